@@ -0,0 +1,76 @@
+package spotigo
+
+import (
+	"slices"
+	"time"
+)
+
+// ============================================================================
+// Added-At Ordering
+// ============================================================================
+
+// AddedAtItem is implemented by saved library items and playlist items,
+// which record when they were added
+type AddedAtItem interface {
+	AddedTime() time.Time
+}
+
+// parseAddedAt parses an added_at timestamp, returning the zero time for
+// missing or malformed ones (Spotify omits it for very old playlist items)
+func parseAddedAt(addedAt string) time.Time {
+	t, err := time.Parse(time.RFC3339, addedAt)
+	if err != nil {
+		return time.Time{}
+	}
+	return t
+}
+
+// AddedTime returns when the track was saved, or the zero time if unknown
+func (s SavedTrack) AddedTime() time.Time { return parseAddedAt(s.AddedAt) }
+
+// AddedTime returns when the album was saved, or the zero time if unknown
+func (s SavedAlbum) AddedTime() time.Time { return parseAddedAt(s.AddedAt) }
+
+// AddedTime returns when the episode was saved, or the zero time if unknown
+func (s SavedEpisode) AddedTime() time.Time { return parseAddedAt(s.AddedAt) }
+
+// AddedTime returns when the show was saved, or the zero time if unknown
+func (s SavedShow) AddedTime() time.Time { return parseAddedAt(s.AddedAt) }
+
+// AddedTime returns when the item was added to the playlist, or the zero
+// time if unknown
+func (p PlaylistTrack) AddedTime() time.Time { return parseAddedAt(p.AddedAt) }
+
+// AddedTime returns when the item was added to the playlist, or the zero
+// time if unknown
+func (p PlaylistItem) AddedTime() time.Time { return parseAddedAt(p.AddedAt) }
+
+// SortSavedByAddedAt sorts items in place by when they were added, oldest
+// first or, with newestFirst, newest first, for chronological library
+// views. The sort is stable, so items added at the same second keep their
+// order, and items without a timestamp go last in both directions.
+//
+// Example:
+//
+//	page, err := client.CurrentUserSavedTracks(ctx, &spotigo.SavedTracksOptions{Limit: 50})
+//	if err != nil {
+//		return err
+//	}
+//	spotigo.SortSavedByAddedAt(page.Items, true)
+func SortSavedByAddedAt[T AddedAtItem](items []T, newestFirst bool) {
+	slices.SortStableFunc(items, func(a, b T) int {
+		ta, tb := a.AddedTime(), b.AddedTime()
+		switch {
+		case ta.IsZero() && tb.IsZero():
+			return 0
+		case ta.IsZero():
+			return 1
+		case tb.IsZero():
+			return -1
+		case newestFirst:
+			return tb.Compare(ta)
+		default:
+			return ta.Compare(tb)
+		}
+	})
+}
@@ -0,0 +1,71 @@
+package spotigo
+
+import (
+	"context"
+	"slices"
+)
+
+// ============================================================================
+// Full Album Tracks
+// ============================================================================
+
+// AlbumTracksFull returns every track on an album as a full Track, with the
+// popularity, external IDs, and album that AlbumTracks leaves out.
+//
+// The album's track list is paginated, then the tracks are looked up in
+// batches of 50. Tracks are returned in disc and track order. Tracks Spotify
+// lists on the album but cannot look up are left out.
+//
+// Example:
+//
+//	tracks, err := client.AlbumTracksFull(ctx, albumID, "US")
+//	if err != nil {
+//		return err
+//	}
+//	for _, track := range tracks {
+//		fmt.Println(track.DiscNumber, track.TrackNumber, track.Name, track.Popularity)
+//	}
+func (c *Client) AlbumTracksFull(ctx context.Context, albumID string, market ...string) ([]Track, error) {
+	opts := &AlbumTracksOptions{Limit: 50}
+	if len(market) > 0 {
+		opts.Market = market[0]
+	}
+
+	page, err := c.AlbumTracks(ctx, albumID, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var listed []SimplifiedTrack
+	for track, err := range IteratePages(c, ctx, page) {
+		if err != nil {
+			return nil, err
+		}
+		listed = append(listed, track)
+	}
+
+	// Spotify lists tracks in disc and track order; sort in case it does not
+	slices.SortStableFunc(listed, func(a, b SimplifiedTrack) int {
+		if a.DiscNumber != b.DiscNumber {
+			return a.DiscNumber - b.DiscNumber
+		}
+		return a.TrackNumber - b.TrackNumber
+	})
+
+	ids := make([]string, len(listed))
+	for i, track := range listed {
+		ids[i] = track.ID
+	}
+	fetched, err := c.tracksByID(ctx, ids, opts.Market)
+	if err != nil {
+		return nil, err
+	}
+
+	tracks := make([]Track, 0, len(listed))
+	for _, id := range ids {
+		if track, ok := fetched[id]; ok {
+			tracks = append(tracks, track)
+		}
+	}
+	return tracks, nil
+}
@@ -0,0 +1,161 @@
+package spotigo
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// ============================================================================
+// Recently Played Archiver
+// ============================================================================
+
+// DefaultArchiveInterval is the default polling interval of a
+// RecentlyPlayedArchiver. Spotify only keeps the last 50 plays, so the
+// interval must be short enough that 50 tracks cannot be played in between.
+const DefaultArchiveInterval = 15 * time.Minute
+
+// HistoryStore defines the interface for play history storage used by
+// RecentlyPlayedArchiver.
+//
+// The library provides two implementations:
+//   - JSONLHistoryStore for append-only JSON Lines files
+//   - SQLiteHistoryStore for SQLite databases
+type HistoryStore interface {
+	// LastPlayedAt returns the played_at time of the newest stored item
+	// Returns the zero time if the store is empty
+	LastPlayedAt(ctx context.Context) (time.Time, error)
+
+	// Append stores items, which are sorted oldest first
+	Append(ctx context.Context, items []PlayHistoryItem) error
+}
+
+// RecentlyPlayedArchiver polls the current user's recently played tracks and
+// appends new plays to a HistoryStore, building a play history beyond the 50
+// items Spotify keeps.
+//
+// Plays are deduplicated by played_at: only items newer than the newest
+// stored item are appended.
+type RecentlyPlayedArchiver struct {
+	Client   *Client
+	Store    HistoryStore
+	Interval time.Duration   // Polling interval (default: DefaultArchiveInterval)
+	OnError  func(err error) // Called with polling errors in Run (optional)
+
+	mu           sync.Mutex
+	lastPlayedAt time.Time
+	loaded       bool
+}
+
+// NewRecentlyPlayedArchiver creates a new recently played archiver
+//
+// Example:
+//
+//	store := spotigo.NewJSONLHistoryStore("history.jsonl")
+//	archiver := spotigo.NewRecentlyPlayedArchiver(client, store, 0)
+//	err := archiver.Run(ctx)
+func NewRecentlyPlayedArchiver(client *Client, store HistoryStore, interval time.Duration) *RecentlyPlayedArchiver {
+	if interval <= 0 {
+		interval = DefaultArchiveInterval
+	}
+	return &RecentlyPlayedArchiver{
+		Client:   client,
+		Store:    store,
+		Interval: interval,
+	}
+}
+
+// Poll fetches the recently played tracks once and appends plays newer than
+// the newest stored item. Returns the number of items appended.
+func (a *RecentlyPlayedArchiver) Poll(ctx context.Context) (int, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.Client == nil {
+		return 0, fmt.Errorf("archiver client is nil")
+	}
+	if a.Store == nil {
+		return 0, fmt.Errorf("archiver store is nil")
+	}
+
+	if !a.loaded {
+		last, err := a.Store.LastPlayedAt(ctx)
+		if err != nil {
+			return 0, fmt.Errorf("failed to read last played time: %w", err)
+		}
+		a.lastPlayedAt = last
+		a.loaded = true
+	}
+
+	opts := &RecentlyPlayedOptions{Limit: 50}
+	if !a.lastPlayedAt.IsZero() {
+		after := a.lastPlayedAt.UnixMilli()
+		opts.After = &after
+	}
+
+	page, err := a.Client.CurrentUserRecentlyPlayed(ctx, opts)
+	if err != nil {
+		return 0, fmt.Errorf("failed to fetch recently played: %w", err)
+	}
+
+	type timedItem struct {
+		item     PlayHistoryItem
+		playedAt time.Time
+	}
+	var newItems []timedItem
+	for _, item := range page.Items {
+		playedAt, err := time.Parse(time.RFC3339, item.PlayedAt)
+		if err != nil {
+			return 0, fmt.Errorf("invalid played_at %q: %w", item.PlayedAt, err)
+		}
+		if !playedAt.After(a.lastPlayedAt) {
+			continue
+		}
+		newItems = append(newItems, timedItem{item: item, playedAt: playedAt})
+	}
+	if len(newItems) == 0 {
+		return 0, nil
+	}
+
+	sort.Slice(newItems, func(i, j int) bool {
+		return newItems[i].playedAt.Before(newItems[j].playedAt)
+	})
+	items := make([]PlayHistoryItem, len(newItems))
+	for i, item := range newItems {
+		items[i] = item.item
+	}
+
+	if err := a.Store.Append(ctx, items); err != nil {
+		return 0, fmt.Errorf("failed to store play history: %w", err)
+	}
+	a.lastPlayedAt = newItems[len(newItems)-1].playedAt
+
+	return len(items), nil
+}
+
+// Run polls immediately and then every Interval until ctx is cancelled.
+// Polling errors are passed to OnError and do not stop the archiver.
+// Returns ctx.Err() when the context is done.
+func (a *RecentlyPlayedArchiver) Run(ctx context.Context) error {
+	interval := a.Interval
+	if interval <= 0 {
+		interval = DefaultArchiveInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := a.Poll(ctx); err != nil && a.OnError != nil && ctx.Err() == nil {
+			a.OnError(err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package spotigo
+
+import (
+	"context"
+	"errors"
+	"math"
+)
+
+// ============================================================================
+// Audio Features Fallback
+// ============================================================================
+
+// GetTrackAudioFeaturesFallback returns a track's audio features, deriving
+// them from its audio analysis when the audio features endpoint is refused or
+// disabled (see EndpointAudioFeatures). The second result is true for derived
+// features, which only have Tempo, Key, Mode, Loudness, TimeSignature,
+// DurationMs, and the track's identifiers set; the perceptual fields such as
+// Danceability and Energy are left zero.
+//
+// If the audio analysis endpoint is refused as well, the original
+// *EndpointDeprecatedError for audio features is returned.
+//
+// Example:
+//
+//	features, derived, err := client.GetTrackAudioFeaturesFallback(ctx, trackID)
+//	if err != nil {
+//		return err
+//	}
+//	fmt.Printf("%.0f BPM\n", features.Tempo)
+//	if !derived {
+//		fmt.Printf("energy %.2f\n", features.Energy)
+//	}
+func (c *Client) GetTrackAudioFeaturesFallback(ctx context.Context, trackID string) (*AudioFeatures, bool, error) {
+	id, err := GetID(trackID, "track")
+	if err != nil {
+		return nil, false, err
+	}
+
+	features, err := c.AudioFeatures(ctx, id)
+	var deprecated *EndpointDeprecatedError
+	if !errors.As(err, &deprecated) {
+		return features, false, err
+	}
+
+	analysis, analysisErr := c.AudioAnalysis(ctx, id)
+	if analysisErr != nil {
+		if errors.Is(analysisErr, ErrEndpointDeprecated) {
+			return nil, false, err
+		}
+		return nil, false, analysisErr
+	}
+	if analysis.Track == nil {
+		return nil, false, err
+	}
+
+	return audioFeaturesFromAnalysis(id, analysis.Track), true, nil
+}
+
+// audioFeaturesFromAnalysis fills the audio features that the track-level
+// analysis also reports
+func audioFeaturesFromAnalysis(id string, track *AnalysisTrack) *AudioFeatures {
+	return &AudioFeatures{
+		Type:          "audio_features",
+		ID:            id,
+		URI:           "spotify:track:" + id,
+		TrackHref:     "https://api.spotify.com/v1/tracks/" + id,
+		AnalysisURL:   "https://api.spotify.com/v1/audio-analysis/" + id,
+		Tempo:         track.Tempo,
+		Key:           track.Key,
+		Mode:          track.Mode,
+		Loudness:      track.Loudness,
+		TimeSignature: track.TimeSignature,
+		DurationMs:    int(math.Round(track.Duration * 1000)),
+	}
+}
@@ -0,0 +1,109 @@
+package spotigo
+
+import (
+	"math"
+	"math/rand/v2"
+	"time"
+)
+
+// ============================================================================
+// Backoff Strategies
+// ============================================================================
+
+// DefaultMaxBackoff caps the delay between retries of the built-in strategies
+const DefaultMaxBackoff = 30 * time.Second
+
+// BackoffStrategy computes the delay before a retry.
+//
+// attempt is the zero-based number of the attempt that just failed. Set
+// RetryConfig.Backoff (or use WithBackoffStrategy) to replace the default
+// linear backoff. A Retry-After header on 429 responses takes precedence
+// over the strategy when RetryConfig.RetryAfterHeader is enabled.
+type BackoffStrategy interface {
+	Delay(attempt int) time.Duration
+}
+
+// BackoffFunc adapts a function to the BackoffStrategy interface
+type BackoffFunc func(attempt int) time.Duration
+
+// Delay calls f(attempt)
+func (f BackoffFunc) Delay(attempt int) time.Duration {
+	return f(attempt)
+}
+
+// LinearBackoff waits (attempt+1) * Factor seconds, capped at Max.
+// It is the default strategy, used when RetryConfig.Backoff is nil.
+type LinearBackoff struct {
+	Factor float64       // Seconds per attempt
+	Max    time.Duration // Maximum delay (default: DefaultMaxBackoff)
+}
+
+// Delay implements BackoffStrategy
+func (b LinearBackoff) Delay(attempt int) time.Duration {
+	maxDelay := b.Max
+	if maxDelay <= 0 {
+		maxDelay = DefaultMaxBackoff
+	}
+	delay := time.Duration(float64(attempt+1) * b.Factor * float64(time.Second))
+	if delay > maxDelay {
+		delay = maxDelay
+	}
+	return delay
+}
+
+// ExponentialBackoff waits Base * 2^attempt, capped at Max.
+//
+// With Jitter enabled it uses "full jitter": the delay is chosen uniformly
+// between 0 and the capped exponential delay, which spreads out retries from
+// many clients failing at the same time.
+type ExponentialBackoff struct {
+	Base   time.Duration // Delay of the first retry (default: 100ms)
+	Max    time.Duration // Maximum delay (default: DefaultMaxBackoff)
+	Jitter bool          // Randomize delays with full jitter
+}
+
+// NewExponentialBackoff creates an exponential backoff with full jitter
+//
+// Example:
+//
+//	client, err := spotigo.NewClient(auth, spotigo.WithBackoffStrategy(
+//		spotigo.NewExponentialBackoff(200*time.Millisecond, 10*time.Second),
+//	))
+func NewExponentialBackoff(base, maxDelay time.Duration) *ExponentialBackoff {
+	return &ExponentialBackoff{Base: base, Max: maxDelay, Jitter: true}
+}
+
+// Delay implements BackoffStrategy
+func (b *ExponentialBackoff) Delay(attempt int) time.Duration {
+	base := b.Base
+	if base <= 0 {
+		base = 100 * time.Millisecond
+	}
+	maxDelay := b.Max
+	if maxDelay <= 0 {
+		maxDelay = DefaultMaxBackoff
+	}
+	if attempt < 0 {
+		attempt = 0
+	}
+
+	delay := maxDelay
+	if exp := float64(base) * math.Pow(2, float64(attempt)); exp < float64(maxDelay) {
+		delay = time.Duration(exp)
+	}
+
+	if b.Jitter && delay > 0 {
+		delay = time.Duration(rand.Int64N(int64(delay) + 1))
+	}
+	return delay
+}
+
+// WithBackoffStrategy sets the delay strategy between retries
+func WithBackoffStrategy(strategy BackoffStrategy) ClientOption {
+	return func(c *Client) {
+		if c.RetryConfig == nil {
+			c.RetryConfig = DefaultRetryConfig()
+		}
+		c.RetryConfig.Backoff = strategy
+	}
+}
@@ -0,0 +1,95 @@
+package spotigo
+
+import (
+	"context"
+)
+
+// ============================================================================
+// Batched Lookups
+// ============================================================================
+
+const (
+	// tracksBatchSize is the maximum number of IDs per tracks request
+	tracksBatchSize = 50
+	// episodesBatchSize is the maximum number of IDs per episodes request
+	episodesBatchSize = 50
+	// albumsBatchSize is the maximum number of IDs per albums request
+	albumsBatchSize = 20
+)
+
+// fetchInBatches looks up ids in batches of size. fetch returns the results
+// of one batch in request order; Spotify returns null for IDs it does not
+// know, which decode to items with an empty ID (as reported by id) and are
+// left out. The result is keyed by the requested ID, which differs from the
+// item's own ID when a track is relinked.
+func fetchInBatches[T any](ids []string, size int, fetch func(batch []string) ([]T, error), id func(T) string) (map[string]T, error) {
+	items := make(map[string]T, len(ids))
+	for start := 0; start < len(ids); start += size {
+		batch := ids[start:min(start+size, len(ids))]
+		results, err := fetch(batch)
+		if err != nil {
+			return nil, err
+		}
+		for i, item := range results {
+			if i < len(batch) && id(item) != "" {
+				items[batch[i]] = item
+			}
+		}
+	}
+	return items, nil
+}
+
+// tracksByID fetches tracks in batches, keyed by requested ID
+func (c *Client) tracksByID(ctx context.Context, ids []string, market string) (map[string]Track, error) {
+	return fetchInBatches(ids, tracksBatchSize, func(batch []string) ([]Track, error) {
+		result, err := c.Tracks(ctx, batch, market)
+		if err != nil {
+			return nil, err
+		}
+		return result.Tracks, nil
+	}, func(track Track) string { return track.ID })
+}
+
+// episodesByID fetches episodes in batches, keyed by requested ID
+func (c *Client) episodesByID(ctx context.Context, ids []string, market ...string) (map[string]Episode, error) {
+	return fetchInBatches(ids, episodesBatchSize, func(batch []string) ([]Episode, error) {
+		result, err := c.Episodes(ctx, batch, market...)
+		if err != nil {
+			return nil, err
+		}
+		return result.Episodes, nil
+	}, func(episode Episode) string { return episode.ID })
+}
+
+// albumsByID fetches albums in batches, keyed by requested ID
+func (c *Client) albumsByID(ctx context.Context, ids []string, market string) (map[string]Album, error) {
+	return fetchInBatches(ids, albumsBatchSize, func(batch []string) ([]Album, error) {
+		result, err := c.Albums(ctx, batch, market)
+		if err != nil {
+			return nil, err
+		}
+		return result.Albums, nil
+	}, func(album Album) string { return album.ID })
+}
+
+// uniqueIDs parses items as IDs of entityType, returning each ID once in
+// order of first appearance along with the inputs that map to it. Inputs
+// that are not valid IDs are reported in the returned *MultiError.
+func uniqueIDs(items []string, entityType string) ([]string, map[string][]string, *MultiError) {
+	var ids []string
+	inputs := make(map[string][]string)
+	invalidIDs := &MultiError{}
+
+	for i, item := range items {
+		id, err := GetID(item, entityType)
+		if err != nil {
+			invalidIDs.Add(i, item, err)
+			continue
+		}
+		if _, seen := inputs[id]; !seen {
+			ids = append(ids, id)
+		}
+		inputs[id] = append(inputs[id], item)
+	}
+	return ids, inputs, invalidIDs
+}
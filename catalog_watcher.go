@@ -0,0 +1,215 @@
+package spotigo
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+)
+
+// ============================================================================
+// Catalog Metadata Watcher
+// ============================================================================
+
+// CatalogSnapshot is the watched metadata of a track or album at one poll
+type CatalogSnapshot struct {
+	Kind       string // "track" or "album"
+	ID         string
+	Name       string
+	Popularity int  // 0-100
+	Available  bool // Playable in the watcher's market, or in some market if none is set
+	Markets    int  // Number of markets the item is available in (0 when a market is set)
+}
+
+// CatalogChange reports an item whose watched metadata changed between polls
+type CatalogChange struct {
+	Previous CatalogSnapshot
+	Current  *CatalogSnapshot // nil if the item is no longer in the catalog
+}
+
+// DefaultCatalogWatchInterval is the polling interval of CatalogWatcher.Run
+// when interval is not positive
+const DefaultCatalogWatchInterval = 6 * time.Hour
+
+// CatalogWatcher refreshes the popularity and availability of a set of
+// tracks and albums and reports changes, e.g. for label analytics dashboards.
+// Tracks are fetched in batches of 50 and albums in batches of 20, through
+// the client's usual rate limiting and retries.
+//
+// The first poll records a baseline without reporting changes.
+type CatalogWatcher struct {
+	Market   string              // Market for availability (optional)
+	OnChange func(CatalogChange) // Called for each change, in Poll's goroutine
+	OnError  func(err error)     // Called with polling errors in Run (optional)
+
+	client   *Client
+	trackIDs []string
+	albumIDs []string
+
+	mu        sync.Mutex
+	snapshots map[string]CatalogSnapshot // Keyed by kind and ID
+}
+
+// NewCatalogWatcher returns a watcher for the given tracks and albums, which
+// may be IDs, URIs, or URLs. IDs that cannot be parsed are ignored.
+//
+// Example:
+//
+//	watcher := client.NewCatalogWatcher(trackIDs, albumIDs, func(change spotigo.CatalogChange) {
+//		if change.Current == nil || !change.Current.Available {
+//			alert(change.Previous.Name + " is no longer available")
+//		}
+//	})
+//	watcher.Market = "US"
+//	err := watcher.Run(ctx, 6*time.Hour)
+func (c *Client) NewCatalogWatcher(trackIDs, albumIDs []string, onChange func(CatalogChange)) *CatalogWatcher {
+	return &CatalogWatcher{
+		OnChange:  onChange,
+		client:    c,
+		trackIDs:  parseWatchedIDs(trackIDs, "track"),
+		albumIDs:  parseWatchedIDs(albumIDs, "album"),
+		snapshots: make(map[string]CatalogSnapshot),
+	}
+}
+
+// parseWatchedIDs extracts the distinct valid IDs of kind
+func parseWatchedIDs(items []string, kind string) []string {
+	var ids []string
+	for _, item := range items {
+		if id, err := GetID(item, kind); err == nil && !slices.Contains(ids, id) {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// Snapshot returns the latest snapshot of a watched track or album
+func (w *CatalogWatcher) Snapshot(kind, id string) (CatalogSnapshot, bool) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	snapshot, ok := w.snapshots[kind+":"+id]
+	return snapshot, ok
+}
+
+// Poll fetches the watched items once, calls OnChange for each change since
+// the last poll, and returns the changes. A failed batch stops the poll; the
+// items already fetched keep their new snapshots.
+func (w *CatalogWatcher) Poll(ctx context.Context) ([]CatalogChange, error) {
+	w.mu.Lock()
+	var changes []CatalogChange
+	record := func(kind, id string, current *CatalogSnapshot) {
+		key := kind + ":" + id
+		previous, known := w.snapshots[key]
+		if current == nil {
+			if known {
+				delete(w.snapshots, key)
+				changes = append(changes, CatalogChange{Previous: previous})
+			}
+			return
+		}
+		w.snapshots[key] = *current
+		if known && previous != *current {
+			changes = append(changes, CatalogChange{Previous: previous, Current: current})
+		}
+	}
+
+	err := w.pollTracks(ctx, record)
+	if err == nil {
+		err = w.pollAlbums(ctx, record)
+	}
+	w.mu.Unlock()
+
+	if w.OnChange != nil {
+		for _, change := range changes {
+			w.OnChange(change)
+		}
+	}
+	return changes, err
+}
+
+// pollTracks fetches the watched tracks in batches of 50
+func (w *CatalogWatcher) pollTracks(ctx context.Context, record func(string, string, *CatalogSnapshot)) error {
+	tracks, err := w.client.tracksByID(ctx, w.trackIDs, w.Market)
+	if err != nil {
+		return err
+	}
+
+	for _, id := range w.trackIDs {
+		track, ok := tracks[id]
+		if !ok {
+			record("track", id, nil)
+			continue
+		}
+		available := w.available(track.Restrictions, track.AvailableMarkets)
+		if track.IsPlayable != nil {
+			available = *track.IsPlayable
+		}
+		record("track", id, &CatalogSnapshot{
+			Kind:       "track",
+			ID:         id,
+			Name:       track.Name,
+			Popularity: track.Popularity,
+			Available:  available,
+			Markets:    len(track.AvailableMarkets),
+		})
+	}
+	return nil
+}
+
+// pollAlbums fetches the watched albums in batches of 20
+func (w *CatalogWatcher) pollAlbums(ctx context.Context, record func(string, string, *CatalogSnapshot)) error {
+	albums, err := w.client.albumsByID(ctx, w.albumIDs, w.Market)
+	if err != nil {
+		return err
+	}
+
+	for _, id := range w.albumIDs {
+		album, ok := albums[id]
+		if !ok {
+			record("album", id, nil)
+			continue
+		}
+		record("album", id, &CatalogSnapshot{
+			Kind:       "album",
+			ID:         id,
+			Name:       album.Name,
+			Popularity: album.Popularity,
+			Available:  w.available(album.Restrictions, album.AvailableMarkets),
+			Markets:    len(album.AvailableMarkets),
+		})
+	}
+	return nil
+}
+
+// available reports whether an item is available: unrestricted in the
+// watcher's market, or listed in some market when no market is set
+func (w *CatalogWatcher) available(restrictions *Restrictions, markets []string) bool {
+	if restrictions != nil {
+		return false
+	}
+	return w.Market != "" || len(markets) > 0
+}
+
+// Run polls immediately and then every interval (default:
+// DefaultCatalogWatchInterval) until ctx is cancelled. Polling errors are
+// passed to OnError and do not stop the watcher. Returns ctx.Err() when the
+// context is done.
+func (w *CatalogWatcher) Run(ctx context.Context, interval time.Duration) error {
+	if interval <= 0 {
+		interval = DefaultCatalogWatchInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := w.Poll(ctx); err != nil && w.OnError != nil && ctx.Err() == nil {
+			w.OnError(err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
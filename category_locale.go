@@ -0,0 +1,35 @@
+package spotigo
+
+import (
+	"errors"
+	"strings"
+)
+
+// ============================================================================
+// Category Locale Fallback
+// ============================================================================
+
+// localeFallbacks returns locale followed by each broader locale to try,
+// ending with "" for Spotify's default, e.g. "es_MX" gives
+// ["es_MX", "es", ""]. Hyphenated locales ("es-MX") are accepted.
+func localeFallbacks(locale string) []string {
+	var chain []string
+	for locale != "" {
+		chain = append(chain, locale)
+		cut := strings.LastIndexAny(locale, "_-")
+		if cut < 0 {
+			break
+		}
+		locale = locale[:cut]
+	}
+	return append(chain, "")
+}
+
+// isNotFoundError reports whether err is a 404 from Spotify
+func isNotFoundError(err error) bool {
+	var spotifyErr *SpotifyError
+	if !errors.As(err, &spotifyErr) {
+		return false
+	}
+	return spotifyErr.HTTPStatus == 404
+}
@@ -0,0 +1,171 @@
+package spotigo
+
+import (
+	"context"
+	"time"
+)
+
+// ============================================================================
+// Audiobook Chapter Progress
+// ============================================================================
+
+// ChapterProgress is how far the current user has listened to a chapter
+type ChapterProgress struct {
+	ChapterID     string        // Chapter ID
+	Name          string        // Chapter name
+	ChapterNumber int           // Position of the chapter in the audiobook
+	Position      time.Duration // Resume position
+	Duration      time.Duration // Chapter length
+	FullyPlayed   bool          // Whether the chapter has been played to the end
+}
+
+// Fraction returns the share of the chapter listened to, from 0 to 1
+func (p ChapterProgress) Fraction() float64 {
+	if p.FullyPlayed {
+		return 1
+	}
+	if p.Duration <= 0 {
+		return 0
+	}
+	return min(float64(p.Position)/float64(p.Duration), 1)
+}
+
+// Remaining returns the time left to listen
+func (p ChapterProgress) Remaining() time.Duration {
+	if p.FullyPlayed {
+		return 0
+	}
+	return max(p.Duration-p.Position, 0)
+}
+
+// Progress returns the current user's progress through the chapter from its
+// resume point. Spotify only includes resume points for requests made with
+// the user-read-playback-position scope.
+func (ch Chapter) Progress() ChapterProgress {
+	progress := ChapterProgress{
+		ChapterID:     ch.ID,
+		Name:          ch.Name,
+		ChapterNumber: ch.ChapterNumber,
+		Duration:      time.Duration(ch.DurationMs) * time.Millisecond,
+	}
+	if ch.ResumePoint != nil {
+		progress.Position = time.Duration(ch.ResumePoint.ResumePositionMs) * time.Millisecond
+		progress.FullyPlayed = ch.ResumePoint.FullyPlayed
+	}
+	return progress
+}
+
+// AudiobookProgress is the current user's progress through every chapter
+// of an audiobook
+type AudiobookProgress struct {
+	AudiobookID string
+	Chapters    []ChapterProgress // In chapter order
+}
+
+// Fraction returns the share of the audiobook listened to by duration,
+// from 0 to 1
+func (p AudiobookProgress) Fraction() float64 {
+	var listened, total time.Duration
+	for _, chapter := range p.Chapters {
+		total += chapter.Duration
+		listened += chapter.Duration - chapter.Remaining()
+	}
+	if total <= 0 {
+		return 0
+	}
+	return float64(listened) / float64(total)
+}
+
+// Remaining returns the time left to listen across all chapters
+func (p AudiobookProgress) Remaining() time.Duration {
+	var remaining time.Duration
+	for _, chapter := range p.Chapters {
+		remaining += chapter.Remaining()
+	}
+	return remaining
+}
+
+// ResumeChapter returns the chapter to continue from: the first one after
+// the last chapter with any progress that is not fully played. It returns
+// false if the audiobook is finished, and the first chapter if it was
+// never started.
+func (p AudiobookProgress) ResumeChapter() (ChapterProgress, bool) {
+	start := 0
+	for i, chapter := range p.Chapters {
+		if chapter.FullyPlayed || chapter.Position > 0 {
+			start = i
+		}
+	}
+	for _, chapter := range p.Chapters[start:] {
+		if !chapter.FullyPlayed {
+			return chapter, true
+		}
+	}
+	return ChapterProgress{}, false
+}
+
+// GetAudiobookChaptersAll retrieves every chapter of an audiobook, 50 per
+// request, for audiobooks with hundreds of chapters. opts.Limit controls
+// the page size (default: 50); opts.Offset sets where to start.
+//
+// Example:
+//
+//	chapters, err := client.GetAudiobookChaptersAll(ctx, audiobookID, &spotigo.AudiobookChaptersOptions{Market: "US"})
+func (c *Client) GetAudiobookChaptersAll(ctx context.Context, audiobookID string, opts *AudiobookChaptersOptions) ([]Chapter, error) {
+	pageOpts := AudiobookChaptersOptions{Limit: 50}
+	if opts != nil {
+		pageOpts = *opts
+		if pageOpts.Limit == 0 {
+			pageOpts.Limit = 50
+		}
+	}
+
+	first, err := c.GetAudiobookChapters(ctx, audiobookID, &pageOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	var chapters []Chapter
+	for chapter, err := range IteratePages(c, ctx, first) {
+		if err != nil {
+			return nil, err
+		}
+		chapters = append(chapters, chapter)
+	}
+	return chapters, nil
+}
+
+// GetAudiobookProgress returns the current user's progress through every
+// chapter of an audiobook from the chapter list, without a request per
+// chapter. Requires the user-read-playback-position scope.
+//
+// Example:
+//
+//	progress, err := client.GetAudiobookProgress(ctx, audiobookID)
+//	if err != nil {
+//		return err
+//	}
+//	if next, ok := progress.ResumeChapter(); ok {
+//		fmt.Printf("%.0f%% done; continue with %s at %s\n", progress.Fraction()*100, next.Name, next.Position)
+//	}
+func (c *Client) GetAudiobookProgress(ctx context.Context, audiobookID string, market ...string) (*AudiobookProgress, error) {
+	id, err := GetID(audiobookID, "audiobook")
+	if err != nil {
+		return nil, err
+	}
+
+	var opts AudiobookChaptersOptions
+	if len(market) > 0 {
+		opts.Market = market[0]
+	}
+	chapters, err := c.GetAudiobookChaptersAll(ctx, id, &opts)
+	if err != nil {
+		return nil, err
+	}
+
+	progress := &AudiobookProgress{AudiobookID: id, Chapters: make([]ChapterProgress, len(chapters))}
+	for i, chapter := range chapters {
+		progress.Chapters[i] = chapter.Progress()
+	}
+	return progress, nil
+}
@@ -0,0 +1,100 @@
+package spotigo
+
+import (
+	"strings"
+	"sync"
+	"time"
+)
+
+// ============================================================================
+// Circuit Breaker
+// ============================================================================
+
+// circuitBreaker tracks consecutive failed attempts against the API prefix.
+//
+// Once failures reach the threshold the circuit opens and requests fail fast
+// until the cooldown has passed. Then a single trial request is let through:
+// success closes the circuit, failure opens it for another cooldown.
+type circuitBreaker struct {
+	mu            sync.Mutex
+	failures      int
+	openUntil     time.Time
+	trialInFlight bool
+}
+
+// allow returns a *CircuitOpenError if the circuit rejects a request now
+func (b *circuitBreaker) allow(now time.Time, threshold int) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.failures < threshold {
+		return nil
+	}
+	if now.Before(b.openUntil) || b.trialInFlight {
+		return &CircuitOpenError{Failures: b.failures, Until: b.openUntil}
+	}
+	// Cooldown passed: let one trial request through
+	b.trialInFlight = true
+	return nil
+}
+
+// record records the outcome of an attempt
+func (b *circuitBreaker) record(failed bool, now time.Time, threshold int, cooldown time.Duration) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.trialInFlight = false
+	if !failed {
+		b.failures = 0
+		b.openUntil = time.Time{}
+		return
+	}
+
+	b.failures++
+	if b.failures >= threshold {
+		b.openUntil = now.Add(cooldown)
+	}
+}
+
+// reset closes the circuit
+func (b *circuitBreaker) reset() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.failures = 0
+	b.openUntil = time.Time{}
+	b.trialInFlight = false
+}
+
+// circuitBreakerEnabled reports whether the circuit breaker applies to urlStr
+func (c *Client) circuitBreakerEnabled(urlStr string) bool {
+	return c.RetryConfig != nil &&
+		c.RetryConfig.CircuitBreakerThreshold > 0 &&
+		strings.HasPrefix(urlStr, c.APIPrefix)
+}
+
+// circuitBreakerAllow returns a *CircuitOpenError if requests to urlStr are
+// currently rejected
+func (c *Client) circuitBreakerAllow(urlStr string) error {
+	if !c.circuitBreakerEnabled(urlStr) {
+		return nil
+	}
+	return c.breaker.allow(time.Now(), c.RetryConfig.CircuitBreakerThreshold)
+}
+
+// circuitBreakerRecord records the outcome of an attempt against urlStr
+func (c *Client) circuitBreakerRecord(urlStr string, failed bool) {
+	if !c.circuitBreakerEnabled(urlStr) {
+		return
+	}
+	cooldown := c.RetryConfig.CircuitBreakerCooldown
+	if cooldown <= 0 {
+		cooldown = DefaultCircuitBreakerCooldown
+	}
+	c.breaker.record(failed, time.Now(), c.RetryConfig.CircuitBreakerThreshold, cooldown)
+}
+
+// ResetCircuitBreaker closes the circuit breaker, allowing requests again
+// immediately
+func (c *Client) ResetCircuitBreaker() {
+	c.breaker.reset()
+}
@@ -19,15 +19,16 @@
 package spotigo
 
 import (
-	"bytes"
 	"context"
-	"encoding/base64"
-	"encoding/json"
+	"crypto/tls"
+	"errors"
 	"fmt"
 	"io"
 	"log"
 	"net/http"
 	"net/url"
+	"path"
+	"reflect"
 	"strconv"
 	"strings"
 	"time"
@@ -40,6 +41,10 @@ const (
 	DefaultTimeout = 5 * time.Second
 	// DefaultMaxRetries is the default maximum number of retries
 	DefaultMaxRetries = 3
+	// DefaultDeviceCacheTTL is how long CurrentUserDevices results are cached
+	DefaultDeviceCacheTTL = 10 * time.Second
+	// DefaultCircuitBreakerCooldown is how long an open circuit rejects requests
+	DefaultCircuitBreakerCooldown = 30 * time.Second
 )
 
 // Logger defines a simple logging interface for the client.
@@ -77,8 +82,25 @@ type RetryConfig struct {
 	MaxRetries       int
 	StatusRetries    int
 	StatusForcelist  []int
-	BackoffFactor    float64
+	BackoffFactor    float64 // Seconds per attempt for the default LinearBackoff
 	RetryAfterHeader bool
+
+	// Backoff computes the delay between retries. nil uses LinearBackoff
+	// with BackoffFactor, preserving the original behavior.
+	Backoff BackoffStrategy
+
+	// StatusPolicies sets retries per status code, or per family with
+	// StatusFamily4xx and StatusFamily5xx keys. Statuses with a policy
+	// ignore StatusForcelist and StatusRetries (see WithStatusRetryPolicy).
+	StatusPolicies map[int]StatusRetryPolicy
+
+	// CircuitBreakerThreshold is the number of consecutive failed attempts
+	// (network errors or 5xx responses) after which the circuit opens and
+	// requests fail fast with ErrCircuitOpen. 0 disables the circuit breaker.
+	CircuitBreakerThreshold int
+	// CircuitBreakerCooldown is how long the circuit stays open before a
+	// trial request is allowed (default: DefaultCircuitBreakerCooldown)
+	CircuitBreakerCooldown time.Duration
 }
 
 // DefaultRetryConfig returns default retry configuration
@@ -107,17 +129,50 @@ func DefaultRetryConfig() *RetryConfig {
 //
 //	track, err := client.Track(ctx, "4iV5W9uYEdYUVa79Axb7Rh")
 type Client struct {
-	HTTPClient     *http.Client      // Custom HTTP client (optional)
-	AuthManager    AuthManager       // Authentication manager (required)
-	CacheHandler   CacheHandler      // Token cache handler (optional)
-	APIPrefix      string            // API base URL (default: https://api.spotify.com/v1/)
-	Language       string            // Language for localized responses
-	RetryConfig    *RetryConfig      // Retry configuration
-	RequestTimeout time.Duration     // Request timeout
-	Logger         Logger            // Logger for debugging
-	Proxies        map[string]string // HTTP proxies
-	MaxRetries     int               // Maximum retry attempts
-	CountryCodes   []string          // Supported country codes (ISO 3166-1 alpha-2)
+	HTTPClient         *http.Client      // Custom HTTP client (optional)
+	AuthManager        AuthManager       // Authentication manager (required)
+	CacheHandler       CacheHandler      // Token cache handler (optional)
+	APIPrefix          string            // API base URL (default: https://api.spotify.com/v1/)
+	Language           string            // Language for localized responses
+	UserAgent          string            // User-Agent header (default: Go's HTTP client default)
+	DefaultHeaders     http.Header       // Headers added to every API request
+	RetryConfig        *RetryConfig      // Retry configuration
+	RequestTimeout     time.Duration     // Request timeout
+	Logger             Logger            // Logger for debugging
+	Proxies            map[string]string // HTTP proxies keyed by scheme ("http", "https")
+	TLSConfig          *tls.Config       // TLS configuration for the default HTTP client (optional)
+	Transport          http.RoundTripper // Transport for the HTTP client, replacing proxies and TLS settings (optional)
+	MaxRetries         int               // Maximum retry attempts
+	CountryCodes       []string          // Supported country codes (ISO 3166-1 alpha-2)
+	DeviceCacheTTL     time.Duration     // How long CurrentUserDevices results are cached (default: 10s)
+	DeadlinePolicy     DeadlinePolicy    // Handling of contexts without a deadline (default: DeadlineIgnore)
+	DefaultCallTimeout time.Duration     // Deadline for calls whose context has none (0 = no default)
+	RateLimitReserve   int               // Delay requests while fewer requests remain in the rate limit window (0 = disabled)
+	MarketFromToken    bool              // Add market=from_token to user-authenticated catalog requests without a market
+	DefaultMarket      string            // Market for requests that accept one and don't set it (see WithDefaultMarket)
+	DefaultLocale      string            // Locale for requests that accept one and don't set it (see WithDefaultLocale)
+	MarketsCacheTTL    time.Duration     // How long Markets results are cached (default: 24h, negative disables)
+	GenreSeedsCacheTTL time.Duration     // How long GenreSeeds results are cached (default: 24h, negative disables)
+	StrictDecoding     bool              // Fail on response fields missing from the models (see WithStrictDecoding)
+	StreamingDecode    bool              // Decode successful responses without buffering them (see WithStreamingDecode)
+	Codec              Codec             // JSON codec for request and response bodies (default: StdCodec)
+	DisableCompression bool              // Don't request compressed responses (see WithCompression)
+	CoalesceRequests   bool              // Share identical in-flight GET requests (see WithRequestCoalescing)
+	MaxResponseBytes   int64             // Largest response body read, before and after decompression (0 = no limit)
+
+	DeprecationFallbacks bool                        // Emulate sunset endpoints where possible (see WithDeprecationFallbacks)
+	DisabledEndpoints    map[DeprecatedEndpoint]bool // Sunset endpoints to fail without a request (see WithDisabledEndpoints)
+
+	deviceCache  deviceCache                  // Cached CurrentUserDevices result
+	breaker      circuitBreaker               // Consecutive failure tracking for RetryConfig's circuit breaker
+	rateLimit    *rateLimitTracker            // Rate limit state from response headers (shared within a ClientPool)
+	bodyEncoders map[reflect.Type]BodyEncoder // Request body encoders registered with WithBodyEncoder
+	remote       playerRemote                 // Recent remote control commands (see TogglePlayback)
+	stats        statsTracker                 // Request counters (see Stats)
+	markets      marketsCache                 // Cached Markets result
+	currentUser  currentUserCache             // Cached CurrentUser result (see Me)
+	genreSeeds   genreSeedsCache              // Cached GenreSeeds result
+	inflight     requestGroup                 // In-flight GET requests (see WithRequestCoalescing)
 }
 
 // ClientOption is a functional option for client configuration.
@@ -149,7 +204,10 @@ func NewClient(authManager AuthManager, opts ...ClientOption) (*Client, error) {
 		MaxRetries:     DefaultMaxRetries,
 		Logger:         &DefaultLogger{},
 		CountryCodes:   getDefaultCountryCodes(),
+		DeviceCacheTTL: DefaultDeviceCacheTTL,
+		rateLimit:      &rateLimitTracker{},
 	}
+	client.stats.reset(time.Now())
 
 	// Apply options
 	for _, opt := range opts {
@@ -158,9 +216,28 @@ func NewClient(authManager AuthManager, opts ...ClientOption) (*Client, error) {
 
 	// Initialize HTTP client if not provided
 	if client.HTTPClient == nil {
-		client.HTTPClient = &http.Client{
-			Timeout: client.RequestTimeout,
+		if client.Transport != nil {
+			client.HTTPClient = &http.Client{
+				Timeout:   client.RequestTimeout,
+				Transport: client.Transport,
+			}
+		} else if len(client.Proxies) > 0 || client.TLSConfig != nil {
+			httpClient, err := newTransportHTTPClient(client.RequestTimeout, client.Proxies, client.TLSConfig)
+			if err != nil {
+				return nil, err
+			}
+			client.HTTPClient = httpClient
+		} else {
+			// The default transport honors HTTP_PROXY, HTTPS_PROXY, and NO_PROXY
+			client.HTTPClient = &http.Client{
+				Timeout: client.RequestTimeout,
+			}
 		}
+	} else if client.Transport != nil {
+		// Leave the caller's client untouched
+		httpClient := *client.HTTPClient
+		httpClient.Transport = client.Transport
+		client.HTTPClient = &httpClient
 	}
 
 	return client, nil
@@ -187,6 +264,32 @@ func WithLanguage(lang string) ClientOption {
 	}
 }
 
+// WithUserAgent sets the User-Agent header sent with every request
+//
+// Example:
+//
+//	client, err := spotigo.NewClient(auth, spotigo.WithUserAgent("my-app/1.2.0"))
+func WithUserAgent(userAgent string) ClientOption {
+	return func(c *Client) {
+		c.UserAgent = userAgent
+	}
+}
+
+// WithDefaultHeaders adds headers to every request, e.g. Spotify-App-Version.
+// Headers managed by the client (Authorization, Content-Type, and
+// Accept-Language when WithLanguage is set) take precedence.
+// Calling it more than once merges the headers.
+func WithDefaultHeaders(headers http.Header) ClientOption {
+	return func(c *Client) {
+		if c.DefaultHeaders == nil {
+			c.DefaultHeaders = http.Header{}
+		}
+		for name, values := range headers {
+			c.DefaultHeaders[http.CanonicalHeaderKey(name)] = append([]string(nil), values...)
+		}
+	}
+}
+
 // WithRetryConfig sets the retry configuration
 func WithRetryConfig(config *RetryConfig) ClientOption {
 	return func(c *Client) {
@@ -211,6 +314,14 @@ func WithLogger(logger Logger) ClientOption {
 	}
 }
 
+// WithDeviceCacheTTL sets how long CurrentUserDevices results are cached.
+// A zero or negative TTL disables caching.
+func WithDeviceCacheTTL(ttl time.Duration) ClientOption {
+	return func(c *Client) {
+		c.DeviceCacheTTL = ttl
+	}
+}
+
 // WithAPIPrefix sets a custom API prefix
 func WithAPIPrefix(prefix string) ClientOption {
 	return func(c *Client) {
@@ -230,13 +341,14 @@ func getDefaultCountryCodes() []string {
 
 // validatePaginationParams validates limit and offset parameters
 func validatePaginationParams(limit, offset int) error {
+	var errs []error
 	if limit < 0 {
-		return fmt.Errorf("limit must be non-negative, got %d", limit)
+		errs = append(errs, &InvalidOptionError{Field: "limit", Reason: fmt.Sprintf("must be non-negative, got %d", limit)})
 	}
 	if offset < 0 {
-		return fmt.Errorf("offset must be non-negative, got %d", offset)
+		errs = append(errs, &InvalidOptionError{Field: "offset", Reason: fmt.Sprintf("must be non-negative, got %d", offset)})
 	}
-	return nil
+	return errors.Join(errs...)
 }
 
 // validateMarketParameter validates market parameter (country code or "from_token")
@@ -249,7 +361,7 @@ func validateMarketParameter(market string) error {
 		return nil
 	}
 	if !ValidateCountryCode(market) {
-		return fmt.Errorf("invalid country code: %s", market)
+		return &InvalidMarketError{Code: market}
 	}
 	return nil
 }
@@ -264,11 +376,38 @@ func (c *Client) _internal_call(
 	result interface{},
 ) error {
 	// Build full URL
+	params, err := c.applyMarketDefaults(ctx, method, urlStr, params)
+	if err != nil {
+		return err
+	}
 	fullURL := c.buildURL(urlStr, params)
 
+	// Share identical in-flight GETs (see WithRequestCoalescing)
+	if c.shouldCoalesce(ctx, method, result) {
+		return c.coalesce(ctx, fullURL, result, func(ctx context.Context) ([]byte, error) {
+			var body capturedBody
+			err := c._internal_call(context.WithValue(ctx, coalescedKey{}, true), method, urlStr, params, nil, &body)
+			return body, err
+		})
+	}
+
+	// Enforce deadline settings for contexts without a deadline
+	ctx, cancel, err := c.applyDeadline(ctx, method, fullURL)
+	defer cancel()
+	if err != nil {
+		return err
+	}
+
+	// Writes that must not be repeated blindly are only retried on 429
+	noRetry := ctx.Value(noRetryKey{}) != nil
+
+	// Backoffs are fitted to the context deadline; see fitRetryDelay
+	shrunk := false
+
 	// Retry loop
 	var lastErr error
-	for attempt := 0; attempt <= c.RetryConfig.MaxRetries; attempt++ {
+	statusRetries := make(map[int]int) // Retries so far by status code
+	for attempt := 0; attempt <= c.RetryConfig.maxAttempts(); attempt++ {
 		// Check context cancellation before retry attempt
 		select {
 		case <-ctx.Done():
@@ -276,32 +415,50 @@ func (c *Client) _internal_call(
 		default:
 		}
 
+		// Wait if the rate limit budget is nearly exhausted
+		if err := c.waitForRateLimit(ctx); err != nil {
+			return err
+		}
+
 		// Refresh token before each attempt to ensure we have a valid token
 		// This is especially important during long retry delays (e.g., 429 Retry-After)
 		token, err := c.AuthManager.GetAccessToken(ctx)
 		if err != nil {
 			return fmt.Errorf("failed to get access token: %w", err)
 		}
+		c.stats.recordToken(token)
 
 		// Create request with fresh token
-		req, err := c.createRequest(ctx, method, fullURL, body, token, params)
+		req, err := c.createRequest(ctx, method, fullURL, body, token)
 		if err != nil {
 			return err
 		}
 
+		// Fail fast while the circuit breaker is open
+		if err := c.circuitBreakerAllow(fullURL); err != nil {
+			return err
+		}
+
 		// Log request
 		c.logRequest(req, body)
+		c.stats.recordRequest(req.ContentLength, attempt > 0)
 
 		// Execute request
+		sentAt := time.Now()
 		resp, err := c.HTTPClient.Do(req)
 		if err != nil {
+			c.stats.recordNetworkError()
+			c.circuitBreakerRecord(fullURL, true)
 			lastErr = err
-			if !c.shouldRetry(err, attempt) {
+			if noRetry || !c.shouldRetry(err, attempt) {
 				return fmt.Errorf("request failed: %w", err)
 			}
 			// Calculate backoff and retry
-			delay := c.calculateBackoffDelay(attempt)
-			c.logRetry(attempt, delay, err)
+			delay, err := fitRetryDelay(ctx, c.calculateBackoffDelay(attempt), false, &shrunk, attempt, err)
+			if err != nil {
+				return err
+			}
+			c.logRetry(attempt, delay, lastErr)
 			
 			// Check context cancellation before sleeping
 			select {
@@ -313,24 +470,51 @@ func (c *Client) _internal_call(
 			continue
 		}
 
+		// Decode large successful responses without buffering them
+		if c.shouldStreamResponse(ctx, resp, result) {
+			transferred, err := c.decodeStreamingResponse(ctx, resp, result)
+			resp.Body.Close()
+			c.stats.recordResponse(resp.StatusCode, transferred, time.Since(sentAt))
+			c.rateLimit.update(resp.StatusCode, resp.Header, time.Now())
+			c.circuitBreakerRecord(fullURL, false)
+			if err != nil {
+				return err
+			}
+			c.logResponse(resp.StatusCode, nil)
+			return nil
+		}
+
 		// Read response body
-		respBody, err := io.ReadAll(resp.Body)
+		respBody, err := io.ReadAll(c.limitResponse(resp.Body))
 		resp.Body.Close()
+		c.stats.recordResponse(resp.StatusCode, len(respBody), time.Since(sentAt))
+		c.rateLimit.update(resp.StatusCode, resp.Header, time.Now())
+		c.circuitBreakerRecord(fullURL, err != nil || resp.StatusCode >= 500)
+		if err == nil {
+			respBody, err = c.decompressBody(resp, respBody)
+		}
 		if err != nil {
 			lastErr = fmt.Errorf("failed to read response: %w", err)
-			if !c.shouldRetry(err, attempt) {
+			if noRetry || errors.Is(err, ErrResponseTooLarge) || !c.shouldRetry(err, attempt) {
 				return lastErr
 			}
 			continue
 		}
+		recordRawResponse(ctx, method, fullURL, resp, respBody)
 
 		// Check for errors
 		if resp.StatusCode >= 400 {
 			spotifyErr := c.parseErrorResponse(resp.StatusCode, method, resp.Header, respBody, fullURL)
 
 			// Check if retryable
-			if c.shouldRetryStatus(resp.StatusCode, attempt) {
-				delay := c.calculateRetryDelay(resp.StatusCode, resp.Header, attempt)
+			retries := statusRetries[resp.StatusCode]
+			if c.shouldRetryStatus(resp.StatusCode, attempt, retries) && (!noRetry || resp.StatusCode == http.StatusTooManyRequests) {
+				statusRetries[resp.StatusCode]++
+				mandated := resp.StatusCode == http.StatusTooManyRequests && c.RetryConfig.RetryAfterHeader && resp.Header.Get("Retry-After") != ""
+				delay, err := fitRetryDelay(ctx, c.calculateRetryDelay(resp.StatusCode, resp.Header, attempt, retries), mandated, &shrunk, attempt, spotifyErr)
+				if err != nil {
+					return err
+				}
 				c.logRetry(attempt, delay, spotifyErr)
 				
 				// Check context cancellation before sleeping
@@ -347,6 +531,21 @@ func (c *Client) _internal_call(
 			return spotifyErr
 		}
 
+		// Hand the body of a coalesced request to its waiters undecoded
+		if captured, ok := result.(*capturedBody); ok {
+			*captured = respBody
+			c.logResponse(resp.StatusCode, respBody)
+			return nil
+		}
+
+		// Report status for requests that return no content
+		if accepted, ok := result.(*AcceptedResult); ok {
+			accepted.StatusCode = resp.StatusCode
+			accepted.Headers = resp.Header
+			c.logResponse(resp.StatusCode, respBody)
+			return nil
+		}
+
 		// Decode successful response
 		if result != nil {
 			if len(respBody) == 0 {
@@ -357,8 +556,8 @@ func (c *Client) _internal_call(
 				// For other status codes, result may have zero values
 				// Continue to unmarshal (will result in zero values)
 			}
-			if err := json.Unmarshal(respBody, result); err != nil {
-				return WrapJSONError(err)
+			if err := c.decodeResponse(respBody, result); err != nil {
+				return err
 			}
 		}
 
@@ -412,39 +611,19 @@ func (c *Client) buildURL(urlStr string, params url.Values) string {
 	return fullURL
 }
 
-// createRequest creates an HTTP request with proper headers and body
-func (c *Client) createRequest(ctx context.Context, method, urlStr string, body interface{}, token string, params url.Values) (*http.Request, error) {
+// createRequest creates an HTTP request with proper headers and body.
+// The body is encoded by the encoder registered for its type (see
+// WithBodyEncoder), defaulting to JSON.
+func (c *Client) createRequest(ctx context.Context, method, urlStr string, body interface{}, token string) (*http.Request, error) {
 	var reqBody io.Reader
-	contentType := "application/json"
-
-	// Handle custom content type from params
-	if params != nil {
-		if customContentType := params.Get("content_type"); customContentType != "" {
-			contentType = customContentType
-			params.Del("content_type") // Remove from query params
-		}
-	}
+	var contentType string
 
 	// Encode body
 	if body != nil {
-		if contentType == "application/json" {
-			// JSON encode
-			jsonData, err := json.Marshal(body)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal request body: %w", err)
-			}
-			reqBody = bytes.NewReader(jsonData)
-		} else {
-			// Form-encoded or other content type
-			if formData, ok := body.(url.Values); ok {
-				reqBody = strings.NewReader(formData.Encode())
-			} else if strData, ok := body.(string); ok {
-				reqBody = strings.NewReader(strData)
-			} else if byteData, ok := body.([]byte); ok {
-				reqBody = bytes.NewReader(byteData)
-			} else {
-				return nil, fmt.Errorf("unsupported body type for content type %s", contentType)
-			}
+		var err error
+		reqBody, contentType, err = c.encodeBody(body)
+		if err != nil {
+			return nil, err
 		}
 	}
 
@@ -454,6 +633,12 @@ func (c *Client) createRequest(ctx context.Context, method, urlStr string, body
 	}
 
 	// Set headers
+	for name, values := range c.DefaultHeaders {
+		req.Header[http.CanonicalHeaderKey(name)] = append([]string(nil), values...)
+	}
+	if c.UserAgent != "" {
+		req.Header.Set("User-Agent", c.UserAgent)
+	}
 	req.Header.Set("Authorization", "Bearer "+token)
 	if body != nil {
 		req.Header.Set("Content-Type", contentType)
@@ -461,6 +646,7 @@ func (c *Client) createRequest(ctx context.Context, method, urlStr string, body
 	if c.Language != "" {
 		req.Header.Set("Accept-Language", c.Language)
 	}
+	c.setAcceptEncoding(req)
 
 	return req, nil
 }
@@ -474,8 +660,12 @@ func (c *Client) shouldRetry(err error, attempt int) bool {
 	return true
 }
 
-// shouldRetryStatus determines if an HTTP status code should be retried
-func (c *Client) shouldRetryStatus(statusCode, attempt int) bool {
+// shouldRetryStatus determines if an HTTP status code should be retried,
+// given the request's attempt and how often this status was retried
+func (c *Client) shouldRetryStatus(statusCode, attempt, retries int) bool {
+	if policy, ok := c.RetryConfig.statusPolicy(statusCode); ok {
+		return retries < policy.MaxRetries
+	}
 	if attempt >= c.RetryConfig.StatusRetries {
 		return false
 	}
@@ -488,19 +678,21 @@ func (c *Client) shouldRetryStatus(statusCode, attempt int) bool {
 	return false
 }
 
-// calculateBackoffDelay calculates exponential backoff delay
+// calculateBackoffDelay calculates the backoff delay using RetryConfig.Backoff,
+// falling back to linear backoff with BackoffFactor
 func (c *Client) calculateBackoffDelay(attempt int) time.Duration {
-	delay := time.Duration(float64(attempt+1) * c.RetryConfig.BackoffFactor * float64(time.Second))
-	if delay > 30*time.Second {
-		delay = 30 * time.Second
+	if c.RetryConfig.Backoff != nil {
+		return c.RetryConfig.Backoff.Delay(attempt)
 	}
-	return delay
+	return LinearBackoff{Factor: c.RetryConfig.BackoffFactor}.Delay(attempt)
 }
 
-// calculateRetryDelay calculates retry delay, using Retry-After header if available
-func (c *Client) calculateRetryDelay(statusCode int, headers http.Header, attempt int) time.Duration {
-	// For 429, try to use Retry-After header
-	if statusCode == 429 && c.RetryConfig.RetryAfterHeader {
+// calculateRetryDelay calculates retry delay, using Retry-After header if
+// available and then the status's policy backoff, given retries so far of
+// the status
+func (c *Client) calculateRetryDelay(statusCode int, headers http.Header, attempt, retries int) time.Duration {
+	// For 429, and 503 from gateways shedding load, try to use Retry-After header
+	if (statusCode == 429 || statusCode == http.StatusServiceUnavailable) && c.RetryConfig.RetryAfterHeader {
 		if retryAfter := headers.Get("Retry-After"); retryAfter != "" {
 			// Try parsing as integer seconds first
 			if seconds, err := strconv.Atoi(retryAfter); err == nil {
@@ -516,6 +708,10 @@ func (c *Client) calculateRetryDelay(statusCode int, headers http.Header, attemp
 		}
 	}
 
+	if policy, ok := c.RetryConfig.statusPolicy(statusCode); ok && policy.Backoff != nil {
+		return policy.Backoff.Delay(retries)
+	}
+
 	// Use exponential backoff
 	return c.calculateBackoffDelay(attempt)
 }
@@ -530,10 +726,9 @@ func (c *Client) logRequest(req *http.Request, body interface{}) {
 	if c.Logger == nil {
 		return
 	}
-	c.Logger.Debug("Request: %s %s", req.Method, req.URL.String())
+	c.Logger.Debug("Request: %s %s", req.Method, RedactString(req.URL.String()))
 	if body != nil {
-		// Sanitize sensitive data if needed
-		c.Logger.Debug("Request body: %v", body)
+		c.Logger.Debug("Request body: %s", redactBody(body))
 	}
 }
 
@@ -544,7 +739,7 @@ func (c *Client) logResponse(statusCode int, body []byte) {
 	}
 	c.Logger.Debug("Response: %d", statusCode)
 	if len(body) > 0 {
-		c.Logger.Debug("Response body: %s", string(body))
+		c.Logger.Debug("Response body: %s", RedactString(string(body)))
 	}
 }
 
@@ -556,7 +751,7 @@ func (c *Client) logRetry(attempt int, delay time.Duration, err error) {
 	if spotifyErr, ok := err.(*SpotifyError); ok && spotifyErr.HTTPStatus == 429 {
 		c.Logger.Warn("Your application has reached a rate/request limit. Retry will occur after: %.0f s", delay.Seconds())
 	} else {
-		c.Logger.Warn("Retry attempt %d after %v: %v", attempt+1, delay, err)
+		c.Logger.Warn("Retry attempt %d after %v: %s", attempt+1, delay, RedactString(err.Error()))
 	}
 }
 
@@ -609,7 +804,7 @@ func (c *Client) Track(ctx context.Context, trackID string, market ...string) (*
 // Maximum 50 tracks per request
 func (c *Client) Tracks(ctx context.Context, trackIDs []string, market ...string) (*TracksResponse, error) {
 	if len(trackIDs) > 50 {
-		return nil, fmt.Errorf("maximum 50 tracks per request")
+		return nil, &TooManyIDsError{Kind: "tracks", Max: 50, Got: len(trackIDs)}
 	}
 
 	ids := make([]string, len(trackIDs))
@@ -656,7 +851,7 @@ func (c *Client) Artist(ctx context.Context, artistID string) (*Artist, error) {
 // Artists retrieves multiple artists by IDs, URIs, or URLs
 func (c *Client) Artists(ctx context.Context, artistIDs []string) (*ArtistsResponse, error) {
 	if len(artistIDs) > 50 {
-		return nil, fmt.Errorf("maximum 50 artists per request")
+		return nil, &TooManyIDsError{Kind: "artists", Max: 50, Got: len(artistIDs)}
 	}
 
 	ids := make([]string, len(artistIDs))
@@ -681,10 +876,13 @@ func (c *Client) Artists(ctx context.Context, artistIDs []string) (*ArtistsRespo
 
 // ArtistAlbumsOptions holds options for ArtistAlbums
 type ArtistAlbumsOptions struct {
+	Groups  []AlbumGroup // Album groups to include (takes precedence over IncludeGroups)
+	Country string       // ISO 3166-1 alpha-2 country code
+	Limit   int          // Default: 20, Max: 50
+	Offset  int          // Default: 0
+
+	// Deprecated: Use Groups, which is checked at compile time.
 	IncludeGroups []string // album, single, appears_on, compilation
-	Country       string   // ISO 3166-1 alpha-2 country code
-	Limit         int      // Default: 20, Max: 50
-	Offset        int      // Default: 0
 }
 
 // ArtistAlbums retrieves albums by an artist
@@ -701,8 +899,21 @@ func (c *Client) ArtistAlbums(ctx context.Context, artistID string, opts *Artist
 			return nil, err
 		}
 		
-		if len(opts.IncludeGroups) > 0 {
-			params.Set("include_groups", strings.Join(opts.IncludeGroups, ","))
+		groups := opts.Groups
+		if len(groups) == 0 {
+			for _, group := range opts.IncludeGroups {
+				groups = append(groups, AlbumGroup(group))
+			}
+		}
+		if len(groups) > 0 {
+			names := make([]string, len(groups))
+			for i, group := range groups {
+				if err := group.Validate(); err != nil {
+					return nil, err
+				}
+				names[i] = string(group)
+			}
+			params.Set("include_groups", strings.Join(names, ","))
 		}
 		if opts.Country != "" {
 			params.Set("country", opts.Country)
@@ -755,20 +966,26 @@ func (c *Client) ArtistTopTracks(ctx context.Context, artistID, market string) (
 	return &result, nil
 }
 
-// ArtistRelatedArtists retrieves artists related to an artist
-// Note: This endpoint may be deprecated by Spotify
+// ArtistRelatedArtists retrieves artists related to an artist.
+// Spotify has sunset this endpoint; see EndpointRelatedArtists.
 func (c *Client) ArtistRelatedArtists(ctx context.Context, artistID string) (*ArtistsResponse, error) {
 	id, err := GetID(artistID, "artist")
 	if err != nil {
 		return nil, err
 	}
 
-	var result ArtistsResponse
-	if err := c._get(ctx, fmt.Sprintf("artists/%s/related-artists", id), nil, &result); err != nil {
+	result := &ArtistsResponse{}
+	err = c.callDeprecated(EndpointRelatedArtists, func() error {
+		return c._get(ctx, fmt.Sprintf("artists/%s/related-artists", id), nil, result)
+	}, func() (err error) {
+		result, err = c.searchRelatedArtists(ctx, id)
+		return err
+	})
+	if err != nil {
 		return nil, err
 	}
 
-	return &result, nil
+	return result, nil
 }
 
 // ============================================================================
@@ -802,7 +1019,7 @@ func (c *Client) Album(ctx context.Context, albumID string, market ...string) (*
 // Maximum 20 albums per request
 func (c *Client) Albums(ctx context.Context, albumIDs []string, market ...string) (*AlbumsResponse, error) {
 	if len(albumIDs) > 20 {
-		return nil, fmt.Errorf("maximum 20 albums per request")
+		return nil, &TooManyIDsError{Kind: "albums", Max: 20, Got: len(albumIDs)}
 	}
 
 	ids := make([]string, len(albumIDs))
@@ -847,15 +1064,11 @@ func (c *Client) AlbumTracks(ctx context.Context, albumID string, opts *AlbumTra
 
 	params := url.Values{}
 	if opts != nil {
-		// Validate pagination parameters
-		if err := validatePaginationParams(opts.Limit, opts.Offset); err != nil {
+		if err := opts.Validate(); err != nil {
 			return nil, err
 		}
 		
 		if opts.Market != "" {
-			if err := validateMarketParameter(opts.Market); err != nil {
-				return nil, err
-			}
 			params.Set("market", opts.Market)
 		}
 		if opts.Limit > 0 {
@@ -887,36 +1100,44 @@ func (c *Client) AlbumTracks(ctx context.Context, albumID string, opts *AlbumTra
 
 // SearchOptions holds options for search
 type SearchOptions struct {
-	Market          string // ISO 3166-1 alpha-2 country code or "from_token"
-	Limit           int    // Default: 10, Min: 1, Max: 50
-	Offset          int    // Default: 0
-	IncludeExternal string // "audio" to include external audio content
+	Market          string       // ISO 3166-1 alpha-2 country code or "from_token"
+	Limit           int          // Default: 10, Min: 1, Max: 50
+	Offset          int          // Default: 0
+	IncludeExternal string       // "audio" to include external audio content
+	Types           []SearchType // Item types to search (takes precedence over the searchType argument)
 }
 
 // Search performs a search query
 // searchType: comma-separated types: 'artist', 'album', 'track', 'playlist', 'show', 'episode', 'audiobook'
 func (c *Client) Search(ctx context.Context, query, searchType string, opts *SearchOptions) (*SearchResponse, error) {
 	if query == "" {
-		return nil, fmt.Errorf("query is required")
+		return nil, &MissingOptionError{Field: "query"}
+	}
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	if opts != nil && len(opts.Types) > 0 {
+		joined, err := joinSearchTypes(opts.Types)
+		if err != nil {
+			return nil, err
+		}
+		searchType = joined
 	}
 	if searchType == "" {
 		searchType = "track" // Default
 	}
+	for _, t := range strings.Split(searchType, ",") {
+		if err := SearchType(strings.TrimSpace(t)).Validate(); err != nil {
+			return nil, err
+		}
+	}
 
 	params := url.Values{}
 	params.Set("q", query)
 	params.Set("type", searchType)
 
 	if opts != nil {
-		// Validate pagination parameters
-		if err := validatePaginationParams(opts.Limit, opts.Offset); err != nil {
-			return nil, err
-		}
-		
 		if opts.Market != "" {
-			if err := validateMarketParameter(opts.Market); err != nil {
-				return nil, err
-			}
 			params.Set("market", opts.Market)
 		}
 		if opts.Limit > 0 {
@@ -1008,8 +1229,7 @@ func (c *Client) PlaylistTracks(ctx context.Context, playlistID string, opts *Pl
 
 	params := url.Values{}
 	if opts != nil {
-		// Validate pagination parameters
-		if err := validatePaginationParams(opts.Limit, opts.Offset); err != nil {
+		if err := opts.Validate(); err != nil {
 			return nil, err
 		}
 		
@@ -1028,9 +1248,6 @@ func (c *Client) PlaylistTracks(ctx context.Context, playlistID string, opts *Pl
 			params.Set("offset", fmt.Sprintf("%d", opts.Offset))
 		}
 		if opts.Market != "" {
-			if err := validateMarketParameter(opts.Market); err != nil {
-				return nil, err
-			}
 			params.Set("market", opts.Market)
 		}
 		if opts.AdditionalTypes != "" {
@@ -1086,14 +1303,70 @@ func (c *Client) CurrentUserPlaylists(ctx context.Context, opts *CurrentUserPlay
 	return &result, nil
 }
 
-// UserPlaylistCreate creates a new playlist for a user
+// UserPlaylistsOptions holds options for a user's playlists
+type UserPlaylistsOptions struct {
+	Limit  int // Default: 20, Max: 50
+	Offset int // Default: 0, Max: 100,000
+}
+
+// UserPlaylists retrieves the public playlists owned or followed by a user
+//
+// Example:
+//
+//	page, err := client.UserPlaylists(ctx, "spotify", &spotigo.UserPlaylistsOptions{Limit: 50})
+//
+// See also: UserPlaylistsAll for retrieving every playlist.
+func (c *Client) UserPlaylists(ctx context.Context, userID string, opts *UserPlaylistsOptions) (*Paging[SimplifiedPlaylist], error) {
+	if userID == "" {
+		return nil, &MissingOptionError{Field: "userID"}
+	}
+
+	params := url.Values{}
+	if opts != nil {
+		// Validate pagination parameters
+		if err := validatePaginationParams(opts.Limit, opts.Offset); err != nil {
+			return nil, err
+		}
+
+		if opts.Limit > 0 {
+			if opts.Limit > 50 {
+				opts.Limit = 50
+			}
+			params.Set("limit", fmt.Sprintf("%d", opts.Limit))
+		} else {
+			params.Set("limit", "20") // Default
+		}
+		if opts.Offset > 0 {
+			params.Set("offset", fmt.Sprintf("%d", opts.Offset))
+		}
+	} else {
+		params.Set("limit", "20") // Default
+	}
+
+	var result Paging[SimplifiedPlaylist]
+	if err := c._get(ctx, fmt.Sprintf("users/%s/playlists", url.PathEscape(userID)), params, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// UserPlaylistCreate creates a new playlist for a user. An empty userID
+// means the current user (see MeID).
 func (c *Client) UserPlaylistCreate(ctx context.Context, userID string, opts *CreatePlaylistOptions) (*Playlist, error) {
 	if opts == nil {
-		return nil, fmt.Errorf("options are required")
+		return nil, &MissingOptionError{Field: "opts"}
 	}
 
 	if opts.Name == "" {
-		return nil, fmt.Errorf("playlist name is required")
+		return nil, &MissingOptionError{Field: "opts.Name"}
+	}
+
+	if userID == "" {
+		var err error
+		if userID, err = c.MeID(ctx); err != nil {
+			return nil, err
+		}
 	}
 
 	var result Playlist
@@ -1115,6 +1388,7 @@ type PlaylistAddItemsRequest struct {
 
 // PlaylistAddItems adds items (tracks or episodes) to a playlist
 // items: list of track/episode URIs, URLs, or IDs (empty array is accepted by API but will have no effect)
+// Raw IDs are treated as tracks; use PlaylistAddTypedItems to add episodes by raw ID.
 // position: optional position to insert items (0-based)
 func (c *Client) PlaylistAddItems(ctx context.Context, playlistID string, items []string, position ...int) (*PlaylistSnapshotID, error) {
 	id, err := GetID(playlistID, "playlist")
@@ -1123,64 +1397,26 @@ func (c *Client) PlaylistAddItems(ctx context.Context, playlistID string, items
 	}
 
 	if len(items) > 100 {
-		return nil, fmt.Errorf("maximum 100 items per request")
+		return nil, &TooManyIDsError{Kind: "items", Max: 100, Got: len(items)}
 	}
 
 	// Convert items to URIs, collecting invalid items
 	uris := make([]string, 0, len(items))
-	invalidItems := make([]string, 0)
-
-	for _, item := range items {
-		var uri string
-		var err error
-
-		// Check if already a URI
-		if IsURI(item) {
-			uris = append(uris, item)
-			continue
-		}
-
-		// Check if it's a URL - extract ID and determine type
-		if strings.Contains(item, "spotify.com") {
-			// Extract type from URL path
-			if strings.Contains(item, "/episode/") {
-				id, err := GetID(item, "episode")
-				if err == nil {
-					uri, err = GetURI(id, "episode")
-					if err == nil {
-						uris = append(uris, uri)
-						continue
-					}
-				}
-			} else {
-				// Default to track for URLs
-				id, err := GetID(item, "track")
-				if err == nil {
-					uri, err = GetURI(id, "track")
-					if err == nil {
-						uris = append(uris, uri)
-						continue
-					}
-				}
-			}
-		}
+	invalidItems := &MultiError{}
 
-		// Raw ID - try track first (most common), then episode
-		uri, err = GetURI(item, "track")
+	for i, item := range items {
+		uri, err := ItemURI(item)
 		if err != nil {
-			uri, err = GetURI(item, "episode")
-			if err != nil {
-				// Invalid item - collect for error reporting
-				invalidItems = append(invalidItems, item)
-				continue
-			}
+			// Invalid item - collect for error reporting
+			invalidItems.Add(i, item, err)
+			continue
 		}
 		uris = append(uris, uri)
 	}
 
 	// If all items are invalid, return error
-	if len(invalidItems) > 0 && len(uris) == 0 {
-		return nil, fmt.Errorf("all items invalid: %v", invalidItems)
+	if invalidItems.Len() > 0 && len(uris) == 0 {
+		return nil, fmt.Errorf("all items invalid: %w", invalidItems)
 	}
 
 	reqBody := PlaylistAddItemsRequest{
@@ -1189,7 +1425,7 @@ func (c *Client) PlaylistAddItems(ctx context.Context, playlistID string, items
 	if len(position) > 0 {
 		pos := position[0]
 		if pos < 0 {
-			return nil, fmt.Errorf("position must be non-negative, got %d", pos)
+			return nil, &InvalidOptionError{Field: "position", Reason: fmt.Sprintf("must be non-negative, got %d", pos)}
 		}
 		reqBody.Position = &pos
 	}
@@ -1200,13 +1436,34 @@ func (c *Client) PlaylistAddItems(ctx context.Context, playlistID string, items
 	}
 
 	// If there were invalid items but we processed valid ones, return result with error
-	if len(invalidItems) > 0 {
-		return &result, fmt.Errorf("some items could not be converted to URIs (processed %d valid items): %v", len(uris), invalidItems)
+	if invalidItems.Len() > 0 {
+		return &result, fmt.Errorf("some items could not be converted to URIs (processed %d valid items): %w", len(uris), invalidItems)
 	}
 
 	return &result, nil
 }
 
+// PlaylistAddTypedItems adds items whose type is stated explicitly, for
+// mixed lists of tracks and episodes given as raw IDs
+//
+// Example:
+//
+//	snapshot, err := client.PlaylistAddTypedItems(ctx, playlistID, []spotigo.TypedItem{
+//		{Kind: "track", ID: "4iV5W9uYEdYUVa79Axb7Rh"},
+//		{Kind: "episode", ID: "512ojhOuo1ktJprKbVcKyQ"},
+//	})
+func (c *Client) PlaylistAddTypedItems(ctx context.Context, playlistID string, items []TypedItem, position ...int) (*PlaylistSnapshotID, error) {
+	uris := make([]string, len(items))
+	for i, item := range items {
+		uri, err := item.URI()
+		if err != nil {
+			return nil, fmt.Errorf("item %d: %w", i, err)
+		}
+		uris[i] = uri
+	}
+	return c.PlaylistAddItems(ctx, playlistID, uris, position...)
+}
+
 // PlaylistReplaceItems replaces all items in a playlist
 // items: list of track/episode URIs, URLs, or IDs
 func (c *Client) PlaylistReplaceItems(ctx context.Context, playlistID string, items []string) (*PlaylistSnapshotID, error) {
@@ -1218,46 +1475,9 @@ func (c *Client) PlaylistReplaceItems(ctx context.Context, playlistID string, it
 	// Convert items to URIs (similar to PlaylistAddItems)
 	uris := make([]string, 0, len(items))
 	for _, item := range items {
-		var uri string
-		var convErr error
-
-		// Check if already a URI
-		if IsURI(item) {
-			uris = append(uris, item)
-			continue
-		}
-
-		// Check if it's a URL - extract ID and determine type
-		if strings.Contains(item, "spotify.com") {
-			if strings.Contains(item, "/episode/") {
-				extractedID, err := GetID(item, "episode")
-				if err == nil {
-					uri, convErr = GetURI(extractedID, "episode")
-					if convErr == nil {
-						uris = append(uris, uri)
-						continue
-					}
-				}
-			} else {
-				// Default to track for URLs
-				extractedID, err := GetID(item, "track")
-				if err == nil {
-					uri, convErr = GetURI(extractedID, "track")
-					if convErr == nil {
-						uris = append(uris, uri)
-						continue
-					}
-				}
-			}
-		}
-
-		// Raw ID - try track first, then episode
-		uri, convErr = GetURI(item, "track")
-		if convErr != nil {
-			uri, convErr = GetURI(item, "episode")
-			if convErr != nil {
-				return nil, fmt.Errorf("failed to convert item to URI: %w", convErr)
-			}
+		uri, err := ItemURI(item)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert item to URI: %w", err)
 		}
 		uris = append(uris, uri)
 	}
@@ -1282,7 +1502,7 @@ func (c *Client) PlaylistReorderItems(ctx context.Context, playlistID string, op
 	}
 
 	if opts == nil {
-		return nil, fmt.Errorf("options are required")
+		return nil, &MissingOptionError{Field: "opts"}
 	}
 
 	var result PlaylistSnapshotID
@@ -1355,6 +1575,11 @@ type CreatePlaylistOptions struct {
 	Public        *bool  `json:"public,omitempty"`
 	Collaborative *bool  `json:"collaborative,omitempty"`
 	Description   string `json:"description,omitempty"`
+
+	// Used by CreatePlaylist only; UserPlaylistCreate ignores them
+	CoverImage        []byte   `json:"-"` // JPEG or PNG cover, converted with PrepareCoverImage
+	InitialTracks     []string `json:"-"` // Track or episode IDs, URIs, or URLs to add
+	RollbackOnFailure bool     `json:"-"` // Unfollow (delete) the playlist if adding items or the cover fails
 }
 
 // PlaylistChangeDetails changes playlist details
@@ -1365,7 +1590,7 @@ func (c *Client) PlaylistChangeDetails(ctx context.Context, playlistID string, o
 	}
 
 	if opts == nil {
-		return fmt.Errorf("options are required")
+		return &MissingOptionError{Field: "opts"}
 	}
 
 	if err := c._put(ctx, fmt.Sprintf("playlists/%s", id), nil, opts, nil); err != nil {
@@ -1391,37 +1616,51 @@ func (c *Client) PlaylistCoverImage(ctx context.Context, playlistID string) ([]I
 }
 
 // PlaylistUploadCoverImage uploads a custom cover image for a playlist
-// imageData: JPEG image data (base64 encoded, max 256KB)
+// imageData: JPEG image data (max 256KB, base64 encoded before sending);
+// PrepareCoverImage converts and shrinks other images to fit
 func (c *Client) PlaylistUploadCoverImage(ctx context.Context, playlistID string, imageData []byte) error {
+	_, err := c.PlaylistUploadCoverImageStatus(ctx, playlistID, imageData)
+	return err
+}
+
+// PlaylistUploadCoverImageStatus uploads a cover image like
+// PlaylistUploadCoverImage and reports the response status.
+//
+// Spotify processes cover uploads asynchronously: a result for which
+// Accepted() is true means the image was queued and PlaylistCoverImage may
+// keep returning the old cover for a short time.
+//
+// Example:
+//
+//	result, err := client.PlaylistUploadCoverImageStatus(ctx, playlistID, cover)
+//	if err == nil && result.Accepted() {
+//		// Poll PlaylistCoverImage before showing the new cover
+//	}
+func (c *Client) PlaylistUploadCoverImageStatus(ctx context.Context, playlistID string, imageData []byte) (*AcceptedResult, error) {
 	id, err := GetID(playlistID, "playlist")
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// Validate image size (max 256KB)
 	const maxImageSize = 256 * 1024
 	if len(imageData) > maxImageSize {
-		return fmt.Errorf("image size exceeds maximum of 256KB: %d bytes", len(imageData))
+		return nil, &InvalidOptionError{Field: "image", Reason: fmt.Sprintf("must be at most 256KB, got %d bytes", len(imageData))}
 	}
 
 	// Validate that it's a JPEG (check magic bytes)
 	if len(imageData) < 2 || (imageData[0] != 0xFF || imageData[1] != 0xD8) {
-		return fmt.Errorf("image must be in JPEG format")
+		return nil, &InvalidOptionError{Field: "image", Reason: "must be in JPEG format"}
 	}
 
-	// Base64 encode the image data
-	encoded := base64.StdEncoding.EncodeToString(imageData)
-
-	// Set content type for image/jpeg
-	params := url.Values{}
-	params.Set("content_type", "image/jpeg")
-
-	// Send as string body (base64 encoded)
-	if err := c._put(ctx, fmt.Sprintf("playlists/%s/images", id), params, encoded, nil); err != nil {
-		return err
+	// Send base64 encoded with an image/jpeg content type
+	body := Base64ImageBody{ContentType: "image/jpeg", Data: imageData}
+	var result AcceptedResult
+	if err := c._put(ctx, fmt.Sprintf("playlists/%s/images", id), nil, body, &result); err != nil {
+		return nil, err
 	}
 
-	return nil
+	return &result, nil
 }
 
 // ============================================================================
@@ -1434,6 +1673,7 @@ func (c *Client) CurrentUser(ctx context.Context) (*User, error) {
 	if err := c._get(ctx, "me", nil, &result); err != nil {
 		return nil, err
 	}
+	c.currentUser.set(&result)
 
 	return &result, nil
 }
@@ -1463,15 +1703,11 @@ type SavedTracksOptions struct {
 func (c *Client) CurrentUserSavedTracks(ctx context.Context, opts *SavedTracksOptions) (*Paging[SavedTrack], error) {
 	params := url.Values{}
 	if opts != nil {
-		// Validate pagination parameters
-		if err := validatePaginationParams(opts.Limit, opts.Offset); err != nil {
+		if err := opts.Validate(); err != nil {
 			return nil, err
 		}
 		
 		if opts.Market != "" {
-			if err := validateMarketParameter(opts.Market); err != nil {
-				return nil, err
-			}
 			params.Set("market", opts.Market)
 		}
 		if opts.Limit > 0 {
@@ -1502,7 +1738,7 @@ func (c *Client) CurrentUserSavedTracks(ctx context.Context, opts *SavedTracksOp
 // trackIDs: list of track IDs, URIs, or URLs (empty array is accepted by API but will have no effect)
 func (c *Client) CurrentUserSavedTracksAdd(ctx context.Context, trackIDs []string) error {
 	if len(trackIDs) > 50 {
-		return fmt.Errorf("maximum 50 tracks per request")
+		return &TooManyIDsError{Kind: "tracks", Max: 50, Got: len(trackIDs)}
 	}
 
 	ids := make([]string, len(trackIDs))
@@ -1526,7 +1762,7 @@ func (c *Client) CurrentUserSavedTracksAdd(ctx context.Context, trackIDs []strin
 // trackIDs: list of track IDs, URIs, or URLs (empty array is accepted by API but will have no effect)
 func (c *Client) CurrentUserSavedTracksDelete(ctx context.Context, trackIDs []string) error {
 	if len(trackIDs) > 50 {
-		return fmt.Errorf("maximum 50 tracks per request")
+		return &TooManyIDsError{Kind: "tracks", Max: 50, Got: len(trackIDs)}
 	}
 
 	ids := make([]string, len(trackIDs))
@@ -1548,7 +1784,7 @@ func (c *Client) CurrentUserSavedTracksDelete(ctx context.Context, trackIDs []st
 // CurrentUserSavedTracksContains checks if tracks are saved
 func (c *Client) CurrentUserSavedTracksContains(ctx context.Context, trackIDs []string) ([]bool, error) {
 	if len(trackIDs) > 50 {
-		return nil, fmt.Errorf("maximum 50 tracks per request")
+		return nil, &TooManyIDsError{Kind: "tracks", Max: 50, Got: len(trackIDs)}
 	}
 
 	ids := make([]string, len(trackIDs))
@@ -1612,7 +1848,7 @@ func (c *Client) CurrentUserSavedAlbums(ctx context.Context, opts *SavedAlbumsOp
 // CurrentUserSavedAlbumsAdd adds albums to user's library
 func (c *Client) CurrentUserSavedAlbumsAdd(ctx context.Context, albumIDs []string) error {
 	if len(albumIDs) > 50 {
-		return fmt.Errorf("maximum 50 albums per request")
+		return &TooManyIDsError{Kind: "albums", Max: 50, Got: len(albumIDs)}
 	}
 
 	ids := make([]string, len(albumIDs))
@@ -1634,7 +1870,7 @@ func (c *Client) CurrentUserSavedAlbumsAdd(ctx context.Context, albumIDs []strin
 // CurrentUserSavedAlbumsDelete removes albums from user's library
 func (c *Client) CurrentUserSavedAlbumsDelete(ctx context.Context, albumIDs []string) error {
 	if len(albumIDs) > 50 {
-		return fmt.Errorf("maximum 50 albums per request")
+		return &TooManyIDsError{Kind: "albums", Max: 50, Got: len(albumIDs)}
 	}
 
 	ids := make([]string, len(albumIDs))
@@ -1656,7 +1892,7 @@ func (c *Client) CurrentUserSavedAlbumsDelete(ctx context.Context, albumIDs []st
 // CurrentUserSavedAlbumsContains checks if albums are saved
 func (c *Client) CurrentUserSavedAlbumsContains(ctx context.Context, albumIDs []string) ([]bool, error) {
 	if len(albumIDs) > 50 {
-		return nil, fmt.Errorf("maximum 50 albums per request")
+		return nil, &TooManyIDsError{Kind: "albums", Max: 50, Got: len(albumIDs)}
 	}
 
 	ids := make([]string, len(albumIDs))
@@ -1690,15 +1926,11 @@ type SavedEpisodesOptions struct {
 func (c *Client) CurrentUserSavedEpisodes(ctx context.Context, opts *SavedEpisodesOptions) (*Paging[SavedEpisode], error) {
 	params := url.Values{}
 	if opts != nil {
-		// Validate pagination parameters
-		if err := validatePaginationParams(opts.Limit, opts.Offset); err != nil {
+		if err := opts.Validate(); err != nil {
 			return nil, err
 		}
 		
 		if opts.Market != "" {
-			if err := validateMarketParameter(opts.Market); err != nil {
-				return nil, err
-			}
 			params.Set("market", opts.Market)
 		}
 		if opts.Limit > 0 {
@@ -1727,7 +1959,7 @@ func (c *Client) CurrentUserSavedEpisodes(ctx context.Context, opts *SavedEpisod
 // CurrentUserSavedEpisodesAdd adds episodes to user's library
 func (c *Client) CurrentUserSavedEpisodesAdd(ctx context.Context, episodeIDs []string) error {
 	if len(episodeIDs) > 50 {
-		return fmt.Errorf("maximum 50 episodes per request")
+		return &TooManyIDsError{Kind: "episodes", Max: 50, Got: len(episodeIDs)}
 	}
 
 	ids := make([]string, len(episodeIDs))
@@ -1749,7 +1981,7 @@ func (c *Client) CurrentUserSavedEpisodesAdd(ctx context.Context, episodeIDs []s
 // CurrentUserSavedEpisodesDelete removes episodes from user's library
 func (c *Client) CurrentUserSavedEpisodesDelete(ctx context.Context, episodeIDs []string) error {
 	if len(episodeIDs) > 50 {
-		return fmt.Errorf("maximum 50 episodes per request")
+		return &TooManyIDsError{Kind: "episodes", Max: 50, Got: len(episodeIDs)}
 	}
 
 	ids := make([]string, len(episodeIDs))
@@ -1771,7 +2003,7 @@ func (c *Client) CurrentUserSavedEpisodesDelete(ctx context.Context, episodeIDs
 // CurrentUserSavedEpisodesContains checks if episodes are saved
 func (c *Client) CurrentUserSavedEpisodesContains(ctx context.Context, episodeIDs []string) ([]bool, error) {
 	if len(episodeIDs) > 50 {
-		return nil, fmt.Errorf("maximum 50 episodes per request")
+		return nil, &TooManyIDsError{Kind: "episodes", Max: 50, Got: len(episodeIDs)}
 	}
 
 	ids := make([]string, len(episodeIDs))
@@ -1835,7 +2067,7 @@ func (c *Client) CurrentUserSavedShows(ctx context.Context, opts *SavedShowsOpti
 // CurrentUserSavedShowsAdd adds shows to user's library
 func (c *Client) CurrentUserSavedShowsAdd(ctx context.Context, showIDs []string) error {
 	if len(showIDs) > 50 {
-		return fmt.Errorf("maximum 50 shows per request")
+		return &TooManyIDsError{Kind: "shows", Max: 50, Got: len(showIDs)}
 	}
 
 	ids := make([]string, len(showIDs))
@@ -1857,7 +2089,7 @@ func (c *Client) CurrentUserSavedShowsAdd(ctx context.Context, showIDs []string)
 // CurrentUserSavedShowsDelete removes shows from user's library
 func (c *Client) CurrentUserSavedShowsDelete(ctx context.Context, showIDs []string) error {
 	if len(showIDs) > 50 {
-		return fmt.Errorf("maximum 50 shows per request")
+		return &TooManyIDsError{Kind: "shows", Max: 50, Got: len(showIDs)}
 	}
 
 	ids := make([]string, len(showIDs))
@@ -1879,7 +2111,7 @@ func (c *Client) CurrentUserSavedShowsDelete(ctx context.Context, showIDs []stri
 // CurrentUserSavedShowsContains checks if shows are saved
 func (c *Client) CurrentUserSavedShowsContains(ctx context.Context, showIDs []string) ([]bool, error) {
 	if len(showIDs) > 50 {
-		return nil, fmt.Errorf("maximum 50 shows per request")
+		return nil, &TooManyIDsError{Kind: "shows", Max: 50, Got: len(showIDs)}
 	}
 
 	ids := make([]string, len(showIDs))
@@ -1902,6 +2134,112 @@ func (c *Client) CurrentUserSavedShowsContains(ctx context.Context, showIDs []st
 	return result, nil
 }
 
+// SavedAudiobooksOptions holds options for saved audiobooks
+type SavedAudiobooksOptions struct {
+	Limit  int // Default: 20, Max: 50
+	Offset int // Default: 0
+}
+
+// CurrentUserSavedAudiobooks retrieves user's saved audiobooks
+func (c *Client) CurrentUserSavedAudiobooks(ctx context.Context, opts *SavedAudiobooksOptions) (*Paging[SimplifiedAudiobook], error) {
+	params := url.Values{}
+	if opts != nil {
+		// Validate pagination parameters
+		if err := validatePaginationParams(opts.Limit, opts.Offset); err != nil {
+			return nil, err
+		}
+
+		if opts.Limit > 0 {
+			if opts.Limit > 50 {
+				opts.Limit = 50
+			}
+			params.Set("limit", fmt.Sprintf("%d", opts.Limit))
+		} else {
+			params.Set("limit", "20") // Default
+		}
+		if opts.Offset > 0 {
+			params.Set("offset", fmt.Sprintf("%d", opts.Offset))
+		}
+	} else {
+		params.Set("limit", "20") // Default
+	}
+
+	var result Paging[SimplifiedAudiobook]
+	if err := c._get(ctx, "me/audiobooks", params, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// CurrentUserSavedAudiobooksAdd adds audiobooks to user's library
+func (c *Client) CurrentUserSavedAudiobooksAdd(ctx context.Context, audiobookIDs []string) error {
+	if len(audiobookIDs) > 50 {
+		return &TooManyIDsError{Kind: "audiobooks", Max: 50, Got: len(audiobookIDs)}
+	}
+
+	ids := make([]string, len(audiobookIDs))
+	for i, id := range audiobookIDs {
+		extracted, err := GetID(id, "audiobook")
+		if err != nil {
+			return err
+		}
+		ids[i] = extracted
+	}
+
+	params := url.Values{}
+	params.Set("ids", strings.Join(ids, ","))
+
+	return c._put(ctx, "me/audiobooks", params, nil, nil)
+}
+
+// CurrentUserSavedAudiobooksDelete removes audiobooks from user's library
+func (c *Client) CurrentUserSavedAudiobooksDelete(ctx context.Context, audiobookIDs []string) error {
+	if len(audiobookIDs) > 50 {
+		return &TooManyIDsError{Kind: "audiobooks", Max: 50, Got: len(audiobookIDs)}
+	}
+
+	ids := make([]string, len(audiobookIDs))
+	for i, id := range audiobookIDs {
+		extracted, err := GetID(id, "audiobook")
+		if err != nil {
+			return err
+		}
+		ids[i] = extracted
+	}
+
+	params := url.Values{}
+	params.Set("ids", strings.Join(ids, ","))
+
+	return c._internal_call(ctx, "DELETE", "me/audiobooks", params, nil, nil)
+}
+
+// CurrentUserSavedAudiobooksContains checks if audiobooks are saved
+func (c *Client) CurrentUserSavedAudiobooksContains(ctx context.Context, audiobookIDs []string) ([]bool, error) {
+	if len(audiobookIDs) > 50 {
+		return nil, &TooManyIDsError{Kind: "audiobooks", Max: 50, Got: len(audiobookIDs)}
+	}
+
+	ids := make([]string, len(audiobookIDs))
+	for i, id := range audiobookIDs {
+		extracted, err := GetID(id, "audiobook")
+		if err != nil {
+			return nil, err
+		}
+		ids[i] = extracted
+	}
+
+	params := url.Values{}
+	params.Set("ids", strings.Join(ids, ","))
+
+	var result []bool
+	if err := c._get(ctx, "me/audiobooks/contains", params, &result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
 // ============================================================================
 // Category 9: Following
 // ============================================================================
@@ -1952,7 +2290,7 @@ func (c *Client) CurrentUserFollowedArtists(ctx context.Context, opts *FollowedA
 // CurrentUserFollowingArtists checks if user follows artists
 func (c *Client) CurrentUserFollowingArtists(ctx context.Context, artistIDs []string) ([]bool, error) {
 	if len(artistIDs) > 50 {
-		return nil, fmt.Errorf("maximum 50 artists per request")
+		return nil, &TooManyIDsError{Kind: "artists", Max: 50, Got: len(artistIDs)}
 	}
 
 	ids := make([]string, len(artistIDs))
@@ -1979,7 +2317,7 @@ func (c *Client) CurrentUserFollowingArtists(ctx context.Context, artistIDs []st
 // CurrentUserFollowingUsers checks if user follows users
 func (c *Client) CurrentUserFollowingUsers(ctx context.Context, userIDs []string) ([]bool, error) {
 	if len(userIDs) > 50 {
-		return nil, fmt.Errorf("maximum 50 users per request")
+		return nil, &TooManyIDsError{Kind: "users", Max: 50, Got: len(userIDs)}
 	}
 
 	params := url.Values{}
@@ -1999,7 +2337,7 @@ func (c *Client) CurrentUserFollowingUsers(ctx context.Context, userIDs []string
 // artistIDs: list of artist IDs, URIs, or URLs (empty array is accepted by API but will have no effect)
 func (c *Client) UserFollowArtists(ctx context.Context, artistIDs []string) error {
 	if len(artistIDs) > 50 {
-		return fmt.Errorf("maximum 50 artists per request")
+		return &TooManyIDsError{Kind: "artists", Max: 50, Got: len(artistIDs)}
 	}
 
 	ids := make([]string, len(artistIDs))
@@ -2023,7 +2361,7 @@ func (c *Client) UserFollowArtists(ctx context.Context, artistIDs []string) erro
 // userIDs: list of user IDs, URIs, or URLs (empty array is accepted by API but will have no effect)
 func (c *Client) UserFollowUsers(ctx context.Context, userIDs []string) error {
 	if len(userIDs) > 50 {
-		return fmt.Errorf("maximum 50 users per request")
+		return &TooManyIDsError{Kind: "users", Max: 50, Got: len(userIDs)}
 	}
 
 	body := map[string]interface{}{
@@ -2039,7 +2377,7 @@ func (c *Client) UserFollowUsers(ctx context.Context, userIDs []string) error {
 // artistIDs: list of artist IDs, URIs, or URLs (empty array is accepted by API but will have no effect)
 func (c *Client) UserUnfollowArtists(ctx context.Context, artistIDs []string) error {
 	if len(artistIDs) > 50 {
-		return fmt.Errorf("maximum 50 artists per request")
+		return &TooManyIDsError{Kind: "artists", Max: 50, Got: len(artistIDs)}
 	}
 
 	ids := make([]string, len(artistIDs))
@@ -2064,7 +2402,7 @@ func (c *Client) UserUnfollowArtists(ctx context.Context, artistIDs []string) er
 // userIDs: list of user IDs, URIs, or URLs (empty array is accepted by API but will have no effect)
 func (c *Client) UserUnfollowUsers(ctx context.Context, userIDs []string) error {
 	if len(userIDs) > 50 {
-		return fmt.Errorf("maximum 50 users per request")
+		return &TooManyIDsError{Kind: "users", Max: 50, Got: len(userIDs)}
 	}
 
 	body := map[string]interface{}{
@@ -2103,7 +2441,7 @@ func (c *Client) CurrentUserUnfollowPlaylist(ctx context.Context, playlistID str
 // PlaylistIsFollowing checks if users follow a playlist
 func (c *Client) PlaylistIsFollowing(ctx context.Context, playlistID string, userIDs []string) ([]bool, error) {
 	if len(userIDs) > 5 {
-		return nil, fmt.Errorf("maximum 5 users per request")
+		return nil, &TooManyIDsError{Kind: "users", Max: 5, Got: len(userIDs)}
 	}
 
 	id, err := GetID(playlistID, "playlist")
@@ -2126,24 +2464,55 @@ func (c *Client) PlaylistIsFollowing(ctx context.Context, playlistID string, use
 // Category 10: User Data (Top Tracks/Artists, Recently Played)
 // ============================================================================
 
+// TopItemsMaxOffset is the largest offset top items can be requested at;
+// Spotify returns no items past it, so at most 99 top items are available
+const TopItemsMaxOffset = 49
+
 // TopItemsOptions holds options for top items
 type TopItemsOptions struct {
-	TimeRange string // "short_term", "medium_term", "long_term" (default: "medium_term")
-	Limit     int    // Default: 20, Max: 50
-	Offset    int    // Default: 0
+	Range  TimeRange // Time range (default: TimeRangeMedium; takes precedence over TimeRange)
+	Limit  int       // Default: 20, Max: 50
+	Offset int       // Default: 0, Max: TopItemsMaxOffset
+
+	// Deprecated: Use Range, which is checked at compile time.
+	TimeRange string // "short_term", "medium_term", "long_term"
+}
+
+// rangeValue returns the requested time range, from Range or the
+// deprecated TimeRange
+func (o *TopItemsOptions) rangeValue() TimeRange {
+	if o.Range != "" {
+		return o.Range
+	}
+	return TimeRange(o.TimeRange)
+}
+
+// timeRange returns the validated time range parameter, or "" for the default
+func (o *TopItemsOptions) timeRange() (string, error) {
+	timeRange := o.rangeValue()
+	if timeRange == "" {
+		return "", nil
+	}
+	if err := timeRange.Validate(); err != nil {
+		return "", err
+	}
+	return string(timeRange), nil
 }
 
 // CurrentUserTopTracks retrieves user's top tracks
 func (c *Client) CurrentUserTopTracks(ctx context.Context, opts *TopItemsOptions) (*Paging[Track], error) {
 	params := url.Values{}
 	if opts != nil {
-		// Validate pagination parameters
-		if err := validatePaginationParams(opts.Limit, opts.Offset); err != nil {
+		if err := opts.Validate(); err != nil {
 			return nil, err
 		}
 		
-		if opts.TimeRange != "" {
-			params.Set("time_range", opts.TimeRange)
+		timeRange, err := opts.timeRange()
+		if err != nil {
+			return nil, err
+		}
+		if timeRange != "" {
+			params.Set("time_range", timeRange)
 		}
 		if opts.Limit > 0 {
 			if opts.Limit > 50 {
@@ -2172,13 +2541,16 @@ func (c *Client) CurrentUserTopTracks(ctx context.Context, opts *TopItemsOptions
 func (c *Client) CurrentUserTopArtists(ctx context.Context, opts *TopItemsOptions) (*Paging[Artist], error) {
 	params := url.Values{}
 	if opts != nil {
-		// Validate pagination parameters
-		if err := validatePaginationParams(opts.Limit, opts.Offset); err != nil {
+		if err := opts.Validate(); err != nil {
 			return nil, err
 		}
 		
-		if opts.TimeRange != "" {
-			params.Set("time_range", opts.TimeRange)
+		timeRange, err := opts.timeRange()
+		if err != nil {
+			return nil, err
+		}
+		if timeRange != "" {
+			params.Set("time_range", timeRange)
 		}
 		if opts.Limit > 0 {
 			if opts.Limit > 50 {
@@ -2272,6 +2644,10 @@ type BrowseCategoriesOptions struct {
 	Locale  string // ISO 639-1 language code and ISO 3166-1 alpha-2 country code
 	Limit   int    // Default: 20, Max: 50
 	Offset  int    // Default: 0
+
+	// LocaleFallback makes BrowseCategory retry a 404 with broader locales,
+	// e.g. "es_MX", then "es", then no locale
+	LocaleFallback bool
 }
 
 // BrowseCategories retrieves browse categories
@@ -2314,24 +2690,47 @@ func (c *Client) BrowseCategories(ctx context.Context, opts *BrowseCategoriesOpt
 	return &result.Categories, nil
 }
 
-// BrowseCategory retrieves a single category
+// BrowseCategory retrieves a single category. With opts.LocaleFallback,
+// a 404 for the locale (opts.Locale, or else the context's or client's
+// default locale) is retried with each broader locale in turn, then without
+// a locale, and only the last error is returned.
 func (c *Client) BrowseCategory(ctx context.Context, categoryID string, opts *BrowseCategoriesOptions) (*Category, error) {
 	params := url.Values{}
+	locales := []string{""}
 	if opts != nil {
 		if opts.Country != "" {
 			params.Set("country", opts.Country)
 		}
-		if opts.Locale != "" {
-			params.Set("locale", opts.Locale)
+		locales = []string{opts.Locale}
+		if opts.LocaleFallback {
+			locale := opts.Locale
+			if locale == "" {
+				locale = c.requestLocale(ctx)
+			}
+			locales = localeFallbacks(locale)
 		}
 	}
 
-	var result Category
-	if err := c._get(ctx, fmt.Sprintf("browse/categories/%s", categoryID), params, &result); err != nil {
-		return nil, err
-	}
+	var err error
+	for _, locale := range locales {
+		requestCtx := ctx
+		params.Del("locale")
+		if locale != "" {
+			params.Set("locale", locale)
+		} else if len(locales) > 1 {
+			// The last fallback must not get the default locale back
+			requestCtx = contextWithoutLocale(ctx)
+		}
 
-	return &result, nil
+		var result Category
+		if err = c._get(requestCtx, fmt.Sprintf("browse/categories/%s", categoryID), params, &result); err == nil {
+			return &result, nil
+		}
+		if !isNotFoundError(err) {
+			return nil, err
+		}
+	}
+	return nil, err
 }
 
 // FeaturedPlaylistsOptions holds options for featured playlists
@@ -2349,7 +2748,8 @@ type FeaturedPlaylistsResponse struct {
 	Playlists Paging[SimplifiedPlaylist] `json:"playlists"`
 }
 
-// BrowseFeaturedPlaylists retrieves featured playlists
+// BrowseFeaturedPlaylists retrieves featured playlists.
+// Spotify has sunset this endpoint; see EndpointFeaturedPlaylists.
 func (c *Client) BrowseFeaturedPlaylists(ctx context.Context, opts *FeaturedPlaylistsOptions) (*FeaturedPlaylistsResponse, error) {
 	params := url.Values{}
 	if opts != nil {
@@ -2383,7 +2783,10 @@ func (c *Client) BrowseFeaturedPlaylists(ctx context.Context, opts *FeaturedPlay
 	}
 
 	var result FeaturedPlaylistsResponse
-	if err := c._get(ctx, "browse/featured-playlists", params, &result); err != nil {
+	err := c.callDeprecated(EndpointFeaturedPlaylists, func() error {
+		return c._get(ctx, "browse/featured-playlists", params, &result)
+	}, nil)
+	if err != nil {
 		return nil, err
 	}
 
@@ -2449,7 +2852,8 @@ type CategoryPlaylistsResponse struct {
 	Playlists Paging[SimplifiedPlaylist] `json:"playlists"`
 }
 
-// BrowseCategoryPlaylists retrieves playlists for a category
+// BrowseCategoryPlaylists retrieves playlists for a category.
+// Spotify has sunset this endpoint; see EndpointCategoryPlaylists.
 func (c *Client) BrowseCategoryPlaylists(ctx context.Context, categoryID string, opts *CategoryPlaylistsOptions) (*CategoryPlaylistsResponse, error) {
 	params := url.Values{}
 	if opts != nil {
@@ -2476,12 +2880,18 @@ func (c *Client) BrowseCategoryPlaylists(ctx context.Context, categoryID string,
 		params.Set("limit", "20") // Default
 	}
 
-	var result CategoryPlaylistsResponse
-	if err := c._get(ctx, fmt.Sprintf("browse/categories/%s/playlists", categoryID), params, &result); err != nil {
+	result := &CategoryPlaylistsResponse{}
+	err := c.callDeprecated(EndpointCategoryPlaylists, func() error {
+		return c._get(ctx, fmt.Sprintf("browse/categories/%s/playlists", categoryID), params, result)
+	}, func() (err error) {
+		result, err = c.searchCategoryPlaylists(ctx, categoryID, opts)
+		return err
+	})
+	if err != nil {
 		return nil, err
 	}
 
-	return &result, nil
+	return result, nil
 }
 
 // ============================================================================
@@ -2539,28 +2949,29 @@ type RecommendationsOptions struct {
 	TargetValence          *float64
 }
 
-// Recommendations retrieves track recommendations
+// Recommendations retrieves track recommendations.
+// Spotify has sunset this endpoint; see EndpointRecommendations.
 func (c *Client) Recommendations(ctx context.Context, opts *RecommendationsOptions) (*RecommendationsResponse, error) {
 	if opts == nil {
-		return nil, fmt.Errorf("options are required")
+		return nil, &MissingOptionError{Field: "opts"}
 	}
 
 	// Validate seed parameters (at least one required, max 5 total)
 	totalSeeds := len(opts.SeedArtists) + len(opts.SeedGenres) + len(opts.SeedTracks)
 	if totalSeeds == 0 {
-		return nil, fmt.Errorf("at least one seed (artist, genre, or track) is required")
+		return nil, &MissingOptionError{Field: "seed (artist, genre, or track)"}
 	}
 	if totalSeeds > 5 {
-		return nil, fmt.Errorf("maximum 5 seeds total (artists + genres + tracks)")
+		return nil, &TooManyIDsError{Kind: "seeds", Max: 5, Got: totalSeeds}
 	}
 	if len(opts.SeedArtists) > 5 {
-		return nil, fmt.Errorf("maximum 5 seed artists")
+		return nil, &TooManyIDsError{Kind: "seed artists", Max: 5, Got: len(opts.SeedArtists)}
 	}
 	if len(opts.SeedGenres) > 5 {
-		return nil, fmt.Errorf("maximum 5 seed genres")
+		return nil, &TooManyIDsError{Kind: "seed genres", Max: 5, Got: len(opts.SeedGenres)}
 	}
 	if len(opts.SeedTracks) > 5 {
-		return nil, fmt.Errorf("maximum 5 seed tracks")
+		return nil, &TooManyIDsError{Kind: "seed tracks", Max: 5, Got: len(opts.SeedTracks)}
 	}
 
 	params := url.Values{}
@@ -2658,20 +3069,26 @@ func (c *Client) Recommendations(ctx context.Context, opts *RecommendationsOptio
 	addFloatParam("target_valence", opts.TargetValence)
 
 	var result RecommendationsResponse
-	if err := c._get(ctx, "recommendations", params, &result); err != nil {
+	err := c.callDeprecated(EndpointRecommendations, func() error {
+		return c._get(ctx, "recommendations", params, &result)
+	}, nil)
+	if err != nil {
 		return nil, err
 	}
 
 	return &result, nil
 }
 
-// RecommendationGenreSeeds retrieves available genre seeds for recommendations
-// Note: This endpoint may be deprecated by Spotify
+// RecommendationGenreSeeds retrieves available genre seeds for recommendations.
+// Spotify has sunset this endpoint; see EndpointGenreSeeds.
 func (c *Client) RecommendationGenreSeeds(ctx context.Context) ([]string, error) {
 	var result struct {
 		Genres []string `json:"genres"`
 	}
-	if err := c._get(ctx, "recommendations/available-genre-seeds", nil, &result); err != nil {
+	err := c.callDeprecated(EndpointGenreSeeds, func() error {
+		return c._get(ctx, "recommendations/available-genre-seeds", nil, &result)
+	}, nil)
+	if err != nil {
 		return nil, err
 	}
 
@@ -2682,7 +3099,8 @@ func (c *Client) RecommendationGenreSeeds(ctx context.Context) ([]string, error)
 // Category 13: Audio Features
 // ============================================================================
 
-// AudioFeatures retrieves audio features for a track
+// AudioFeatures retrieves audio features for a track.
+// Spotify has sunset this endpoint; see EndpointAudioFeatures.
 func (c *Client) AudioFeatures(ctx context.Context, trackID string) (*AudioFeatures, error) {
 	id, err := GetID(trackID, "track")
 	if err != nil {
@@ -2690,17 +3108,21 @@ func (c *Client) AudioFeatures(ctx context.Context, trackID string) (*AudioFeatu
 	}
 
 	var result AudioFeatures
-	if err := c._get(ctx, fmt.Sprintf("audio-features/%s", id), nil, &result); err != nil {
+	err = c.callDeprecated(EndpointAudioFeatures, func() error {
+		return c._get(ctx, fmt.Sprintf("audio-features/%s", id), nil, &result)
+	}, nil)
+	if err != nil {
 		return nil, err
 	}
 
 	return &result, nil
 }
 
-// AudioFeaturesMultiple retrieves audio features for multiple tracks
+// AudioFeaturesMultiple retrieves audio features for multiple tracks.
+// Spotify has sunset this endpoint; see EndpointAudioFeatures.
 func (c *Client) AudioFeaturesMultiple(ctx context.Context, trackIDs []string) ([]AudioFeatures, error) {
 	if len(trackIDs) > 100 {
-		return nil, fmt.Errorf("maximum 100 tracks per request")
+		return nil, &TooManyIDsError{Kind: "tracks", Max: 100, Got: len(trackIDs)}
 	}
 
 	ids := make([]string, len(trackIDs))
@@ -2718,14 +3140,18 @@ func (c *Client) AudioFeaturesMultiple(ctx context.Context, trackIDs []string) (
 	var result struct {
 		AudioFeatures []AudioFeatures `json:"audio_features"`
 	}
-	if err := c._get(ctx, "audio-features", params, &result); err != nil {
+	err := c.callDeprecated(EndpointAudioFeatures, func() error {
+		return c._get(ctx, "audio-features", params, &result)
+	}, nil)
+	if err != nil {
 		return nil, err
 	}
 
 	return result.AudioFeatures, nil
 }
 
-// AudioAnalysis retrieves detailed audio analysis for a track
+// AudioAnalysis retrieves detailed audio analysis for a track.
+// Spotify has sunset this endpoint; see EndpointAudioAnalysis.
 func (c *Client) AudioAnalysis(ctx context.Context, trackID string) (*AudioAnalysis, error) {
 	id, err := GetID(trackID, "track")
 	if err != nil {
@@ -2733,7 +3159,10 @@ func (c *Client) AudioAnalysis(ctx context.Context, trackID string) (*AudioAnaly
 	}
 
 	var result AudioAnalysis
-	if err := c._get(ctx, fmt.Sprintf("audio-analysis/%s", id), nil, &result); err != nil {
+	err = c.callDeprecated(EndpointAudioAnalysis, func() error {
+		return c._get(ctx, fmt.Sprintf("audio-analysis/%s", id), nil, &result)
+	}, nil)
+	if err != nil {
 		return nil, err
 	}
 
@@ -2797,7 +3226,14 @@ func (c *Client) CurrentUserPlaybackState(ctx context.Context, opts *CurrentlyPl
 }
 
 // CurrentUserDevices retrieves user's available devices
+// Results are cached for DeviceCacheTTL; use RefreshDevices to bypass the cache.
 func (c *Client) CurrentUserDevices(ctx context.Context) ([]Device, error) {
+	if devices, ok := c.deviceCache.get(c.DeviceCacheTTL); ok {
+		c.stats.recordCache(true)
+		return devices, nil
+	}
+	c.stats.recordCache(false)
+
 	var result struct {
 		Devices []Device `json:"devices"`
 	}
@@ -2805,6 +3241,7 @@ func (c *Client) CurrentUserDevices(ctx context.Context) ([]Device, error) {
 		return nil, err
 	}
 
+	c.deviceCache.set(result.Devices)
 	return result.Devices, nil
 }
 
@@ -2816,7 +3253,7 @@ type TransferPlaybackOptions struct {
 // CurrentUserTransferPlayback transfers playback to a device
 func (c *Client) CurrentUserTransferPlayback(ctx context.Context, deviceIDs []string, opts *TransferPlaybackOptions) error {
 	if len(deviceIDs) == 0 {
-		return fmt.Errorf("at least one device ID is required")
+		return &MissingOptionError{Field: "deviceIDs"}
 	}
 
 	body := map[string]interface{}{
@@ -2826,7 +3263,13 @@ func (c *Client) CurrentUserTransferPlayback(ctx context.Context, deviceIDs []st
 		body["play"] = opts.Play
 	}
 
-	return c._put(ctx, "me/player", nil, body, nil)
+	if err := c._put(ctx, "me/player", nil, body, nil); err != nil {
+		return err
+	}
+
+	// Active device changed - cached device list is stale
+	c.deviceCache.invalidate()
+	return nil
 }
 
 // StartPlaybackOptions holds options for starting playback
@@ -2839,7 +3282,13 @@ type StartPlaybackOptions struct {
 }
 
 // CurrentUserStartPlayback starts or resumes playback
+// ContextURI and URIs are mutually exclusive; Offset requires one of them.
+// See PlayTracks, PlayContext, and PlayContextFromURI for convenience wrappers.
 func (c *Client) CurrentUserStartPlayback(ctx context.Context, opts *StartPlaybackOptions) error {
+	if err := validateStartPlaybackOptions(opts); err != nil {
+		return err
+	}
+
 	params := url.Values{}
 	body := map[string]interface{}{}
 
@@ -2888,7 +3337,7 @@ type SeekToPositionOptions struct {
 // CurrentUserSeekToPosition seeks to position in currently playing track
 func (c *Client) CurrentUserSeekToPosition(ctx context.Context, opts *SeekToPositionOptions) error {
 	if opts == nil {
-		return fmt.Errorf("options are required")
+		return &MissingOptionError{Field: "opts"}
 	}
 
 	params := url.Values{}
@@ -2902,18 +3351,29 @@ func (c *Client) CurrentUserSeekToPosition(ctx context.Context, opts *SeekToPosi
 
 // SetRepeatModeOptions holds options for setting repeat mode
 type SetRepeatModeOptions struct {
-	State    string // "track", "context", "off"
-	DeviceID string // Device ID
+	Mode     RepeatState // Repeat mode (takes precedence over State)
+	DeviceID string      // Device ID
+
+	// Deprecated: Use Mode, which is checked at compile time.
+	State string // "track", "context", "off"
 }
 
 // CurrentUserSetRepeatMode sets repeat mode
 func (c *Client) CurrentUserSetRepeatMode(ctx context.Context, opts *SetRepeatModeOptions) error {
 	if opts == nil {
-		return fmt.Errorf("options are required")
+		return &MissingOptionError{Field: "opts"}
+	}
+
+	mode := opts.Mode
+	if mode == "" {
+		mode = RepeatState(opts.State)
+	}
+	if err := mode.Validate(); err != nil {
+		return err
 	}
 
 	params := url.Values{}
-	params.Set("state", opts.State)
+	params.Set("state", string(mode))
 	if opts.DeviceID != "" {
 		params.Set("device_id", opts.DeviceID)
 	}
@@ -2930,11 +3390,11 @@ type SetVolumeOptions struct {
 // CurrentUserSetVolume sets playback volume
 func (c *Client) CurrentUserSetVolume(ctx context.Context, opts *SetVolumeOptions) error {
 	if opts == nil {
-		return fmt.Errorf("options are required")
+		return &MissingOptionError{Field: "opts"}
 	}
 
 	if opts.VolumePercent < 0 || opts.VolumePercent > 100 {
-		return fmt.Errorf("volume must be between 0 and 100")
+		return &InvalidOptionError{Field: "volume", Reason: fmt.Sprintf("must be between 0 and 100, got %d", opts.VolumePercent)}
 	}
 
 	params := url.Values{}
@@ -2955,7 +3415,7 @@ type ToggleShuffleOptions struct {
 // CurrentUserToggleShuffle toggles shuffle mode
 func (c *Client) CurrentUserToggleShuffle(ctx context.Context, opts *ToggleShuffleOptions) error {
 	if opts == nil {
-		return fmt.Errorf("options are required")
+		return &MissingOptionError{Field: "opts"}
 	}
 
 	params := url.Values{}
@@ -3094,7 +3554,7 @@ func (c *Client) Show(ctx context.Context, showID string, market ...string) (*Sh
 // Shows retrieves multiple shows by IDs, URIs, or URLs
 func (c *Client) Shows(ctx context.Context, showIDs []string, market ...string) (*ShowsResponse, error) {
 	if len(showIDs) > 50 {
-		return nil, fmt.Errorf("maximum 50 shows per request")
+		return nil, &TooManyIDsError{Kind: "shows", Max: 50, Got: len(showIDs)}
 	}
 
 	ids := make([]string, len(showIDs))
@@ -3139,15 +3599,11 @@ func (c *Client) ShowEpisodes(ctx context.Context, showID string, opts *ShowEpis
 
 	params := url.Values{}
 	if opts != nil {
-		// Validate pagination parameters
-		if err := validatePaginationParams(opts.Limit, opts.Offset); err != nil {
+		if err := opts.Validate(); err != nil {
 			return nil, err
 		}
 		
 		if opts.Market != "" {
-			if err := validateMarketParameter(opts.Market); err != nil {
-				return nil, err
-			}
 			params.Set("market", opts.Market)
 		}
 		if opts.Limit > 0 {
@@ -3199,7 +3655,7 @@ func (c *Client) Episode(ctx context.Context, episodeID string, market ...string
 // Episodes retrieves multiple episodes by IDs, URIs, or URLs
 func (c *Client) Episodes(ctx context.Context, episodeIDs []string, market ...string) (*EpisodesResponse, error) {
 	if len(episodeIDs) > 50 {
-		return nil, fmt.Errorf("maximum 50 episodes per request")
+		return nil, &TooManyIDsError{Kind: "episodes", Max: 50, Got: len(episodeIDs)}
 	}
 
 	ids := make([]string, len(episodeIDs))
@@ -3232,7 +3688,8 @@ func (c *Client) Episodes(ctx context.Context, episodeIDs []string, market ...st
 // Category 4: Audiobooks
 // ============================================================================
 
-// GetAudiobook retrieves a single audiobook by ID, URI, or URL
+// GetAudiobook retrieves a single audiobook by ID, URI, or URL.
+// Audiobooks and their chapters differ by market.
 func (c *Client) GetAudiobook(ctx context.Context, audiobookID string, market ...string) (*Audiobook, error) {
 	id, err := GetID(audiobookID, "audiobook")
 	if err != nil {
@@ -3255,10 +3712,11 @@ func (c *Client) GetAudiobook(ctx context.Context, audiobookID string, market ..
 	return &result, nil
 }
 
-// GetAudiobooks retrieves multiple audiobooks by IDs, URIs, or URLs
+// GetAudiobooks retrieves multiple audiobooks by IDs, URIs, or URLs.
+// Audiobooks and their chapters differ by market.
 func (c *Client) GetAudiobooks(ctx context.Context, audiobookIDs []string, market ...string) (*AudiobooksResponse, error) {
 	if len(audiobookIDs) > 50 {
-		return nil, fmt.Errorf("maximum 50 audiobooks per request")
+		return nil, &TooManyIDsError{Kind: "audiobooks", Max: 50, Got: len(audiobookIDs)}
 	}
 
 	ids := make([]string, len(audiobookIDs))
@@ -3303,15 +3761,11 @@ func (c *Client) GetAudiobookChapters(ctx context.Context, audiobookID string, o
 
 	params := url.Values{}
 	if opts != nil {
-		// Validate pagination parameters
-		if err := validatePaginationParams(opts.Limit, opts.Offset); err != nil {
+		if err := opts.Validate(); err != nil {
 			return nil, err
 		}
 		
 		if opts.Market != "" {
-			if err := validateMarketParameter(opts.Market); err != nil {
-				return nil, err
-			}
 			params.Set("market", opts.Market)
 		}
 		if opts.Limit > 0 {
@@ -3337,6 +3791,117 @@ func (c *Client) GetAudiobookChapters(ctx context.Context, audiobookID string, o
 	return &result, nil
 }
 
+// GetChapter retrieves a single audiobook chapter by ID, URI, or URL.
+// Chapters are only available in some markets.
+func (c *Client) GetChapter(ctx context.Context, chapterID string, market ...string) (*Chapter, error) {
+	id, err := GetID(chapterID, "chapter")
+	if err != nil {
+		return nil, err
+	}
+
+	params := url.Values{}
+	if len(market) > 0 && market[0] != "" {
+		if err := validateMarketParameter(market[0]); err != nil {
+			return nil, err
+		}
+		params.Set("market", market[0])
+	}
+
+	var result Chapter
+	if err := c._get(ctx, fmt.Sprintf("chapters/%s", id), params, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// GetChapters retrieves multiple audiobook chapters by IDs, URIs, or URLs
+func (c *Client) GetChapters(ctx context.Context, chapterIDs []string, market ...string) (*ChaptersResponse, error) {
+	if len(chapterIDs) > 50 {
+		return nil, &TooManyIDsError{Kind: "chapters", Max: 50, Got: len(chapterIDs)}
+	}
+
+	ids := make([]string, len(chapterIDs))
+	for i, id := range chapterIDs {
+		extracted, err := GetID(id, "chapter")
+		if err != nil {
+			return nil, err
+		}
+		ids[i] = extracted
+	}
+
+	params := url.Values{}
+	params.Set("ids", strings.Join(ids, ","))
+	if len(market) > 0 && market[0] != "" {
+		if err := validateMarketParameter(market[0]); err != nil {
+			return nil, err
+		}
+		params.Set("market", market[0])
+	}
+
+	var result ChaptersResponse
+	if err := c._get(ctx, "chapters", params, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// Do sends a request to any Web API endpoint with the client's
+// authentication, retries, rate limiting, and error handling, for endpoints
+// that have no dedicated method yet.
+//
+// path is relative to APIPrefix (e.g. "me/following") or an absolute URL
+// under APIPrefix, such as a pagination link; other hosts are rejected so
+// the access token is never sent elsewhere.
+// body is encoded like other requests: JSON by default, or with an encoder
+// registered for its type (see WithBodyEncoder). The response is decoded
+// into result as JSON; pass nil to discard it, or an *AcceptedResult to
+// receive the status code and headers of an empty response.
+//
+// Example:
+//
+//	var out struct {
+//		Genres []string `json:"genres"`
+//	}
+//	err := client.Do(ctx, http.MethodGet, "recommendations/available-genre-seeds", nil, nil, &out)
+func (c *Client) Do(ctx context.Context, method, path string, params url.Values, body interface{}, result interface{}) error {
+	if path == "" {
+		return &MissingOptionError{Field: "path"}
+	}
+	switch method {
+	case http.MethodGet, http.MethodPost, http.MethodPut, http.MethodDelete, http.MethodPatch:
+	default:
+		return &InvalidOptionError{Field: "method", Reason: fmt.Sprintf("must be GET, POST, PUT, DELETE, or PATCH, got %q", method)}
+	}
+	if (strings.HasPrefix(path, "http://") || strings.HasPrefix(path, "https://")) && !c.underAPIPrefix(path) {
+		return &InvalidOptionError{Field: "path", Reason: fmt.Sprintf("must be under the API prefix %q, got %q", c.APIPrefix, path)}
+	}
+	return c._internal_call(ctx, method, path, params, body, result)
+}
+
+// underAPIPrefix reports whether an absolute URL has the scheme and host of
+// APIPrefix and a path under its path. Comparing parsed URLs rather than
+// strings keeps "https://api.spotify.com.evil.example" and userinfo tricks
+// from passing.
+func (c *Client) underAPIPrefix(rawURL string) bool {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.User != nil {
+		return false
+	}
+	prefix, err := url.Parse(c.APIPrefix)
+	if err != nil {
+		return false
+	}
+	if !strings.EqualFold(u.Scheme, prefix.Scheme) || !strings.EqualFold(u.Host, prefix.Host) {
+		return false
+	}
+
+	prefixPath := strings.TrimSuffix(prefix.Path, "/")
+	cleaned := path.Clean("/" + u.Path)
+	return prefixPath == "" || cleaned == prefixPath || strings.HasPrefix(cleaned, prefixPath+"/")
+}
+
 // _get performs a GET request
 func (c *Client) _get(ctx context.Context, urlStr string, params url.Values, result interface{}) error {
 	return c._internal_call(ctx, "GET", urlStr, params, nil, result)
@@ -0,0 +1,169 @@
+package spotigo
+
+import (
+	"container/list"
+	"context"
+	"net/http"
+	"sync"
+)
+
+// ============================================================================
+// Client Pool
+// ============================================================================
+
+// DefaultClientPoolSize is the number of clients a ClientPool keeps when no
+// size is given
+const DefaultClientPoolSize = 1000
+
+// AuthFactory creates the auth manager for a user, typically an OAuth
+// manager with a per-user token cache
+type AuthFactory func(ctx context.Context, userID string) (AuthManager, error)
+
+// ClientPool hands out one Client per user for multi-tenant backends.
+//
+// All clients in the pool share one http.Client, so connections to the API
+// are reused across users, and one rate limit tracker, since Spotify rate
+// limits apply per app rather than per user. Each client has its own auth
+// manager, created on first use by the pool's AuthFactory. When the pool is
+// full, the least recently used client is evicted; it is recreated (and its
+// token reloaded from its cache) the next time the user is seen.
+//
+// A ClientPool is safe for concurrent use.
+type ClientPool struct {
+	newAuth AuthFactory
+	opts    []ClientOption
+	size    int
+
+	mu         sync.Mutex
+	clients    map[string]*list.Element // Values are *pooledClient
+	lru        *list.List               // Most recently used first
+	httpClient *http.Client             // Shared by every client, set by the first one
+	rateLimit  *rateLimitTracker        // Shared by every client
+}
+
+// pooledClient is an LRU list entry
+type pooledClient struct {
+	userID string
+	client *Client
+}
+
+// NewClientPool creates a pool that keeps up to size clients (0 uses
+// DefaultClientPoolSize). opts configure every client in the pool.
+//
+// Example:
+//
+//	pool, err := spotigo.NewClientPool(func(ctx context.Context, userID string) (spotigo.AuthManager, error) {
+//		auth, err := spotigo.NewSpotifyOAuth("", "", "", scopes)
+//		if err != nil {
+//			return nil, err
+//		}
+//		auth.CacheHandler, err = spotigo.NewFileCacheHandler("", userID)
+//		return auth, err
+//	}, 0)
+//	if err != nil {
+//		return err
+//	}
+//
+//	client, err := pool.Get(ctx, session.UserID)
+func NewClientPool(newAuth AuthFactory, size int, opts ...ClientOption) (*ClientPool, error) {
+	if newAuth == nil {
+		return nil, &MissingOptionError{Field: "newAuth"}
+	}
+	if size <= 0 {
+		size = DefaultClientPoolSize
+	}
+	return &ClientPool{
+		newAuth:   newAuth,
+		opts:      opts,
+		size:      size,
+		clients:   make(map[string]*list.Element),
+		lru:       list.New(),
+		rateLimit: &rateLimitTracker{},
+	}, nil
+}
+
+// Get returns the client for userID, creating it if it is not in the pool
+func (p *ClientPool) Get(ctx context.Context, userID string) (*Client, error) {
+	if userID == "" {
+		return nil, &MissingOptionError{Field: "userID"}
+	}
+
+	if client := p.lookup(userID); client != nil {
+		return client, nil
+	}
+
+	// Create the auth manager without holding the lock, since factories may
+	// read token caches
+	auth, err := p.newAuth(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	// Another caller may have created the client meanwhile
+	if element, ok := p.clients[userID]; ok {
+		p.lru.MoveToFront(element)
+		return element.Value.(*pooledClient).client, nil
+	}
+
+	opts := p.opts
+	if p.httpClient != nil {
+		opts = append(opts[:len(opts):len(opts)], WithHTTPClient(p.httpClient))
+	}
+	client, err := NewClient(auth, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if p.httpClient == nil {
+		p.httpClient = client.HTTPClient
+	}
+	client.rateLimit = p.rateLimit
+
+	p.clients[userID] = p.lru.PushFront(&pooledClient{userID: userID, client: client})
+	for p.lru.Len() > p.size {
+		oldest := p.lru.Back()
+		p.lru.Remove(oldest)
+		delete(p.clients, oldest.Value.(*pooledClient).userID)
+	}
+	return client, nil
+}
+
+// lookup returns the pooled client for userID and marks it recently used,
+// or nil if there is none
+func (p *ClientPool) lookup(userID string) *Client {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	element, ok := p.clients[userID]
+	if !ok {
+		return nil
+	}
+	p.lru.MoveToFront(element)
+	return element.Value.(*pooledClient).client
+}
+
+// Remove drops the client for userID from the pool, for example when the
+// user logs out. It is a no-op if the user has no client.
+func (p *ClientPool) Remove(userID string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if element, ok := p.clients[userID]; ok {
+		p.lru.Remove(element)
+		delete(p.clients, userID)
+	}
+}
+
+// Len returns the number of clients in the pool
+func (p *ClientPool) Len() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.lru.Len()
+}
+
+// RateLimitState returns the rate limit state shared by the pool's clients
+func (p *ClientPool) RateLimitState() RateLimitState {
+	return p.rateLimit.get()
+}
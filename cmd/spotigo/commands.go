@@ -0,0 +1,396 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/sv4u/spotigo"
+)
+
+// flagSet returns a flag set for a command that reports errors instead of
+// exiting
+func (a *app) flagSet(name string) *flag.FlagSet {
+	flags := flag.NewFlagSet("spotigo "+name, flag.ContinueOnError)
+	flags.SetOutput(a.errOut)
+	return flags
+}
+
+// search prints catalog search results, one per line
+func (a *app) search(ctx context.Context, args []string) error {
+	flags := a.flagSet("search")
+	searchType := flags.String("type", "track", "result type: track, album, artist, playlist, show, episode, or audiobook")
+	limit := flags.Int("limit", 10, "maximum number of results (1-50)")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() == 0 {
+		return errors.New("search: a query is required")
+	}
+
+	client, err := a.newClient(ctx, false)
+	if err != nil {
+		return err
+	}
+
+	results, err := client.Search(ctx, strings.Join(flags.Args(), " "), "", &spotigo.SearchOptions{
+		Types: []spotigo.SearchType{spotigo.SearchType(*searchType)},
+		Limit: *limit,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, line := range searchLines(results) {
+		fmt.Fprintln(a.out, line)
+	}
+	return nil
+}
+
+// searchLines formats search results as "URI  name" lines
+func searchLines(results *spotigo.SearchResponse) []string {
+	var lines []string
+	if results.Tracks != nil {
+		for _, track := range results.Tracks.Items {
+			lines = append(lines, fmt.Sprintf("%s  %s — %s", track.URI, track.Name, artistNames(track.Artists)))
+		}
+	}
+	if results.Albums != nil {
+		for _, album := range results.Albums.Items {
+			lines = append(lines, fmt.Sprintf("%s  %s — %s", album.URI, album.Name, artistNames(album.Artists)))
+		}
+	}
+	if results.Artists != nil {
+		for _, artist := range results.Artists.Items {
+			lines = append(lines, fmt.Sprintf("%s  %s", artist.URI, artist.Name))
+		}
+	}
+	if results.Playlists != nil {
+		for _, playlist := range results.Playlists.Items {
+			lines = append(lines, fmt.Sprintf("%s  %s", playlist.URI, playlist.Name))
+		}
+	}
+	if results.Shows != nil {
+		for _, show := range results.Shows.Items {
+			lines = append(lines, fmt.Sprintf("%s  %s", show.URI, show.Name))
+		}
+	}
+	if results.Episodes != nil {
+		for _, episode := range results.Episodes.Items {
+			lines = append(lines, fmt.Sprintf("%s  %s", episode.URI, episode.Name))
+		}
+	}
+	if results.Audiobooks != nil {
+		for _, audiobook := range results.Audiobooks.Items {
+			lines = append(lines, fmt.Sprintf("%s  %s", audiobook.URI, audiobook.Name))
+		}
+	}
+	return lines
+}
+
+// artistNames joins artist names with commas
+func artistNames(artists []spotigo.Artist) string {
+	names := make([]string, 0, len(artists))
+	for _, artist := range artists {
+		names = append(names, artist.Name)
+	}
+	return strings.Join(names, ", ")
+}
+
+// play resumes playback, or starts a track, episode, or context
+func (a *app) play(ctx context.Context, args []string) error {
+	flags := a.flagSet("play")
+	device := flags.String("device", "", "name of the device to play on (default: the active device)")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() > 1 {
+		return errors.New("play: at most one URI or URL is allowed")
+	}
+
+	opts := &spotigo.StartPlaybackOptions{}
+	if flags.NArg() == 1 {
+		kind, _, err := spotigo.ParseAny(flags.Arg(0))
+		if err != nil {
+			return fmt.Errorf("play: %w", err)
+		}
+		uri, err := spotigo.TypedItem{Kind: kind, ID: flags.Arg(0)}.URI()
+		if err != nil {
+			return fmt.Errorf("play: %w", err)
+		}
+		if kind == "track" || kind == "episode" {
+			opts.URIs = []string{uri}
+		} else {
+			opts.ContextURI = uri
+		}
+	}
+
+	client, err := a.newClient(ctx, false)
+	if err != nil {
+		return err
+	}
+
+	if *device != "" {
+		return client.StartPlaybackOnDevice(ctx, *device, opts)
+	}
+	return client.CurrentUserStartPlayback(ctx, opts)
+}
+
+// pause pauses playback
+func (a *app) pause(ctx context.Context, args []string) error {
+	flags := a.flagSet("pause")
+	device := flags.String("device", "", "name of the device to pause (default: the active device)")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	client, err := a.newClient(ctx, false)
+	if err != nil {
+		return err
+	}
+
+	if *device != "" {
+		return client.PausePlaybackOnDevice(ctx, *device)
+	}
+	return client.CurrentUserPausePlayback(ctx, nil)
+}
+
+// nowPlaying prints the current item, and with -watch a new line whenever
+// the item or play/pause state changes
+func (a *app) nowPlaying(ctx context.Context, args []string) error {
+	flags := a.flagSet("now-playing")
+	watch := flags.Bool("watch", false, "keep printing changes until interrupted")
+	interval := flags.Duration("interval", 5*time.Second, "polling interval with -watch")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	client, err := a.newClient(ctx, false)
+	if err != nil {
+		return err
+	}
+
+	if !*watch {
+		state, err := client.CurrentUserPlaybackState(ctx, &spotigo.CurrentlyPlayingOptions{AdditionalTypes: "track,episode"})
+		if err != nil {
+			return err
+		}
+		if state.Item == nil {
+			state = nil
+		}
+		fmt.Fprintln(a.out, describePlayback(state))
+		return nil
+	}
+
+	for event := range client.WatchPlayback(ctx, *interval) {
+		if event.Err != nil {
+			fmt.Fprintln(a.errOut, "spotigo: playback poll failed:", event.Err)
+			continue
+		}
+		if event.ItemChanged || event.PlayingChanged {
+			fmt.Fprintln(a.out, describePlayback(event.State))
+		}
+	}
+	return ctx.Err()
+}
+
+// describePlayback formats a playback state as one line
+func describePlayback(state *spotigo.PlaybackState) string {
+	if state == nil || state.Item == nil {
+		return "Nothing playing"
+	}
+
+	var item struct {
+		Name    string `json:"name"`
+		Artists []struct {
+			Name string `json:"name"`
+		} `json:"artists"`
+		Show *struct {
+			Name string `json:"name"`
+		} `json:"show"`
+	}
+	if data, err := json.Marshal(state.Item); err == nil {
+		json.Unmarshal(data, &item)
+	}
+
+	by := make([]string, 0, len(item.Artists))
+	for _, artist := range item.Artists {
+		by = append(by, artist.Name)
+	}
+	if item.Show != nil {
+		by = append(by, item.Show.Name)
+	}
+
+	status := "▶"
+	if !state.IsPlaying {
+		status = "⏸"
+	}
+	line := status + " " + item.Name
+	if len(by) > 0 {
+		line += " — " + strings.Join(by, ", ")
+	}
+	if state.Device != nil {
+		line += " (on " + state.Device.Name + ")"
+	}
+	return line
+}
+
+// playlistFile is the JSON document written by playlist export
+type playlistFile struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	Public      *bool    `json:"public,omitempty"`
+	Items       []string `json:"items"`              // Track and episode URIs in playlist order
+	AddedAt     []string `json:"added_at,omitempty"` // When each item was added, parallel to Items (not restored on import)
+}
+
+// playlist dispatches the playlist subcommands
+func (a *app) playlist(ctx context.Context, args []string) error {
+	if len(args) == 0 {
+		return errors.New("playlist: expected 'export' or 'import'")
+	}
+	switch args[0] {
+	case "export":
+		return a.playlistExport(ctx, args[1:])
+	case "import":
+		return a.playlistImport(ctx, args[1:])
+	default:
+		return fmt.Errorf("playlist: unknown subcommand %q", args[0])
+	}
+}
+
+// playlistExport writes a playlist's details and items as JSON
+func (a *app) playlistExport(ctx context.Context, args []string) error {
+	flags := a.flagSet("playlist export")
+	outPath := flags.String("o", "", "file to write (default: standard output)")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() != 1 {
+		return errors.New("playlist export: exactly one playlist ID, URI, or URL is required")
+	}
+
+	client, err := a.newClient(ctx, false)
+	if err != nil {
+		return err
+	}
+
+	playlist, err := client.Playlist(ctx, flags.Arg(0), nil)
+	if err != nil {
+		return err
+	}
+	file := playlistFile{Name: playlist.Name, Public: playlist.Public, Items: []string{}}
+	if playlist.Description != nil {
+		file.Description = spotigo.UnescapePlaylistDescription(*playlist.Description)
+	}
+
+	page, err := client.PlaylistTracks(ctx, playlist.ID, &spotigo.PlaylistTracksOptions{
+		Fields:          "items(added_at,is_local,track(uri)),next",
+		Limit:           100,
+		AdditionalTypes: "track,episode",
+	})
+	for err == nil && page != nil {
+		for _, item := range page.Items {
+			if uri := playlistItemURI(item); uri != "" {
+				file.Items = append(file.Items, uri)
+				file.AddedAt = append(file.AddedAt, item.AddedAt)
+			}
+		}
+		page, err = spotigo.NextGeneric[spotigo.PlaylistTrack](client, ctx, page)
+	}
+	if err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(file, "", "  ")
+	if err != nil {
+		return err
+	}
+	data = append(data, '\n')
+	if *outPath == "" {
+		_, err = a.out.Write(data)
+		return err
+	}
+	if err := os.WriteFile(*outPath, data, 0o644); err != nil {
+		return err
+	}
+	fmt.Fprintf(a.errOut, "Exported %d items to %s\n", len(file.Items), *outPath)
+	return nil
+}
+
+// playlistItemURI returns the URI of a playlist item, or "" for local files
+// and items removed from Spotify, which cannot be re-added
+func playlistItemURI(item spotigo.PlaylistTrack) string {
+	if track, ok := item.AsTrack(); ok && !track.IsLocal {
+		return track.URI
+	}
+	if episode, ok := item.AsEpisode(); ok {
+		return episode.URI
+	}
+	return ""
+}
+
+// playlistImport creates a playlist for the current user from an exported
+// file ("-" reads standard input)
+func (a *app) playlistImport(ctx context.Context, args []string) error {
+	flags := a.flagSet("playlist import")
+	name := flags.String("name", "", "name of the new playlist (default: the exported name)")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() != 1 {
+		return errors.New("playlist import: exactly one file is required")
+	}
+
+	var data []byte
+	var err error
+	if flags.Arg(0) == "-" {
+		data, err = io.ReadAll(os.Stdin)
+	} else {
+		data, err = os.ReadFile(flags.Arg(0))
+	}
+	if err != nil {
+		return err
+	}
+
+	var file playlistFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return fmt.Errorf("playlist import: invalid playlist file: %w", err)
+	}
+	if *name != "" {
+		file.Name = *name
+	}
+	if file.Name == "" {
+		return errors.New("playlist import: the file has no name; use -name")
+	}
+
+	client, err := a.newClient(ctx, false)
+	if err != nil {
+		return err
+	}
+
+	playlist, err := client.UserPlaylistCreate(ctx, "", &spotigo.CreatePlaylistOptions{
+		Name:        file.Name,
+		Public:      file.Public,
+		Description: file.Description,
+	})
+	if err != nil {
+		return err
+	}
+
+	for start := 0; start < len(file.Items); start += 100 {
+		end := min(start+100, len(file.Items))
+		if _, err := client.PlaylistAddItems(ctx, playlist.ID, file.Items[start:end]); err != nil {
+			return fmt.Errorf("created %s but failed to add items %d-%d: %w", playlist.URI, start, end-1, err)
+		}
+	}
+
+	fmt.Fprintf(a.out, "Created %s with %d items\n", playlist.URI, len(file.Items))
+	return nil
+}
@@ -0,0 +1,160 @@
+// Command spotigo is a small command-line client for common Spotify tasks,
+// built on the spotigo library.
+//
+// Prerequisites:
+//   - Set SPOTIGO_CLIENT_ID environment variable
+//   - Set SPOTIGO_CLIENT_SECRET environment variable
+//   - Set SPOTIGO_REDIRECT_URI environment variable (e.g., http://localhost:8080/callback)
+//   - Add redirect URI to your Spotify app settings
+//
+// Usage:
+//
+//	spotigo login
+//	spotigo search [-type track] [-limit 10] <query>
+//	spotigo play [-device <name>] [<URI or URL>]
+//	spotigo pause [-device <name>]
+//	spotigo now-playing [-watch] [-interval 5s]
+//	spotigo playlist export [-o file] <playlist>
+//	spotigo playlist import [-name <name>] <file>
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+
+	"github.com/sv4u/spotigo"
+)
+
+// scopes are the permissions every command together needs, requested once
+// at login
+const scopes = "user-read-playback-state user-modify-playback-state user-read-currently-playing " +
+	"playlist-read-private playlist-read-collaborative playlist-modify-private playlist-modify-public"
+
+const usage = `Usage: spotigo <command> [flags] [args]
+
+Commands:
+  login                       authorize spotigo with your Spotify account
+  search <query>              search the catalog
+  play [<URI or URL>]         resume playback, or play a track, album, or playlist
+  pause                       pause playback
+  now-playing                 show what is playing
+  playlist export <playlist>  write a playlist as JSON
+  playlist import <file>      create a playlist from an exported JSON file
+
+Run 'spotigo <command> -h' for a command's flags.
+`
+
+// errNotLoggedIn is returned when a command needs a token and none is cached
+var errNotLoggedIn = errors.New("not logged in: run 'spotigo login' first")
+
+// app holds the command environment so commands can be tested against a
+// mock server
+type app struct {
+	out       io.Writer
+	errOut    io.Writer
+	newClient func(ctx context.Context, login bool) (*spotigo.Client, error)
+}
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	a := &app{out: os.Stdout, errOut: os.Stderr, newClient: newClient}
+	if err := a.run(ctx, os.Args[1:]); err != nil && ctx.Err() == nil {
+		fmt.Fprintln(os.Stderr, "spotigo:", err)
+		os.Exit(1)
+	}
+}
+
+// run dispatches args to a command
+func (a *app) run(ctx context.Context, args []string) error {
+	if len(args) == 0 {
+		fmt.Fprint(a.errOut, usage)
+		return errors.New("no command given")
+	}
+
+	command, args := args[0], args[1:]
+	switch command {
+	case "login":
+		return a.login(ctx, args)
+	case "search":
+		return a.search(ctx, args)
+	case "play":
+		return a.play(ctx, args)
+	case "pause":
+		return a.pause(ctx, args)
+	case "now-playing":
+		return a.nowPlaying(ctx, args)
+	case "playlist":
+		return a.playlist(ctx, args)
+	case "help", "-h", "--help":
+		fmt.Fprint(a.out, usage)
+		return nil
+	default:
+		fmt.Fprint(a.errOut, usage)
+		return fmt.Errorf("unknown command %q", command)
+	}
+}
+
+// newClient authorizes with the Authorization Code flow. With login set it
+// always runs the browser flow; otherwise it requires a cached token.
+func newClient(ctx context.Context, login bool) (*spotigo.Client, error) {
+	auth, err := spotigo.NewSpotifyOAuth("", "", "", scopes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OAuth: %w", err)
+	}
+
+	cache, err := spotigo.NewFileCacheHandler("", os.Getenv("SPOTIGO_CLIENT_USERNAME"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create token cache: %w", err)
+	}
+	auth.CacheHandler = cache
+
+	if login {
+		code, err := auth.GetAuthorizationCode(ctx, true)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get authorization code: %w", err)
+		}
+		if err := auth.ExchangeCode(ctx, code); err != nil {
+			return nil, fmt.Errorf("failed to exchange code: %w", err)
+		}
+	} else {
+		token, err := auth.GetCachedToken(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get cached token: %w", err)
+		}
+		if token == nil {
+			return nil, errNotLoggedIn
+		}
+	}
+
+	return spotigo.NewClient(auth)
+}
+
+// login runs the authorization flow and caches the token
+func (a *app) login(ctx context.Context, args []string) error {
+	flags := a.flagSet("login")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	client, err := a.newClient(ctx, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := client.CurrentUser(ctx)
+	if err != nil {
+		return err
+	}
+	name := user.ID
+	if user.DisplayName != nil && *user.DisplayName != "" {
+		name = *user.DisplayName
+	}
+	fmt.Fprintf(a.out, "Logged in as %s\n", name)
+	return nil
+}
@@ -0,0 +1,187 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/sv4u/spotigo"
+	"github.com/sv4u/spotigo/tests"
+)
+
+// newTestApp creates an app whose commands use a client pointed at server
+func newTestApp(t *testing.T, server *httptest.Server) (*app, *bytes.Buffer) {
+	t.Helper()
+	var out bytes.Buffer
+	return &app{
+		out:    &out,
+		errOut: &bytes.Buffer{},
+		newClient: func(ctx context.Context, login bool) (*spotigo.Client, error) {
+			auth := &tests.MockAuthManager{
+				Token: &spotigo.TokenInfo{AccessToken: "test_token", TokenType: "Bearer"},
+			}
+			return spotigo.NewClient(auth, spotigo.WithAPIPrefix(server.URL+"/"))
+		},
+	}, &out
+}
+
+func TestUnknownCommand(t *testing.T) {
+	a := &app{out: &bytes.Buffer{}, errOut: &bytes.Buffer{}}
+	if err := a.run(context.Background(), []string{"dance"}); err == nil {
+		t.Error("expected error for unknown command")
+	}
+	if err := a.run(context.Background(), nil); err == nil {
+		t.Error("expected error without a command")
+	}
+}
+
+func TestSearch(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("q"); got != "paranoid android" {
+			t.Errorf("q = %q", got)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"tracks": {"items": [{"uri": "spotify:track:6LgJvl0Xdtc73RJ1mmpotq",
+			"name": "Paranoid Android", "artists": [{"name": "Radiohead"}]}]}}`))
+	}))
+	defer server.Close()
+
+	a, out := newTestApp(t, server)
+	if err := a.run(context.Background(), []string{"search", "paranoid", "android"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := out.String(); got != "spotify:track:6LgJvl0Xdtc73RJ1mmpotq  Paranoid Android — Radiohead\n" {
+		t.Errorf("output = %q", got)
+	}
+}
+
+func TestPlay(t *testing.T) {
+	var bodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body bytes.Buffer
+		body.ReadFrom(r.Body)
+		bodies = append(bodies, strings.TrimSpace(body.String()))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	a, _ := newTestApp(t, server)
+	ctx := context.Background()
+	for _, args := range [][]string{
+		{"play", "https://open.spotify.com/album/04xe676vyiTeYNXw15o9jT?si=1"},
+		{"play", "spotify:track:6LgJvl0Xdtc73RJ1mmpotq"},
+	} {
+		if err := a.run(ctx, args); err != nil {
+			t.Fatalf("%v: unexpected error: %v", args, err)
+		}
+	}
+
+	if len(bodies) != 2 || !strings.Contains(bodies[0], `"context_uri":"spotify:album:04xe676vyiTeYNXw15o9jT"`) ||
+		!strings.Contains(bodies[1], `"uris":["spotify:track:6LgJvl0Xdtc73RJ1mmpotq"]`) {
+		t.Errorf("request bodies = %v", bodies)
+	}
+
+	if err := a.run(ctx, []string{"play", "6LgJvl0Xdtc73RJ1mmpotq"}); err == nil {
+		t.Error("expected error for a raw ID")
+	}
+}
+
+func TestNowPlaying(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"is_playing": false, "device": {"name": "Kitchen"},
+			"item": {"name": "Karma Police", "artists": [{"name": "Radiohead"}]}}`))
+	}))
+	defer server.Close()
+
+	a, out := newTestApp(t, server)
+	if err := a.run(context.Background(), []string{"now-playing"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := out.String(); got != "⏸ Karma Police — Radiohead (on Kitchen)\n" {
+		t.Errorf("output = %q", got)
+	}
+}
+
+func TestPlaylistExportImport(t *testing.T) {
+	var added [][]string
+	var created map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/playlists/37i9dQZF1DXcBWIGoYBM5M":
+			w.Write([]byte(`{"id": "37i9dQZF1DXcBWIGoYBM5M", "name": "Mix", "description": "Rock &amp; roll"}`))
+		case r.URL.Path == "/playlists/37i9dQZF1DXcBWIGoYBM5M/tracks":
+			w.Write([]byte(`{"items": [
+				{"added_at": "2024-03-01T12:00:00Z", "track": {"uri": "spotify:track:6LgJvl0Xdtc73RJ1mmpotq"}},
+				{"is_local": true, "track": {"uri": "spotify:local:a:b:c:1"}},
+				{"track": null},
+				{"added_at": "2024-03-02T08:30:00Z", "track": {"uri": "spotify:episode:512ojhOuo1ktJprKbVcKyQ"}}
+			]}`))
+		case r.URL.Path == "/me":
+			w.Write([]byte(`{"id": "wizzler"}`))
+		case r.URL.Path == "/users/wizzler/playlists":
+			json.NewDecoder(r.Body).Decode(&created)
+			w.Write([]byte(`{"id": "2oCEWyyAPbZp9xhVSxZavx", "uri": "spotify:playlist:2oCEWyyAPbZp9xhVSxZavx"}`))
+		case r.URL.Path == "/playlists/2oCEWyyAPbZp9xhVSxZavx/tracks":
+			var body struct {
+				URIs []string `json:"uris"`
+			}
+			json.NewDecoder(r.Body).Decode(&body)
+			added = append(added, body.URIs)
+			w.Write([]byte(`{"snapshot_id": "s"}`))
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	a, out := newTestApp(t, server)
+	ctx := context.Background()
+
+	if err := a.run(ctx, []string{"playlist", "export", "spotify:playlist:37i9dQZF1DXcBWIGoYBM5M"}); err != nil {
+		t.Fatalf("export failed: %v", err)
+	}
+	var exported playlistFile
+	if err := json.Unmarshal(out.Bytes(), &exported); err != nil {
+		t.Fatalf("invalid export: %v\n%s", err, out.String())
+	}
+	if exported.Name != "Mix" || exported.Description != "Rock & roll" || len(exported.Items) != 2 {
+		t.Errorf("exported = %+v", exported)
+	}
+	if len(exported.AddedAt) != 2 || exported.AddedAt[1] != "2024-03-02T08:30:00Z" {
+		t.Errorf("exported added_at = %v", exported.AddedAt)
+	}
+
+	// Import a larger file to exercise batching
+	for i := 0; i < 148; i++ {
+		exported.Items = append(exported.Items, fmt.Sprintf("spotify:track:%022d", i))
+	}
+	path := filepath.Join(t.TempDir(), "mix.json")
+	data, _ := json.Marshal(exported)
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	out.Reset()
+	if err := a.run(ctx, []string{"playlist", "import", "-name", "Mix copy", path}); err != nil {
+		t.Fatalf("import failed: %v", err)
+	}
+	if created["name"] != "Mix copy" || created["description"] != "Rock & roll" {
+		t.Errorf("created = %v", created)
+	}
+	if len(added) != 2 || len(added[0]) != 100 || len(added[1]) != 50 || added[0][1] != "spotify:episode:512ojhOuo1ktJprKbVcKyQ" {
+		t.Errorf("added batches = %d", len(added))
+	}
+	if !strings.Contains(out.String(), "Created spotify:playlist:2oCEWyyAPbZp9xhVSxZavx with 150 items") {
+		t.Errorf("output = %q", out.String())
+	}
+}
@@ -0,0 +1,119 @@
+package spotigo
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+)
+
+// ============================================================================
+// Request Coalescing
+// ============================================================================
+
+// WithRequestCoalescing makes concurrent identical GET requests share one
+// network request, for UIs where several components fetch the same track or
+// album at once. Requests are identical when their full URLs, including the
+// market and locale defaults, match. Requests made with ContextWithRawResponse
+// or ContextWithItemStream are never shared.
+//
+// Example:
+//
+//	client, err := spotigo.NewClient(auth, spotigo.WithRequestCoalescing(true))
+func WithRequestCoalescing(enabled bool) ClientOption {
+	return func(c *Client) {
+		c.CoalesceRequests = enabled
+	}
+}
+
+// coalescedKey marks the context of the request made for a coalesced group
+type coalescedKey struct{}
+
+// capturedBody is a result that receives the raw response body instead of
+// decoding it
+type capturedBody []byte
+
+// inflightRequest is a GET request that others can wait on
+type inflightRequest struct {
+	done chan struct{}
+	body []byte
+	err  error
+}
+
+// requestGroup tracks in-flight GET requests by URL
+type requestGroup struct {
+	mu       sync.Mutex
+	requests map[string]*inflightRequest
+}
+
+// shouldCoalesce reports whether a request may share another's response
+func (c *Client) shouldCoalesce(ctx context.Context, method string, result interface{}) bool {
+	if !c.CoalesceRequests || method != http.MethodGet || result == nil {
+		return false
+	}
+	if ctx.Value(coalescedKey{}) != nil || ctx.Value(itemStreamKey{}) != nil {
+		return false
+	}
+	if raw, ok := ctx.Value(rawResponseKey{}).(*RawResponse); ok && raw != nil {
+		return false
+	}
+	return true
+}
+
+// coalesce decodes into result the body of the in-flight request for
+// fullURL, starting one with fetch if there is none. Waiters whose request
+// failed only because the first caller's context ended fetch for
+// themselves.
+func (c *Client) coalesce(ctx context.Context, fullURL string, result interface{}, fetch func(context.Context) ([]byte, error)) error {
+	c.inflight.mu.Lock()
+	if request, ok := c.inflight.requests[fullURL]; ok {
+		c.inflight.mu.Unlock()
+
+		select {
+		case <-request.done:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		body, err := request.body, request.err
+		if err != nil && isContextError(err) && ctx.Err() == nil {
+			body, err = fetch(ctx)
+		}
+		if err != nil {
+			return err
+		}
+		return c.decodeCoalesced(body, result)
+	}
+
+	request := &inflightRequest{done: make(chan struct{})}
+	if c.inflight.requests == nil {
+		c.inflight.requests = make(map[string]*inflightRequest)
+	}
+	c.inflight.requests[fullURL] = request
+	c.inflight.mu.Unlock()
+
+	request.body, request.err = fetch(ctx)
+
+	c.inflight.mu.Lock()
+	delete(c.inflight.requests, fullURL)
+	c.inflight.mu.Unlock()
+	close(request.done)
+
+	if request.err != nil {
+		return request.err
+	}
+	return c.decodeCoalesced(request.body, result)
+}
+
+// decodeCoalesced decodes a shared body; empty bodies (204 No Content)
+// leave result unchanged
+func (c *Client) decodeCoalesced(body []byte, result interface{}) error {
+	if len(body) == 0 {
+		return nil
+	}
+	return c.decodeResponse(body, result)
+}
+
+// isContextError reports whether err comes from a canceled or expired context
+func isContextError(err error) bool {
+	return errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)
+}
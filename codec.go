@@ -0,0 +1,51 @@
+package spotigo
+
+import "encoding/json"
+
+// ============================================================================
+// JSON Codec
+// ============================================================================
+
+// Codec marshals JSON request bodies and unmarshals JSON responses. Plug in a
+// faster JSON library, or add decoding hooks, with WithCodec. Codecs must
+// honor json.Marshaler and json.Unmarshaler, which the models use to keep
+// unknown fields in Extras.
+type Codec interface {
+	Marshal(v interface{}) ([]byte, error)
+	Unmarshal(data []byte, v interface{}) error
+}
+
+// StdCodec is the default Codec, backed by encoding/json
+type StdCodec struct{}
+
+// Marshal encodes v with json.Marshal
+func (StdCodec) Marshal(v interface{}) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+// Unmarshal decodes data with json.Unmarshal
+func (StdCodec) Unmarshal(data []byte, v interface{}) error {
+	return json.Unmarshal(data, v)
+}
+
+// WithCodec sets the codec for JSON request bodies and responses. Strict
+// decoding (WithStrictDecoding) and streamed responses (WithStreamingDecode,
+// ContextWithItemStream) rely on encoding/json's Decoder and bypass it.
+//
+// Example:
+//
+//	// jsoniter.ConfigCompatibleWithStandardLibrary satisfies Codec
+//	client, err := spotigo.NewClient(auth, spotigo.WithCodec(jsoniter.ConfigCompatibleWithStandardLibrary))
+func WithCodec(codec Codec) ClientOption {
+	return func(c *Client) {
+		c.Codec = codec
+	}
+}
+
+// codec returns the client's codec, defaulting to StdCodec
+func (c *Client) codec() Codec {
+	if c.Codec == nil {
+		return StdCodec{}
+	}
+	return c.Codec
+}
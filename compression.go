@@ -0,0 +1,102 @@
+package spotigo
+
+import (
+	"bufio"
+	"bytes"
+	"compress/flate"
+	"compress/gzip"
+	"compress/zlib"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// ============================================================================
+// Response Compression
+// ============================================================================
+
+// acceptEncoding lists the response encodings the client decodes
+const acceptEncoding = "gzip, deflate"
+
+// WithCompression sets whether responses are requested compressed (default:
+// true). Compressed responses are decoded by the client itself, so this works
+// with any HTTPClient transport; large payloads such as playlists and audio
+// analyses shrink several times over. Client.Stats counts the bytes as
+// transferred.
+//
+// Example:
+//
+//	client, err := spotigo.NewClient(auth, spotigo.WithCompression(false))
+func WithCompression(enabled bool) ClientOption {
+	return func(c *Client) {
+		c.DisableCompression = !enabled
+	}
+}
+
+// setAcceptEncoding asks for a compressed response, unless compression is
+// disabled or DefaultHeaders already chose an encoding
+func (c *Client) setAcceptEncoding(req *http.Request) {
+	if !c.DisableCompression && req.Header.Get("Accept-Encoding") == "" {
+		req.Header.Set("Accept-Encoding", acceptEncoding)
+	}
+}
+
+// decompressBody decodes a gzip or deflate response body (see
+// decompressReader), up to MaxResponseBytes decoded bytes
+func (c *Client) decompressBody(resp *http.Response, body []byte) ([]byte, error) {
+	reader, err := decompressReader(resp, bytes.NewReader(body))
+	if err != nil || reader == nil {
+		return body, err
+	}
+	defer reader.Close()
+
+	decoded, err := io.ReadAll(c.limitResponse(reader))
+	if errors.Is(err, ErrResponseTooLarge) {
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress response: %w", err)
+	}
+	return decoded, nil
+}
+
+// decompressReader returns a reader decoding body as resp's Content-Encoding
+// says, or nil if body is not encoded. The encoding headers are removed from
+// resp, as net/http does for bodies it decodes.
+func decompressReader(resp *http.Response, body io.Reader) (io.ReadCloser, error) {
+	encoding := strings.ToLower(strings.TrimSpace(resp.Header.Get("Content-Encoding")))
+	if encoding == "" || encoding == "identity" || resp.Uncompressed {
+		return nil, nil
+	}
+
+	buffered := bufio.NewReader(body)
+	if _, err := buffered.Peek(1); err == io.EOF {
+		return nil, nil // Empty body
+	}
+
+	var reader io.ReadCloser
+	var err error
+	switch encoding {
+	case "gzip", "x-gzip":
+		reader, err = gzip.NewReader(buffered)
+	case "deflate":
+		// Spec-compliant deflate is zlib-wrapped, but some servers send raw deflate
+		if header, _ := buffered.Peek(2); len(header) == 2 && header[0]&0x0f == 8 && (uint16(header[0])<<8|uint16(header[1]))%31 == 0 {
+			reader, err = zlib.NewReader(buffered)
+		} else {
+			reader = flate.NewReader(buffered)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported response Content-Encoding %q", encoding)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress %s response: %w", encoding, err)
+	}
+
+	resp.Header.Del("Content-Encoding")
+	resp.Header.Del("Content-Length")
+	resp.Uncompressed = true
+	return reader, nil
+}
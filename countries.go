@@ -0,0 +1,277 @@
+package spotigo
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ============================================================================
+// Markets and Country Metadata
+// ============================================================================
+
+// DefaultMarketsCacheTTL is how long Markets results are cached by default
+const DefaultMarketsCacheTTL = 24 * time.Hour
+
+// Country describes a Spotify market
+type Country struct {
+	Code   string // ISO 3166-1 alpha-2 country code
+	Name   string // English short name
+	Region string // Continental region: Africa, Americas, Asia, Europe, or Oceania
+}
+
+// countries maps each code in SupportedCountryCodes to its metadata
+var countries = map[string]Country{
+	"AD": {Code: "AD", Name: "Andorra", Region: "Europe"},
+	"AE": {Code: "AE", Name: "United Arab Emirates", Region: "Asia"},
+	"AG": {Code: "AG", Name: "Antigua and Barbuda", Region: "Americas"},
+	"AL": {Code: "AL", Name: "Albania", Region: "Europe"},
+	"AM": {Code: "AM", Name: "Armenia", Region: "Asia"},
+	"AO": {Code: "AO", Name: "Angola", Region: "Africa"},
+	"AR": {Code: "AR", Name: "Argentina", Region: "Americas"},
+	"AT": {Code: "AT", Name: "Austria", Region: "Europe"},
+	"AU": {Code: "AU", Name: "Australia", Region: "Oceania"},
+	"AZ": {Code: "AZ", Name: "Azerbaijan", Region: "Asia"},
+	"BA": {Code: "BA", Name: "Bosnia and Herzegovina", Region: "Europe"},
+	"BB": {Code: "BB", Name: "Barbados", Region: "Americas"},
+	"BD": {Code: "BD", Name: "Bangladesh", Region: "Asia"},
+	"BE": {Code: "BE", Name: "Belgium", Region: "Europe"},
+	"BF": {Code: "BF", Name: "Burkina Faso", Region: "Africa"},
+	"BG": {Code: "BG", Name: "Bulgaria", Region: "Europe"},
+	"BH": {Code: "BH", Name: "Bahrain", Region: "Asia"},
+	"BI": {Code: "BI", Name: "Burundi", Region: "Africa"},
+	"BJ": {Code: "BJ", Name: "Benin", Region: "Africa"},
+	"BN": {Code: "BN", Name: "Brunei", Region: "Asia"},
+	"BO": {Code: "BO", Name: "Bolivia", Region: "Americas"},
+	"BR": {Code: "BR", Name: "Brazil", Region: "Americas"},
+	"BS": {Code: "BS", Name: "Bahamas", Region: "Americas"},
+	"BT": {Code: "BT", Name: "Bhutan", Region: "Asia"},
+	"BW": {Code: "BW", Name: "Botswana", Region: "Africa"},
+	"BY": {Code: "BY", Name: "Belarus", Region: "Europe"},
+	"BZ": {Code: "BZ", Name: "Belize", Region: "Americas"},
+	"CA": {Code: "CA", Name: "Canada", Region: "Americas"},
+	"CH": {Code: "CH", Name: "Switzerland", Region: "Europe"},
+	"CI": {Code: "CI", Name: "Côte d'Ivoire", Region: "Africa"},
+	"CL": {Code: "CL", Name: "Chile", Region: "Americas"},
+	"CM": {Code: "CM", Name: "Cameroon", Region: "Africa"},
+	"CO": {Code: "CO", Name: "Colombia", Region: "Americas"},
+	"CR": {Code: "CR", Name: "Costa Rica", Region: "Americas"},
+	"CV": {Code: "CV", Name: "Cabo Verde", Region: "Africa"},
+	"CW": {Code: "CW", Name: "Curaçao", Region: "Americas"},
+	"CY": {Code: "CY", Name: "Cyprus", Region: "Europe"},
+	"CZ": {Code: "CZ", Name: "Czechia", Region: "Europe"},
+	"DE": {Code: "DE", Name: "Germany", Region: "Europe"},
+	"DJ": {Code: "DJ", Name: "Djibouti", Region: "Africa"},
+	"DK": {Code: "DK", Name: "Denmark", Region: "Europe"},
+	"DM": {Code: "DM", Name: "Dominica", Region: "Americas"},
+	"DO": {Code: "DO", Name: "Dominican Republic", Region: "Americas"},
+	"DZ": {Code: "DZ", Name: "Algeria", Region: "Africa"},
+	"EC": {Code: "EC", Name: "Ecuador", Region: "Americas"},
+	"EE": {Code: "EE", Name: "Estonia", Region: "Europe"},
+	"EG": {Code: "EG", Name: "Egypt", Region: "Africa"},
+	"ES": {Code: "ES", Name: "Spain", Region: "Europe"},
+	"FI": {Code: "FI", Name: "Finland", Region: "Europe"},
+	"FJ": {Code: "FJ", Name: "Fiji", Region: "Oceania"},
+	"FM": {Code: "FM", Name: "Micronesia", Region: "Oceania"},
+	"FR": {Code: "FR", Name: "France", Region: "Europe"},
+	"GA": {Code: "GA", Name: "Gabon", Region: "Africa"},
+	"GB": {Code: "GB", Name: "United Kingdom", Region: "Europe"},
+	"GD": {Code: "GD", Name: "Grenada", Region: "Americas"},
+	"GE": {Code: "GE", Name: "Georgia", Region: "Asia"},
+	"GH": {Code: "GH", Name: "Ghana", Region: "Africa"},
+	"GM": {Code: "GM", Name: "Gambia", Region: "Africa"},
+	"GN": {Code: "GN", Name: "Guinea", Region: "Africa"},
+	"GQ": {Code: "GQ", Name: "Equatorial Guinea", Region: "Africa"},
+	"GR": {Code: "GR", Name: "Greece", Region: "Europe"},
+	"GT": {Code: "GT", Name: "Guatemala", Region: "Americas"},
+	"GW": {Code: "GW", Name: "Guinea-Bissau", Region: "Africa"},
+	"GY": {Code: "GY", Name: "Guyana", Region: "Americas"},
+	"HK": {Code: "HK", Name: "Hong Kong", Region: "Asia"},
+	"HN": {Code: "HN", Name: "Honduras", Region: "Americas"},
+	"HR": {Code: "HR", Name: "Croatia", Region: "Europe"},
+	"HT": {Code: "HT", Name: "Haiti", Region: "Americas"},
+	"HU": {Code: "HU", Name: "Hungary", Region: "Europe"},
+	"ID": {Code: "ID", Name: "Indonesia", Region: "Asia"},
+	"IE": {Code: "IE", Name: "Ireland", Region: "Europe"},
+	"IL": {Code: "IL", Name: "Israel", Region: "Asia"},
+	"IN": {Code: "IN", Name: "India", Region: "Asia"},
+	"IS": {Code: "IS", Name: "Iceland", Region: "Europe"},
+	"IT": {Code: "IT", Name: "Italy", Region: "Europe"},
+	"JM": {Code: "JM", Name: "Jamaica", Region: "Americas"},
+	"JO": {Code: "JO", Name: "Jordan", Region: "Asia"},
+	"JP": {Code: "JP", Name: "Japan", Region: "Asia"},
+	"KE": {Code: "KE", Name: "Kenya", Region: "Africa"},
+	"KG": {Code: "KG", Name: "Kyrgyzstan", Region: "Asia"},
+	"KH": {Code: "KH", Name: "Cambodia", Region: "Asia"},
+	"KI": {Code: "KI", Name: "Kiribati", Region: "Oceania"},
+	"KM": {Code: "KM", Name: "Comoros", Region: "Africa"},
+	"KN": {Code: "KN", Name: "Saint Kitts and Nevis", Region: "Americas"},
+	"KR": {Code: "KR", Name: "South Korea", Region: "Asia"},
+	"KW": {Code: "KW", Name: "Kuwait", Region: "Asia"},
+	"KZ": {Code: "KZ", Name: "Kazakhstan", Region: "Asia"},
+	"LA": {Code: "LA", Name: "Laos", Region: "Asia"},
+	"LB": {Code: "LB", Name: "Lebanon", Region: "Asia"},
+	"LC": {Code: "LC", Name: "Saint Lucia", Region: "Americas"},
+	"LI": {Code: "LI", Name: "Liechtenstein", Region: "Europe"},
+	"LK": {Code: "LK", Name: "Sri Lanka", Region: "Asia"},
+	"LR": {Code: "LR", Name: "Liberia", Region: "Africa"},
+	"LS": {Code: "LS", Name: "Lesotho", Region: "Africa"},
+	"LT": {Code: "LT", Name: "Lithuania", Region: "Europe"},
+	"LU": {Code: "LU", Name: "Luxembourg", Region: "Europe"},
+	"LV": {Code: "LV", Name: "Latvia", Region: "Europe"},
+	"MA": {Code: "MA", Name: "Morocco", Region: "Africa"},
+	"MD": {Code: "MD", Name: "Moldova", Region: "Europe"},
+	"ME": {Code: "ME", Name: "Montenegro", Region: "Europe"},
+	"MG": {Code: "MG", Name: "Madagascar", Region: "Africa"},
+	"MH": {Code: "MH", Name: "Marshall Islands", Region: "Oceania"},
+	"MK": {Code: "MK", Name: "North Macedonia", Region: "Europe"},
+	"ML": {Code: "ML", Name: "Mali", Region: "Africa"},
+	"MN": {Code: "MN", Name: "Mongolia", Region: "Asia"},
+	"MO": {Code: "MO", Name: "Macao", Region: "Asia"},
+	"MR": {Code: "MR", Name: "Mauritania", Region: "Africa"},
+	"MT": {Code: "MT", Name: "Malta", Region: "Europe"},
+	"MU": {Code: "MU", Name: "Mauritius", Region: "Africa"},
+	"MV": {Code: "MV", Name: "Maldives", Region: "Asia"},
+	"MW": {Code: "MW", Name: "Malawi", Region: "Africa"},
+	"MX": {Code: "MX", Name: "Mexico", Region: "Americas"},
+	"MY": {Code: "MY", Name: "Malaysia", Region: "Asia"},
+	"MZ": {Code: "MZ", Name: "Mozambique", Region: "Africa"},
+	"NA": {Code: "NA", Name: "Namibia", Region: "Africa"},
+	"NE": {Code: "NE", Name: "Niger", Region: "Africa"},
+	"NG": {Code: "NG", Name: "Nigeria", Region: "Africa"},
+	"NI": {Code: "NI", Name: "Nicaragua", Region: "Americas"},
+	"NL": {Code: "NL", Name: "Netherlands", Region: "Europe"},
+	"NO": {Code: "NO", Name: "Norway", Region: "Europe"},
+	"NP": {Code: "NP", Name: "Nepal", Region: "Asia"},
+	"NR": {Code: "NR", Name: "Nauru", Region: "Oceania"},
+	"NZ": {Code: "NZ", Name: "New Zealand", Region: "Oceania"},
+	"OM": {Code: "OM", Name: "Oman", Region: "Asia"},
+	"PA": {Code: "PA", Name: "Panama", Region: "Americas"},
+	"PE": {Code: "PE", Name: "Peru", Region: "Americas"},
+	"PG": {Code: "PG", Name: "Papua New Guinea", Region: "Oceania"},
+	"PH": {Code: "PH", Name: "Philippines", Region: "Asia"},
+	"PK": {Code: "PK", Name: "Pakistan", Region: "Asia"},
+	"PL": {Code: "PL", Name: "Poland", Region: "Europe"},
+	"PS": {Code: "PS", Name: "Palestine", Region: "Asia"},
+	"PT": {Code: "PT", Name: "Portugal", Region: "Europe"},
+	"PW": {Code: "PW", Name: "Palau", Region: "Oceania"},
+	"PY": {Code: "PY", Name: "Paraguay", Region: "Americas"},
+	"QA": {Code: "QA", Name: "Qatar", Region: "Asia"},
+	"RO": {Code: "RO", Name: "Romania", Region: "Europe"},
+	"RS": {Code: "RS", Name: "Serbia", Region: "Europe"},
+	"RW": {Code: "RW", Name: "Rwanda", Region: "Africa"},
+	"SA": {Code: "SA", Name: "Saudi Arabia", Region: "Asia"},
+	"SB": {Code: "SB", Name: "Solomon Islands", Region: "Oceania"},
+	"SC": {Code: "SC", Name: "Seychelles", Region: "Africa"},
+	"SE": {Code: "SE", Name: "Sweden", Region: "Europe"},
+	"SG": {Code: "SG", Name: "Singapore", Region: "Asia"},
+	"SI": {Code: "SI", Name: "Slovenia", Region: "Europe"},
+	"SK": {Code: "SK", Name: "Slovakia", Region: "Europe"},
+	"SL": {Code: "SL", Name: "Sierra Leone", Region: "Africa"},
+	"SM": {Code: "SM", Name: "San Marino", Region: "Europe"},
+	"SN": {Code: "SN", Name: "Senegal", Region: "Africa"},
+	"SR": {Code: "SR", Name: "Suriname", Region: "Americas"},
+	"ST": {Code: "ST", Name: "São Tomé and Príncipe", Region: "Africa"},
+	"SV": {Code: "SV", Name: "El Salvador", Region: "Americas"},
+	"SZ": {Code: "SZ", Name: "Eswatini", Region: "Africa"},
+	"TD": {Code: "TD", Name: "Chad", Region: "Africa"},
+	"TG": {Code: "TG", Name: "Togo", Region: "Africa"},
+	"TH": {Code: "TH", Name: "Thailand", Region: "Asia"},
+	"TL": {Code: "TL", Name: "Timor-Leste", Region: "Asia"},
+	"TN": {Code: "TN", Name: "Tunisia", Region: "Africa"},
+	"TO": {Code: "TO", Name: "Tonga", Region: "Oceania"},
+	"TR": {Code: "TR", Name: "Türkiye", Region: "Asia"},
+	"TT": {Code: "TT", Name: "Trinidad and Tobago", Region: "Americas"},
+	"TV": {Code: "TV", Name: "Tuvalu", Region: "Oceania"},
+	"TW": {Code: "TW", Name: "Taiwan", Region: "Asia"},
+	"TZ": {Code: "TZ", Name: "Tanzania", Region: "Africa"},
+	"UA": {Code: "UA", Name: "Ukraine", Region: "Europe"},
+	"UG": {Code: "UG", Name: "Uganda", Region: "Africa"},
+	"US": {Code: "US", Name: "United States", Region: "Americas"},
+	"UY": {Code: "UY", Name: "Uruguay", Region: "Americas"},
+	"UZ": {Code: "UZ", Name: "Uzbekistan", Region: "Asia"},
+	"VC": {Code: "VC", Name: "Saint Vincent and the Grenadines", Region: "Americas"},
+	"VE": {Code: "VE", Name: "Venezuela", Region: "Americas"},
+	"VN": {Code: "VN", Name: "Vietnam", Region: "Asia"},
+	"VU": {Code: "VU", Name: "Vanuatu", Region: "Oceania"},
+	"WS": {Code: "WS", Name: "Samoa", Region: "Oceania"},
+	"XK": {Code: "XK", Name: "Kosovo", Region: "Europe"},
+	"ZA": {Code: "ZA", Name: "South Africa", Region: "Africa"},
+	"ZM": {Code: "ZM", Name: "Zambia", Region: "Africa"},
+	"ZW": {Code: "ZW", Name: "Zimbabwe", Region: "Africa"},
+}
+
+// LookupCountry returns the metadata for an ISO 3166-1 alpha-2 country code
+// (case-insensitive), or false if the code is not a known Spotify market
+func LookupCountry(code string) (Country, bool) {
+	country, ok := countries[strings.ToUpper(code)]
+	return country, ok
+}
+
+// marketsCache holds a copy of the available markets
+type marketsCache struct {
+	mu        sync.Mutex
+	markets   []Country
+	fetchedAt time.Time
+}
+
+// WithMarketsCacheTTL sets how long Markets results are cached.
+// 0 uses DefaultMarketsCacheTTL; a negative TTL disables caching.
+func WithMarketsCacheTTL(ttl time.Duration) ClientOption {
+	return func(c *Client) {
+		c.MarketsCacheTTL = ttl
+	}
+}
+
+// Markets returns the markets where Spotify is available with their country
+// names and regions, sorted by name, for rendering market pickers.
+//
+// Results are cached for MarketsCacheTTL (default: 24 hours). Codes missing
+// from the built-in country table use the code as the name and an empty
+// region.
+//
+// Example:
+//
+//	markets, err := client.Markets(ctx)
+//	if err != nil {
+//		return err
+//	}
+//	for _, market := range markets {
+//		fmt.Printf("<option value=%q>%s</option>\n", market.Code, market.Name)
+//	}
+func (c *Client) Markets(ctx context.Context) ([]Country, error) {
+	ttl := c.MarketsCacheTTL
+	if ttl == 0 {
+		ttl = DefaultMarketsCacheTTL
+	}
+
+	c.markets.mu.Lock()
+	defer c.markets.mu.Unlock()
+
+	if ttl > 0 && c.markets.markets != nil && time.Since(c.markets.fetchedAt) < ttl {
+		return slices.Clone(c.markets.markets), nil
+	}
+
+	codes, err := c.AvailableMarkets(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	markets := make([]Country, 0, len(codes))
+	for _, code := range codes {
+		country, ok := LookupCountry(code)
+		if !ok {
+			country = Country{Code: code, Name: code}
+		}
+		markets = append(markets, country)
+	}
+	slices.SortFunc(markets, func(a, b Country) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	c.markets.markets = markets
+	c.markets.fetchedAt = time.Now()
+	return slices.Clone(markets), nil
+}
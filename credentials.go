@@ -0,0 +1,137 @@
+package spotigo
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+)
+
+// ============================================================================
+// Credentials Providers
+// ============================================================================
+
+// CredentialsProvider supplies the client ID and secret used for token
+// requests.
+//
+// When SpotifyAuthBase.CredentialsProvider is set, it is consulted before
+// every token request (new token, code exchange, and refresh), so credentials
+// can be rotated in a secret store without recreating auth managers.
+//
+// Adapters for secret stores only need to implement Credentials. For example,
+// with HashiCorp Vault (github.com/hashicorp/vault/api):
+//
+//	provider := spotigo.CredentialsProviderFunc(func(ctx context.Context) (string, string, error) {
+//		secret, err := vault.KVv2("secret").Get(ctx, "spotify")
+//		if err != nil {
+//			return "", "", err
+//		}
+//		return secret.Data["client_id"].(string), secret.Data["client_secret"].(string), nil
+//	})
+//
+// Or with AWS Secrets Manager (github.com/aws/aws-sdk-go-v2/service/secretsmanager):
+//
+//	provider := spotigo.CredentialsProviderFunc(func(ctx context.Context) (string, string, error) {
+//		out, err := sm.GetSecretValue(ctx, &secretsmanager.GetSecretValueInput{
+//			SecretId: aws.String("spotify"),
+//		})
+//		if err != nil {
+//			return "", "", err
+//		}
+//		var creds struct {
+//			ClientID     string `json:"client_id"`
+//			ClientSecret string `json:"client_secret"`
+//		}
+//		err = json.Unmarshal([]byte(*out.SecretString), &creds)
+//		return creds.ClientID, creds.ClientSecret, err
+//	})
+type CredentialsProvider interface {
+	// Credentials returns the current client ID and secret
+	// The secret may be empty for flows that don't use one (PKCE)
+	Credentials(ctx context.Context) (clientID, clientSecret string, err error)
+}
+
+// CredentialsProviderFunc adapts a function to the CredentialsProvider interface
+type CredentialsProviderFunc func(ctx context.Context) (string, string, error)
+
+// Credentials calls f(ctx)
+func (f CredentialsProviderFunc) Credentials(ctx context.Context) (string, string, error) {
+	return f(ctx)
+}
+
+// StaticCredentials is a CredentialsProvider that always returns the same
+// credentials
+type StaticCredentials struct {
+	ClientID     string
+	ClientSecret string
+}
+
+// Credentials implements CredentialsProvider
+func (s StaticCredentials) Credentials(ctx context.Context) (string, string, error) {
+	return s.ClientID, s.ClientSecret, nil
+}
+
+// EnvCredentials is a CredentialsProvider that reads credentials from
+// environment variables on every call
+type EnvCredentials struct {
+	ClientIDVar     string // Variable holding the client ID (default: SPOTIGO_CLIENT_ID)
+	ClientSecretVar string // Variable holding the client secret (default: SPOTIGO_CLIENT_SECRET)
+}
+
+// Credentials implements CredentialsProvider
+func (e EnvCredentials) Credentials(ctx context.Context) (string, string, error) {
+	idVar := e.ClientIDVar
+	if idVar == "" {
+		idVar = EnvClientID
+	}
+	secretVar := e.ClientSecretVar
+	if secretVar == "" {
+		secretVar = EnvClientSecret
+	}
+
+	clientID := os.Getenv(idVar)
+	if clientID == "" {
+		return "", "", fmt.Errorf("environment variable %s is not set", idVar)
+	}
+	return clientID, os.Getenv(secretVar), nil
+}
+
+// NewClientCredentialsFromProvider creates a Client Credentials auth manager
+// that fetches its credentials from provider before each token request
+func NewClientCredentialsFromProvider(provider CredentialsProvider) (*ClientCredentials, error) {
+	if provider == nil {
+		return nil, fmt.Errorf("credentials provider is required")
+	}
+
+	base := &SpotifyAuthBase{
+		HTTPClient:          newHTTPClient(5 * time.Second),
+		RequestsTimeout:     5 * time.Second,
+		ReplayGuard:         NewReplayGuard(DefaultReplayWindow),
+		CredentialsProvider: provider,
+	}
+
+	return &ClientCredentials{SpotifyAuthBase: base}, nil
+}
+
+// loadCredentials refreshes ClientID and ClientSecret from the
+// CredentialsProvider, if one is set
+func (b *SpotifyAuthBase) loadCredentials(ctx context.Context) error {
+	if b.CredentialsProvider == nil {
+		return nil
+	}
+
+	clientID, clientSecret, err := b.CredentialsProvider.Credentials(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load credentials: %w", err)
+	}
+	if clientID == "" {
+		return &SpotifyOAuthError{
+			ErrorType:        "missing_parameter",
+			ErrorDescription: "Credentials provider returned an empty client_id",
+		}
+	}
+
+	b.ClientID = clientID
+	b.ClientSecret = clientSecret
+	return nil
+}
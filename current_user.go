@@ -0,0 +1,78 @@
+package spotigo
+
+import (
+	"context"
+	"sync"
+)
+
+// ============================================================================
+// Current User Identity
+// ============================================================================
+
+// currentUserCache holds the profile of the user the client acts for
+type currentUserCache struct {
+	mu   sync.Mutex
+	user *User
+}
+
+// get returns a copy of the cached profile
+func (u *currentUserCache) get() (*User, bool) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	if u.user == nil {
+		return nil, false
+	}
+	user := *u.user
+	return &user, true
+}
+
+// set stores a copy of user
+func (u *currentUserCache) set(user *User) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	cached := *user
+	u.user = &cached
+}
+
+// invalidate clears the cache
+func (u *currentUserCache) invalidate() {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	u.user = nil
+}
+
+// Me returns the current user's profile, fetching it with CurrentUser only
+// on first use. Every CurrentUser call refreshes the cached profile.
+func (c *Client) Me(ctx context.Context) (*User, error) {
+	if user, ok := c.currentUser.get(); ok {
+		return user, nil
+	}
+	return c.CurrentUser(ctx)
+}
+
+// MeID returns the current user's ID, for endpoints that take one. The
+// profile is cached (see Me), so repeated calls cost no requests.
+//
+// Example:
+//
+//	userID, err := client.MeID(ctx)
+//	if err != nil {
+//		return err
+//	}
+//	playlists, err := client.UserPlaylists(ctx, userID, nil)
+func (c *Client) MeID(ctx context.Context) (string, error) {
+	user, err := c.Me(ctx)
+	if err != nil {
+		return "", err
+	}
+	return user.ID, nil
+}
+
+// InvalidateCurrentUser discards the cached profile so the next Me or MeID
+// call fetches it again, e.g. after the auth manager switches to another user
+func (c *Client) InvalidateCurrentUser() {
+	c.currentUser.invalidate()
+}
@@ -0,0 +1,106 @@
+package spotigo
+
+import (
+	"context"
+	"time"
+)
+
+// ============================================================================
+// Context Deadlines
+// ============================================================================
+
+// DeadlinePolicy controls how the client treats contexts without a deadline
+type DeadlinePolicy int
+
+const (
+	// DeadlineIgnore accepts contexts without a deadline (default)
+	DeadlineIgnore DeadlinePolicy = iota
+	// DeadlineWarn logs a warning for contexts without a deadline
+	DeadlineWarn
+	// DeadlineRequire rejects contexts without a deadline with ErrNoDeadline
+	DeadlineRequire
+)
+
+// String returns the policy name
+func (p DeadlinePolicy) String() string {
+	switch p {
+	case DeadlineIgnore:
+		return "ignore"
+	case DeadlineWarn:
+		return "warn"
+	case DeadlineRequire:
+		return "require"
+	default:
+		return "unknown"
+	}
+}
+
+// WithDeadlinePolicy sets how calls without a context deadline are handled.
+// The policy only applies when no DefaultCallTimeout is configured.
+func WithDeadlinePolicy(policy DeadlinePolicy) ClientOption {
+	return func(c *Client) {
+		c.DeadlinePolicy = policy
+	}
+}
+
+// WithDefaultCallTimeout sets a deadline applied to calls whose context has
+// none. Unlike RequestTimeout, which limits each HTTP attempt, the deadline
+// covers the whole call including retries and backoff.
+//
+// Example:
+//
+//	client, err := spotigo.NewClient(auth, spotigo.WithDefaultCallTimeout(30*time.Second))
+func WithDefaultCallTimeout(timeout time.Duration) ClientOption {
+	return func(c *Client) {
+		c.DefaultCallTimeout = timeout
+	}
+}
+
+// applyDeadline enforces the client's deadline settings on ctx.
+// The returned cancel function must always be called.
+func (c *Client) applyDeadline(ctx context.Context, method, urlStr string) (context.Context, context.CancelFunc, error) {
+	if _, ok := ctx.Deadline(); ok {
+		return ctx, func() {}, nil
+	}
+
+	if c.DefaultCallTimeout > 0 {
+		ctx, cancel := context.WithTimeout(ctx, c.DefaultCallTimeout)
+		return ctx, cancel, nil
+	}
+
+	switch c.DeadlinePolicy {
+	case DeadlineRequire:
+		return ctx, func() {}, ErrNoDeadline
+	case DeadlineWarn:
+		if c.Logger != nil {
+			c.Logger.Warn("Request without context deadline: %s %s", method, RedactString(urlStr))
+		}
+	}
+	return ctx, func() {}, nil
+}
+
+// minFinalAttempt is the least time a shrunk backoff leaves for the final
+// attempt before the deadline
+const minFinalAttempt = 100 * time.Millisecond
+
+// fitRetryDelay checks a retry's backoff against ctx's deadline. A backoff
+// that would outlast the deadline fails fast with a *DeadlineWouldExceedError,
+// except that one computed backoff per call is shrunk to half the remaining
+// time so a final attempt still fits. Retry-After delays (mandated) are never
+// shrunk, since Spotify rejects earlier retries.
+func fitRetryDelay(ctx context.Context, delay time.Duration, mandated bool, shrunk *bool, attempt int, cause error) (time.Duration, error) {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return delay, nil
+	}
+
+	remaining := time.Until(deadline)
+	if delay < remaining {
+		return delay, nil
+	}
+	if !mandated && !*shrunk && remaining >= 2*minFinalAttempt {
+		*shrunk = true
+		return remaining / 2, nil
+	}
+	return 0, &DeadlineWouldExceedError{Attempt: attempt + 1, Delay: delay, Remaining: max(remaining, 0), Err: cause}
+}
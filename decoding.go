@@ -0,0 +1,262 @@
+package spotigo
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// ============================================================================
+// Response Decoding and API Drift
+// ============================================================================
+
+// WithStrictDecoding makes responses with fields missing from the models fail
+// with an *UnknownFieldError, to catch API drift in tests. The default
+// (lenient) ignores unknown fields; core models still capture them in Extras.
+func WithStrictDecoding(strict bool) ClientOption {
+	return func(c *Client) {
+		c.StrictDecoding = strict
+	}
+}
+
+// decodeResponse decodes a JSON response body into result
+func (c *Client) decodeResponse(body []byte, result interface{}) error {
+	if !c.StrictDecoding {
+		if err := c.codec().Unmarshal(body, result); err != nil {
+			return WrapJSONError(err)
+		}
+		return nil
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(result); err != nil {
+		if field, ok := strings.CutPrefix(err.Error(), "json: unknown field "); ok {
+			return &UnknownFieldError{Fields: []string{strings.Trim(field, `"`)}}
+		}
+		return WrapJSONError(err)
+	}
+
+	// Core models decode themselves, so unknown fields end up in Extras
+	// instead of failing the decoder
+	var fields []string
+	collectExtras(reflect.ValueOf(result), "", &fields)
+	if len(fields) > 0 {
+		sort.Strings(fields)
+		return &UnknownFieldError{Fields: fields}
+	}
+	return nil
+}
+
+// extrasType is the type of the Extras field on core models
+var extrasType = reflect.TypeOf(map[string]json.RawMessage(nil))
+
+// collectExtras appends the paths of fields captured in Extras maps
+// anywhere in v
+func collectExtras(v reflect.Value, path string, fields *[]string) {
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if !v.IsNil() {
+			collectExtras(v.Elem(), path, fields)
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			collectExtras(v.Index(i), path, fields)
+		}
+	case reflect.Map:
+		if v.Type() == extrasType {
+			return
+		}
+		for _, key := range v.MapKeys() {
+			collectExtras(v.MapIndex(key), path, fields)
+		}
+	case reflect.Struct:
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			if !field.IsExported() {
+				continue
+			}
+			if field.Name == "Extras" && field.Type == extrasType {
+				for name := range v.Field(i).Interface().(map[string]json.RawMessage) {
+					*fields = append(*fields, joinFieldPath(path, name))
+				}
+				continue
+			}
+			name := field.Name
+			if tag, _, _ := strings.Cut(field.Tag.Get("json"), ","); tag != "" && tag != "-" {
+				name = tag
+			}
+			if field.Anonymous {
+				collectExtras(v.Field(i), path, fields)
+			} else {
+				collectExtras(v.Field(i), joinFieldPath(path, name), fields)
+			}
+		}
+	}
+}
+
+// joinFieldPath joins JSON field names with dots
+func joinFieldPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+// knownFieldsCache caches the JSON field names of model types
+var knownFieldsCache sync.Map // reflect.Type -> map[string]bool
+
+// knownFields returns the JSON field names decoded into struct type t,
+// including those of embedded structs
+func knownFields(t reflect.Type) map[string]bool {
+	if cached, ok := knownFieldsCache.Load(t); ok {
+		return cached.(map[string]bool)
+	}
+
+	names := make(map[string]bool)
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if tag == "-" {
+			continue
+		}
+		if field.Anonymous && tag == "" && field.Type.Kind() == reflect.Struct {
+			for name := range knownFields(field.Type) {
+				names[name] = true
+			}
+			continue
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if tag == "" {
+			tag = field.Name
+		}
+		names[strings.ToLower(tag)] = true
+	}
+
+	knownFieldsCache.Store(t, names)
+	return names
+}
+
+// unknownFields returns the top-level fields of a JSON object that model
+// (a struct value) does not decode, or nil if there are none
+func unknownFields(data []byte, model interface{}) map[string]json.RawMessage {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil
+	}
+
+	known := knownFields(reflect.TypeOf(model))
+	var extras map[string]json.RawMessage
+	for name, value := range raw {
+		if known[strings.ToLower(name)] {
+			continue
+		}
+		if extras == nil {
+			extras = make(map[string]json.RawMessage)
+		}
+		extras[name] = value
+	}
+	return extras
+}
+
+// UnmarshalJSON decodes the artist, keeping unknown fields in Extras
+func (m *Artist) UnmarshalJSON(data []byte) error {
+	type plain Artist
+	var decoded plain
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	decoded.Extras = unknownFields(data, decoded)
+	*m = Artist(decoded)
+	return nil
+}
+
+// UnmarshalJSON decodes the track, keeping unknown fields in Extras
+func (m *Track) UnmarshalJSON(data []byte) error {
+	type plain Track
+	var decoded plain
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	decoded.Extras = unknownFields(data, decoded)
+	*m = Track(decoded)
+	return nil
+}
+
+// UnmarshalJSON decodes the album, keeping unknown fields in Extras
+func (m *Album) UnmarshalJSON(data []byte) error {
+	type plain Album
+	var decoded plain
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	decoded.Extras = unknownFields(data, decoded)
+	*m = Album(decoded)
+	return nil
+}
+
+// UnmarshalJSON decodes the playlist, keeping unknown fields in Extras
+func (m *Playlist) UnmarshalJSON(data []byte) error {
+	type plain Playlist
+	var decoded plain
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	decoded.Extras = unknownFields(data, decoded)
+	*m = Playlist(decoded)
+	return nil
+}
+
+// UnmarshalJSON decodes the episode, keeping unknown fields in Extras
+func (m *Episode) UnmarshalJSON(data []byte) error {
+	type plain Episode
+	var decoded plain
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	decoded.Extras = unknownFields(data, decoded)
+	*m = Episode(decoded)
+	return nil
+}
+
+// UnmarshalJSON decodes the show, keeping unknown fields in Extras
+func (m *Show) UnmarshalJSON(data []byte) error {
+	type plain Show
+	var decoded plain
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	decoded.Extras = unknownFields(data, decoded)
+	*m = Show(decoded)
+	return nil
+}
+
+// UnmarshalJSON decodes the audiobook, keeping unknown fields in Extras
+func (m *Audiobook) UnmarshalJSON(data []byte) error {
+	type plain Audiobook
+	var decoded plain
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	decoded.Extras = unknownFields(data, decoded)
+	*m = Audiobook(decoded)
+	return nil
+}
+
+// UnmarshalJSON decodes the user, keeping unknown fields in Extras
+func (m *User) UnmarshalJSON(data []byte) error {
+	type plain User
+	var decoded plain
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	decoded.Extras = unknownFields(data, decoded)
+	*m = User(decoded)
+	return nil
+}
@@ -0,0 +1,169 @@
+package spotigo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// ============================================================================
+// Deprecated Endpoints
+// ============================================================================
+
+// DeprecatedEndpoint names a Web API endpoint Spotify has sunset or
+// restricted. Since November 2024, apps without extended access get errors
+// from these endpoints.
+type DeprecatedEndpoint string
+
+const (
+	EndpointFeaturedPlaylists DeprecatedEndpoint = "featured-playlists"
+	EndpointCategoryPlaylists DeprecatedEndpoint = "category-playlists"
+	EndpointRelatedArtists    DeprecatedEndpoint = "related-artists"
+	EndpointRecommendations   DeprecatedEndpoint = "recommendations"
+	EndpointGenreSeeds        DeprecatedEndpoint = "available-genre-seeds"
+	EndpointAudioFeatures     DeprecatedEndpoint = "audio-features"
+	EndpointAudioAnalysis     DeprecatedEndpoint = "audio-analysis"
+)
+
+// deprecationGuidance suggests what to use instead of each endpoint
+var deprecationGuidance = map[DeprecatedEndpoint]string{
+	EndpointFeaturedPlaylists: "search for playlists instead",
+	EndpointCategoryPlaylists: "search for playlists by category name instead, or enable WithDeprecationFallbacks",
+	EndpointRelatedArtists:    "search for artists by genre instead, or enable WithDeprecationFallbacks",
+	EndpointRecommendations:   "build recommendations from search, top items, or artist top tracks instead",
+	EndpointGenreSeeds:        "use the genres on Artist objects instead",
+	EndpointAudioFeatures:     "GetTrackAudioFeaturesFallback derives tempo, key, mode, and loudness from audio analysis where that is still available",
+	EndpointAudioAnalysis:     "no replacement is available",
+}
+
+// WithDeprecationFallbacks makes endpoints Spotify has sunset fall back to an
+// emulation when the endpoint fails or is disabled: BrowseCategoryPlaylists
+// searches for playlists named like the category, and ArtistRelatedArtists
+// searches for artists in the artist's first genre. The results approximate
+// the originals. Other deprecated endpoints have no fallback.
+//
+// Example:
+//
+//	client, err := spotigo.NewClient(auth, spotigo.WithDeprecationFallbacks(true))
+func WithDeprecationFallbacks(enabled bool) ClientOption {
+	return func(c *Client) {
+		c.DeprecationFallbacks = enabled
+	}
+}
+
+// WithDisabledEndpoints makes calls to the given deprecated endpoints fail
+// with an *EndpointDeprecatedError (or use their fallback) without sending a
+// request, for apps that know they lack access.
+//
+// Example:
+//
+//	client, err := spotigo.NewClient(auth,
+//		spotigo.WithDisabledEndpoints(spotigo.EndpointRecommendations, spotigo.EndpointAudioFeatures),
+//	)
+func WithDisabledEndpoints(endpoints ...DeprecatedEndpoint) ClientOption {
+	return func(c *Client) {
+		if c.DisabledEndpoints == nil {
+			c.DisabledEndpoints = make(map[DeprecatedEndpoint]bool)
+		}
+		for _, endpoint := range endpoints {
+			c.DisabledEndpoints[endpoint] = true
+		}
+	}
+}
+
+// callDeprecated calls a deprecated endpoint, reporting Spotify's refusal as
+// an *EndpointDeprecatedError. The fallback, if any, runs instead when
+// fallbacks are enabled and the endpoint is disabled or refused.
+func (c *Client) callDeprecated(endpoint DeprecatedEndpoint, call func() error, fallback func() error) error {
+	deprecated := &EndpointDeprecatedError{Endpoint: endpoint, Guidance: deprecationGuidance[endpoint]}
+	if !c.DisabledEndpoints[endpoint] {
+		err := call()
+		if err == nil || !endpointRefused(err) {
+			return err
+		}
+		deprecated.Err = err
+	}
+
+	if fallback == nil || !c.DeprecationFallbacks {
+		return deprecated
+	}
+	return fallback()
+}
+
+// endpointRefused reports whether err is how Spotify answers apps without
+// access to a sunset endpoint (403 or 404)
+func endpointRefused(err error) bool {
+	var spotifyErr *SpotifyError
+	if !errors.As(err, &spotifyErr) {
+		return false
+	}
+	return spotifyErr.HTTPStatus == 403 || spotifyErr.HTTPStatus == 404
+}
+
+// searchCategoryPlaylists emulates BrowseCategoryPlaylists by searching for
+// playlists named like the category
+func (c *Client) searchCategoryPlaylists(ctx context.Context, categoryID string, opts *CategoryPlaylistsOptions) (*CategoryPlaylistsResponse, error) {
+	searchOpts := &SearchOptions{Types: []SearchType{SearchTypePlaylist}, Limit: 20}
+	var categoryOpts *BrowseCategoriesOptions
+	if opts != nil {
+		searchOpts.Market = opts.Country
+		searchOpts.Offset = opts.Offset
+		if opts.Limit > 0 {
+			searchOpts.Limit = min(opts.Limit, 50)
+		}
+		categoryOpts = &BrowseCategoriesOptions{Country: opts.Country}
+	}
+
+	category, err := c.BrowseCategory(ctx, categoryID, categoryOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := c.Search(ctx, category.Name, "", searchOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &CategoryPlaylistsResponse{}
+	if results.Playlists != nil {
+		result.Playlists = *results.Playlists
+		// Search returns null entries for playlists it cannot show
+		result.Playlists.Items = nil
+		for _, playlist := range results.Playlists.Items {
+			if playlist.ID != "" {
+				result.Playlists.Items = append(result.Playlists.Items, playlist)
+			}
+		}
+	}
+	return result, nil
+}
+
+// searchRelatedArtists emulates ArtistRelatedArtists by searching for artists
+// in the artist's first genre
+func (c *Client) searchRelatedArtists(ctx context.Context, artistID string) (*ArtistsResponse, error) {
+	artist, err := c.Artist(ctx, artistID)
+	if err != nil {
+		return nil, err
+	}
+	if len(artist.Genres) == 0 {
+		return &ArtistsResponse{Artists: []Artist{}}, nil
+	}
+
+	results, err := c.Search(ctx, fmt.Sprintf("genre:%q", artist.Genres[0]), "", &SearchOptions{
+		Types: []SearchType{SearchTypeArtist},
+		Limit: 21, // The related artists endpoint returns up to 20
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ArtistsResponse{Artists: []Artist{}}
+	if results.Artists != nil {
+		for _, related := range results.Artists.Items {
+			if related.ID != "" && related.ID != artist.ID && len(result.Artists) < 20 {
+				result.Artists = append(result.Artists, related)
+			}
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,477 @@
+package spotigo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ============================================================================
+// Device Targeting
+// ============================================================================
+
+// deviceCache holds a short-lived copy of the user's devices
+type deviceCache struct {
+	mu           sync.Mutex
+	devices      []Device
+	fetchedAt    time.Time
+	lastActiveID string // Most recently seen active device
+}
+
+// get returns the cached devices if they are younger than ttl
+func (d *deviceCache) get(ttl time.Duration) ([]Device, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if ttl <= 0 || d.devices == nil || time.Since(d.fetchedAt) > ttl {
+		return nil, false
+	}
+	return append([]Device(nil), d.devices...), true
+}
+
+// set stores devices in the cache
+func (d *deviceCache) set(devices []Device) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.devices = append([]Device{}, devices...)
+	d.fetchedAt = time.Now()
+}
+
+// setLastActive records the most recently seen active device ID
+func (d *deviceCache) setLastActive(deviceID string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.lastActiveID = deviceID
+}
+
+// lastActive returns the most recently seen active device ID
+func (d *deviceCache) lastActive() string {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.lastActiveID
+}
+
+// invalidate clears the cache
+func (d *deviceCache) invalidate() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.devices = nil
+	d.fetchedAt = time.Time{}
+}
+
+// InvalidateDeviceCache discards the cached device list so the next
+// CurrentUserDevices or DeviceByName call fetches a fresh one
+func (c *Client) InvalidateDeviceCache() {
+	c.deviceCache.invalidate()
+}
+
+// RefreshDevices fetches the user's devices, bypassing and then updating the cache
+func (c *Client) RefreshDevices(ctx context.Context) ([]Device, error) {
+	c.deviceCache.invalidate()
+	return c.CurrentUserDevices(ctx)
+}
+
+// DeviceByName finds one of the user's playback devices by name.
+//
+// Names are matched case-insensitively. The device list is cached for
+// DeviceCacheTTL; on a cache miss the list is refreshed once before giving up.
+// Returns a *DeviceNotFoundError (matching ErrDeviceNotFound) if no device has
+// the given name.
+//
+// Example:
+//
+//	device, err := client.DeviceByName(ctx, "Kitchen Speaker")
+//	if errors.Is(err, spotigo.ErrDeviceNotFound) {
+//		// Handle missing device
+//	}
+func (c *Client) DeviceByName(ctx context.Context, name string) (*Device, error) {
+	if name == "" {
+		return nil, &MissingOptionError{Field: "deviceName"}
+	}
+
+	devices, err := c.CurrentUserDevices(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if device := findDeviceByName(devices, name); device != nil {
+		return device, nil
+	}
+
+	// Device may have appeared since the list was cached - refresh once
+	devices, err = c.RefreshDevices(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if device := findDeviceByName(devices, name); device != nil {
+		return device, nil
+	}
+
+	return nil, &DeviceNotFoundError{Name: name}
+}
+
+// findDeviceByName returns a copy of the first device whose name matches
+func findDeviceByName(devices []Device, name string) *Device {
+	for _, device := range devices {
+		if strings.EqualFold(device.Name, name) {
+			found := device
+			return &found
+		}
+	}
+	return nil
+}
+
+// deviceIDByName resolves a device name to its ID
+func (c *Client) deviceIDByName(ctx context.Context, name string) (string, error) {
+	device, err := c.DeviceByName(ctx, name)
+	if err != nil {
+		return "", err
+	}
+	if device.ID == nil || *device.ID == "" {
+		return "", fmt.Errorf("device %q has no ID and cannot be targeted", device.Name)
+	}
+	return *device.ID, nil
+}
+
+// StartPlaybackOnDevice starts or resumes playback on the device with the given name.
+// Any DeviceID set in opts is replaced by the resolved device ID.
+func (c *Client) StartPlaybackOnDevice(ctx context.Context, deviceName string, opts *StartPlaybackOptions) error {
+	deviceID, err := c.deviceIDByName(ctx, deviceName)
+	if err != nil {
+		return err
+	}
+
+	var withDevice StartPlaybackOptions
+	if opts != nil {
+		withDevice = *opts
+	}
+	withDevice.DeviceID = deviceID
+
+	return c.CurrentUserStartPlayback(ctx, &withDevice)
+}
+
+// PausePlaybackOnDevice pauses playback on the device with the given name
+func (c *Client) PausePlaybackOnDevice(ctx context.Context, deviceName string) error {
+	deviceID, err := c.deviceIDByName(ctx, deviceName)
+	if err != nil {
+		return err
+	}
+
+	return c.CurrentUserPausePlayback(ctx, &PausePlaybackOptions{DeviceID: deviceID})
+}
+
+// TransferPlaybackToDevice transfers playback to the device with the given name
+func (c *Client) TransferPlaybackToDevice(ctx context.Context, deviceName string, opts *TransferPlaybackOptions) error {
+	deviceID, err := c.deviceIDByName(ctx, deviceName)
+	if err != nil {
+		return err
+	}
+
+	return c.CurrentUserTransferPlayback(ctx, []string{deviceID}, opts)
+}
+
+// SetVolumeOnDevice sets the volume (0-100) on the device with the given name
+func (c *Client) SetVolumeOnDevice(ctx context.Context, deviceName string, volumePercent int) error {
+	deviceID, err := c.deviceIDByName(ctx, deviceName)
+	if err != nil {
+		return err
+	}
+
+	return c.CurrentUserSetVolume(ctx, &SetVolumeOptions{
+		VolumePercent: volumePercent,
+		DeviceID:      deviceID,
+	})
+}
+
+// SkipToNextOnDevice skips to the next track on the device with the given name
+func (c *Client) SkipToNextOnDevice(ctx context.Context, deviceName string) error {
+	deviceID, err := c.deviceIDByName(ctx, deviceName)
+	if err != nil {
+		return err
+	}
+
+	return c.CurrentUserSkipToNext(ctx, deviceID)
+}
+
+// SkipToPreviousOnDevice skips to the previous track on the device with the given name
+func (c *Client) SkipToPreviousOnDevice(ctx context.Context, deviceName string) error {
+	deviceID, err := c.deviceIDByName(ctx, deviceName)
+	if err != nil {
+		return err
+	}
+
+	return c.CurrentUserSkipToPrevious(ctx, deviceID)
+}
+
+// IsNoActiveDeviceError reports whether err is Spotify's 404 NO_ACTIVE_DEVICE
+// response, returned by playback commands when no device is active
+func IsNoActiveDeviceError(err error) bool {
+	var spotifyErr *SpotifyError
+	if !errors.As(err, &spotifyErr) {
+		return false
+	}
+	return spotifyErr.HTTPStatus == 404 && spotifyErr.Reason == "NO_ACTIVE_DEVICE"
+}
+
+// EnsureActiveDevice makes sure the user has an active playback device.
+//
+// If playback is already active, the active device is returned. Otherwise
+// playback is transferred (without starting it) to, in order of preference:
+// preferredDeviceID, the last active device seen by this client, or the
+// first available unrestricted device.
+//
+// Example:
+//
+//	device, err := client.EnsureActiveDevice(ctx, "")
+//	if err != nil {
+//		// No devices available
+//	}
+//	err = client.CurrentUserStartPlayback(ctx, nil)
+func (c *Client) EnsureActiveDevice(ctx context.Context, preferredDeviceID string) (*Device, error) {
+	state, err := c.CurrentUserPlaybackState(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	if state.Device != nil && state.Device.IsActive && state.Device.ID != nil {
+		if preferredDeviceID == "" || *state.Device.ID == preferredDeviceID {
+			c.deviceCache.setLastActive(*state.Device.ID)
+			return state.Device, nil
+		}
+	}
+
+	devices, err := c.RefreshDevices(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	target := selectDevice(devices, preferredDeviceID, c.deviceCache.lastActive())
+	if target == nil {
+		if preferredDeviceID != "" {
+			return nil, &DeviceNotFoundError{Name: preferredDeviceID}
+		}
+		return nil, fmt.Errorf("no available playback devices: %w", ErrDeviceNotFound)
+	}
+
+	if !target.IsActive {
+		if err := c.CurrentUserTransferPlayback(ctx, []string{*target.ID}, &TransferPlaybackOptions{Play: false}); err != nil {
+			return nil, fmt.Errorf("failed to transfer playback to %q: %w", target.Name, err)
+		}
+		target.IsActive = true
+	}
+
+	c.deviceCache.setLastActive(*target.ID)
+	return target, nil
+}
+
+// selectDevice picks the device to activate: the preferred device if given,
+// otherwise the last active device, an already active device, or the first
+// unrestricted device
+func selectDevice(devices []Device, preferredID, lastActiveID string) *Device {
+	usable := func(d Device) bool {
+		return d.ID != nil && *d.ID != "" && !d.IsRestricted
+	}
+
+	if preferredID != "" {
+		for _, device := range devices {
+			if usable(device) && *device.ID == preferredID {
+				found := device
+				return &found
+			}
+		}
+		return nil
+	}
+
+	if lastActiveID != "" {
+		for _, device := range devices {
+			if usable(device) && *device.ID == lastActiveID {
+				found := device
+				return &found
+			}
+		}
+	}
+
+	for _, device := range devices {
+		if usable(device) && device.IsActive {
+			found := device
+			return &found
+		}
+	}
+
+	for _, device := range devices {
+		if usable(device) {
+			found := device
+			return &found
+		}
+	}
+
+	return nil
+}
+
+// WithActiveDevice runs a playback command, activating a device and retrying
+// once if the command fails with NO_ACTIVE_DEVICE.
+//
+// fn is first called with preferredDeviceID, which is empty to target the
+// active device. On retry it receives the ID of the device that was
+// activated, preferring preferredDeviceID.
+//
+// Example:
+//
+//	err := client.WithActiveDevice(ctx, "", func(ctx context.Context, deviceID string) error {
+//		return client.CurrentUserStartPlayback(ctx, &spotigo.StartPlaybackOptions{
+//			DeviceID: deviceID,
+//			URIs:     []string{"spotify:track:6b2oQwSGFkzsMtQruIWm2p"},
+//		})
+//	})
+func (c *Client) WithActiveDevice(ctx context.Context, preferredDeviceID string, fn func(ctx context.Context, deviceID string) error) error {
+	err := fn(ctx, preferredDeviceID)
+	if err == nil || !IsNoActiveDeviceError(err) {
+		return err
+	}
+
+	device, ensureErr := c.EnsureActiveDevice(ctx, preferredDeviceID)
+	if ensureErr != nil {
+		return fmt.Errorf("%w (failed to activate a device: %v)", err, ensureErr)
+	}
+
+	return fn(ctx, *device.ID)
+}
+
+// DevicesChangedEvent describes a change in the user's device list observed by WatchDevices
+type DevicesChangedEvent struct {
+	Devices []Device // Full device list after the change
+	Added   []Device // Devices that appeared
+	Removed []Device // Devices that disappeared
+	Changed []Device // Devices whose state (active, volume, name, ...) changed
+	Err     error    // Set if polling failed; other fields are empty
+}
+
+// DefaultDevicePollInterval is the polling interval of WatchDevices when
+// interval is not positive
+const DefaultDevicePollInterval = 5 * time.Second
+
+// WatchDevices polls the user's devices every interval (default:
+// DefaultDevicePollInterval) and sends an event whenever the device list
+// changes. The first successful poll is reported with every device in Added.
+//
+// Polling refreshes the device cache, so CurrentUserDevices and DeviceByName
+// calls made between polls are served from the cache. Polling errors are sent
+// as events with Err set and do not stop the watcher. The returned channel is
+// closed when ctx is cancelled.
+//
+// Example:
+//
+//	for event := range client.WatchDevices(ctx, 5*time.Second) {
+//		if event.Err != nil {
+//			log.Printf("device poll failed: %v", event.Err)
+//			continue
+//		}
+//		for _, device := range event.Added {
+//			fmt.Println("new device:", device.Name)
+//		}
+//	}
+func (c *Client) WatchDevices(ctx context.Context, interval time.Duration) <-chan DevicesChangedEvent {
+	if interval <= 0 {
+		interval = DefaultDevicePollInterval
+	}
+	events := make(chan DevicesChangedEvent)
+
+	go func() {
+		defer close(events)
+
+		var previous []Device
+		first := true
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			devices, err := c.RefreshDevices(ctx)
+			var event DevicesChangedEvent
+			send := false
+			if err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+				event = DevicesChangedEvent{Err: err}
+				send = true
+			} else {
+				event = diffDevices(previous, devices)
+				send = first || len(event.Added) > 0 || len(event.Removed) > 0 || len(event.Changed) > 0
+				previous = devices
+				first = false
+			}
+
+			if send {
+				select {
+				case events <- event:
+				case <-ctx.Done():
+					return
+				}
+			}
+
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return events
+}
+
+// deviceKey identifies a device across polls, falling back to its name
+// for devices without an ID
+func deviceKey(device Device) string {
+	if device.ID != nil && *device.ID != "" {
+		return "id:" + *device.ID
+	}
+	return "name:" + device.Name
+}
+
+// diffDevices compares two device lists
+func diffDevices(previous, current []Device) DevicesChangedEvent {
+	event := DevicesChangedEvent{Devices: current}
+
+	before := make(map[string]Device, len(previous))
+	for _, device := range previous {
+		before[deviceKey(device)] = device
+	}
+
+	seen := make(map[string]bool, len(current))
+	for _, device := range current {
+		key := deviceKey(device)
+		seen[key] = true
+		old, ok := before[key]
+		if !ok {
+			event.Added = append(event.Added, device)
+		} else if !sameDeviceState(old, device) {
+			event.Changed = append(event.Changed, device)
+		}
+	}
+
+	for _, device := range previous {
+		if !seen[deviceKey(device)] {
+			event.Removed = append(event.Removed, device)
+		}
+	}
+
+	return event
+}
+
+// sameDeviceState reports whether two observations of a device are identical
+func sameDeviceState(a, b Device) bool {
+	volumeEqual := (a.VolumePercent == nil && b.VolumePercent == nil) ||
+		(a.VolumePercent != nil && b.VolumePercent != nil && *a.VolumePercent == *b.VolumePercent)
+	return volumeEqual &&
+		a.Name == b.Name &&
+		a.Type == b.Type &&
+		a.IsActive == b.IsActive &&
+		a.IsPrivateSession == b.IsPrivateSession &&
+		a.IsRestricted == b.IsRestricted
+}
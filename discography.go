@@ -0,0 +1,191 @@
+package spotigo
+
+import (
+	"context"
+	"strings"
+)
+
+// ============================================================================
+// Artist Discography
+// ============================================================================
+
+// DiscographyOptions holds options for ArtistDiscography
+type DiscographyOptions struct {
+	Groups     []AlbumGroup // Album groups to include (default: all)
+	Market     string       // ISO 3166-1 alpha-2 country code
+	FullAlbums bool         // Fetch full Album objects, with tracks and label
+}
+
+// Release is one release in a discography, with the re-releases folded
+// into it
+type Release struct {
+	Album    SimplifiedAlbum   // The edition with the most tracks
+	Full     *Album            // Set with DiscographyOptions.FullAlbums
+	Editions []SimplifiedAlbum // Re-releases with the same name and release date
+}
+
+// Discography is an artist's releases by album group, each in the order
+// Spotify lists them (newest first)
+type Discography struct {
+	ArtistID     string
+	Albums       []Release
+	Singles      []Release
+	AppearsOn    []Release
+	Compilations []Release
+}
+
+// Group returns the releases in group
+func (d *Discography) Group(group AlbumGroup) []Release {
+	if releases := d.groupSlice(group); releases != nil {
+		return *releases
+	}
+	return nil
+}
+
+// ArtistDiscography fetches every page of an artist's albums for the
+// requested groups and returns them as a Discography.
+//
+// Spotify often lists the same release several times, for example once
+// per market when no market is given. Releases in the same group with the
+// same name (ignoring case and spacing) and release date are folded into
+// one Release, keeping the edition with the most tracks. With FullAlbums,
+// the full Album objects are fetched in batches of 20.
+//
+// Example:
+//
+//	disco, err := client.ArtistDiscography(ctx, artistID, &spotigo.DiscographyOptions{
+//		Groups: []spotigo.AlbumGroup{spotigo.AlbumGroupAlbum, spotigo.AlbumGroupSingle},
+//		Market: "US",
+//	})
+//	if err != nil {
+//		return err
+//	}
+//	for _, release := range disco.Albums {
+//		fmt.Println(release.Album.ReleaseDate, release.Album.Name)
+//	}
+func (c *Client) ArtistDiscography(ctx context.Context, artistID string, opts *DiscographyOptions) (*Discography, error) {
+	if opts == nil {
+		opts = &DiscographyOptions{}
+	}
+	id, err := GetID(artistID, "artist")
+	if err != nil {
+		return nil, err
+	}
+	if opts.Market != "" {
+		if err := validateMarketParameter(opts.Market); err != nil {
+			return nil, err
+		}
+	}
+
+	groups := opts.Groups
+	if len(groups) == 0 {
+		groups = []AlbumGroup{AlbumGroupAlbum, AlbumGroupSingle, AlbumGroupAppearsOn, AlbumGroupCompilation}
+	}
+
+	page, err := c.ArtistAlbums(ctx, id, &ArtistAlbumsOptions{Groups: groups, Country: opts.Market, Limit: 50})
+	if err != nil {
+		return nil, err
+	}
+
+	disco := &Discography{ArtistID: id}
+	releases := make(map[AlbumGroup]*[]Release)
+	for _, group := range groups {
+		releases[group] = disco.groupSlice(group)
+	}
+
+	// Index of each release by group, name, and date
+	index := make(map[string]int)
+	for album, err := range IteratePages(c, ctx, page) {
+		if err != nil {
+			return nil, err
+		}
+
+		group := albumGroupOf(album, groups)
+		list, ok := releases[group]
+		if !ok {
+			continue
+		}
+
+		key := string(group) + "\x00" + releaseKey(album)
+		i, seen := index[key]
+		if !seen {
+			index[key] = len(*list)
+			*list = append(*list, Release{Album: album})
+			continue
+		}
+
+		release := &(*list)[i]
+		if album.TotalTracks > release.Album.TotalTracks {
+			release.Album, album = album, release.Album
+		}
+		release.Editions = append(release.Editions, album)
+	}
+
+	if opts.FullAlbums {
+		if err := c.fillFullAlbums(ctx, disco, opts.Market); err != nil {
+			return nil, err
+		}
+	}
+
+	return disco, nil
+}
+
+// groupSlice returns a pointer to the releases for group
+func (d *Discography) groupSlice(group AlbumGroup) *[]Release {
+	switch group {
+	case AlbumGroupAlbum:
+		return &d.Albums
+	case AlbumGroupSingle:
+		return &d.Singles
+	case AlbumGroupAppearsOn:
+		return &d.AppearsOn
+	case AlbumGroupCompilation:
+		return &d.Compilations
+	}
+	return nil
+}
+
+// albumGroupOf returns the group Spotify listed album under, falling back to
+// the album type when the response has no album_group
+func albumGroupOf(album SimplifiedAlbum, requested []AlbumGroup) AlbumGroup {
+	if album.AlbumGroup != "" {
+		return AlbumGroup(album.AlbumGroup)
+	}
+	if len(requested) == 1 {
+		return requested[0]
+	}
+	return AlbumGroup(album.AlbumType)
+}
+
+// releaseKey identifies re-releases of the same album: the name with case
+// and spacing normalized, and the release date
+func releaseKey(album SimplifiedAlbum) string {
+	name := strings.Join(strings.Fields(strings.ToLower(album.Name)), " ")
+	return name + "\x00" + album.ReleaseDate.String()
+}
+
+// fillFullAlbums fetches the full Album for every release in disco
+func (c *Client) fillFullAlbums(ctx context.Context, disco *Discography, market string) error {
+	var all []*Release
+	for _, group := range []*[]Release{&disco.Albums, &disco.Singles, &disco.AppearsOn, &disco.Compilations} {
+		for i := range *group {
+			all = append(all, &(*group)[i])
+		}
+	}
+
+	ids := make([]string, len(all))
+	for i, release := range all {
+		ids[i] = release.Album.ID
+	}
+	albums, err := c.albumsByID(ctx, ids, market)
+	if err != nil {
+		return err
+	}
+
+	for _, release := range all {
+		if album, ok := albums[release.Album.ID]; ok {
+			release.Full = &album
+		}
+	}
+	return nil
+}
@@ -0,0 +1,240 @@
+package spotigo
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ============================================================================
+// Typed Enumerations
+// ============================================================================
+
+// RepeatState is a playback repeat mode
+type RepeatState string
+
+const (
+	RepeatTrack   RepeatState = "track"   // Repeat the current track
+	RepeatContext RepeatState = "context" // Repeat the current album or playlist
+	RepeatOff     RepeatState = "off"     // Repeat is off
+)
+
+// Validate returns an error if s is not a known repeat state
+func (s RepeatState) Validate() error {
+	switch s {
+	case RepeatTrack, RepeatContext, RepeatOff:
+		return nil
+	}
+	return fmt.Errorf("invalid repeat state: %q", string(s))
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting unknown states
+func (s RepeatState) MarshalText() ([]byte, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	return []byte(s), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, rejecting unknown states
+func (s *RepeatState) UnmarshalText(text []byte) error {
+	state := RepeatState(text)
+	if err := state.Validate(); err != nil {
+		return err
+	}
+	*s = state
+	return nil
+}
+
+// TimeRange is the period over which top items are calculated
+type TimeRange string
+
+const (
+	TimeRangeShort  TimeRange = "short_term"  // Approximately the last 4 weeks
+	TimeRangeMedium TimeRange = "medium_term" // Approximately the last 6 months
+	TimeRangeLong   TimeRange = "long_term"   // Approximately the last year
+)
+
+// Validate returns an error if r is not a known time range
+func (r TimeRange) Validate() error {
+	switch r {
+	case TimeRangeShort, TimeRangeMedium, TimeRangeLong:
+		return nil
+	}
+	return fmt.Errorf("invalid time range: %q", string(r))
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting unknown ranges
+func (r TimeRange) MarshalText() ([]byte, error) {
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+	return []byte(r), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, rejecting unknown ranges
+func (r *TimeRange) UnmarshalText(text []byte) error {
+	timeRange := TimeRange(text)
+	if err := timeRange.Validate(); err != nil {
+		return err
+	}
+	*r = timeRange
+	return nil
+}
+
+// SearchType is a kind of item returned by Search
+type SearchType string
+
+const (
+	SearchTypeAlbum     SearchType = "album"
+	SearchTypeArtist    SearchType = "artist"
+	SearchTypePlaylist  SearchType = "playlist"
+	SearchTypeTrack     SearchType = "track"
+	SearchTypeShow      SearchType = "show"
+	SearchTypeEpisode   SearchType = "episode"
+	SearchTypeAudiobook SearchType = "audiobook"
+)
+
+// Validate returns an error if t is not a known search type
+func (t SearchType) Validate() error {
+	switch t {
+	case SearchTypeAlbum, SearchTypeArtist, SearchTypePlaylist, SearchTypeTrack,
+		SearchTypeShow, SearchTypeEpisode, SearchTypeAudiobook:
+		return nil
+	}
+	return fmt.Errorf("invalid search type: %q", string(t))
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting unknown types
+func (t SearchType) MarshalText() ([]byte, error) {
+	if err := t.Validate(); err != nil {
+		return nil, err
+	}
+	return []byte(t), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, rejecting unknown types
+func (t *SearchType) UnmarshalText(text []byte) error {
+	searchType := SearchType(text)
+	if err := searchType.Validate(); err != nil {
+		return err
+	}
+	*t = searchType
+	return nil
+}
+
+// joinSearchTypes validates types and joins them for the type parameter
+func joinSearchTypes(types []SearchType) (string, error) {
+	parts := make([]string, len(types))
+	for i, t := range types {
+		if err := t.Validate(); err != nil {
+			return "", err
+		}
+		parts[i] = string(t)
+	}
+	return strings.Join(parts, ","), nil
+}
+
+// AlbumGroup is the relationship between an artist and an album
+type AlbumGroup string
+
+const (
+	AlbumGroupAlbum       AlbumGroup = "album"
+	AlbumGroupSingle      AlbumGroup = "single"
+	AlbumGroupAppearsOn   AlbumGroup = "appears_on"
+	AlbumGroupCompilation AlbumGroup = "compilation"
+)
+
+// Validate returns an error if g is not a known album group
+func (g AlbumGroup) Validate() error {
+	switch g {
+	case AlbumGroupAlbum, AlbumGroupSingle, AlbumGroupAppearsOn, AlbumGroupCompilation:
+		return nil
+	}
+	return fmt.Errorf("invalid album group: %q", string(g))
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting unknown groups
+func (g AlbumGroup) MarshalText() ([]byte, error) {
+	if err := g.Validate(); err != nil {
+		return nil, err
+	}
+	return []byte(g), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, rejecting unknown groups
+func (g *AlbumGroup) UnmarshalText(text []byte) error {
+	group := AlbumGroup(text)
+	if err := group.Validate(); err != nil {
+		return err
+	}
+	*g = group
+	return nil
+}
+
+// DeviceType is the kind of a playback device.
+//
+// Spotify adds device types over time, so unlike the other enums unknown
+// values are kept rather than rejected; use Known to check for them.
+type DeviceType string
+
+const (
+	DeviceTypeComputer    DeviceType = "Computer"
+	DeviceTypeTablet      DeviceType = "Tablet"
+	DeviceTypeSmartphone  DeviceType = "Smartphone"
+	DeviceTypeSpeaker     DeviceType = "Speaker"
+	DeviceTypeTV          DeviceType = "TV"
+	DeviceTypeAVR         DeviceType = "AVR"
+	DeviceTypeSTB         DeviceType = "STB"
+	DeviceTypeAudioDongle DeviceType = "AudioDongle"
+	DeviceTypeGameConsole DeviceType = "GameConsole"
+	DeviceTypeCastVideo   DeviceType = "CastVideo"
+	DeviceTypeCastAudio   DeviceType = "CastAudio"
+	DeviceTypeAutomobile  DeviceType = "Automobile"
+	DeviceTypeUnknown     DeviceType = "Unknown"
+)
+
+// knownDeviceTypes lists the documented device types
+var knownDeviceTypes = []DeviceType{
+	DeviceTypeComputer, DeviceTypeTablet, DeviceTypeSmartphone, DeviceTypeSpeaker,
+	DeviceTypeTV, DeviceTypeAVR, DeviceTypeSTB, DeviceTypeAudioDongle,
+	DeviceTypeGameConsole, DeviceTypeCastVideo, DeviceTypeCastAudio,
+	DeviceTypeAutomobile, DeviceTypeUnknown,
+}
+
+// ParseDeviceType converts a device type string, matching known types
+// case-insensitively. Unknown values are returned unchanged.
+func ParseDeviceType(s string) DeviceType {
+	for _, known := range knownDeviceTypes {
+		if strings.EqualFold(s, string(known)) {
+			return known
+		}
+	}
+	return DeviceType(s)
+}
+
+// Known reports whether t is a documented device type
+func (t DeviceType) Known() bool {
+	for _, known := range knownDeviceTypes {
+		if t == known {
+			return true
+		}
+	}
+	return false
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, normalizing the case
+// of known types
+func (t *DeviceType) UnmarshalText(text []byte) error {
+	*t = ParseDeviceType(string(text))
+	return nil
+}
+
+// DeviceType returns the device's type as a DeviceType
+func (d Device) DeviceType() DeviceType {
+	return ParseDeviceType(d.Type)
+}
+
+// Repeat returns the repeat state as a RepeatState
+func (p PlaybackState) Repeat() RepeatState {
+	return RepeatState(p.RepeatState)
+}
@@ -0,0 +1,119 @@
+package spotigo
+
+import (
+	"context"
+	"os"
+	"time"
+)
+
+// ============================================================================
+// Environment Configuration
+// ============================================================================
+
+// EnvRefreshToken names the environment variable holding a refresh token
+// for NewFromEnv
+const EnvRefreshToken = "SPOTIGO_REFRESH_TOKEN"
+
+// Environment variable names used by other Spotify SDKs, read by NewFromEnv
+// when the SPOTIGO_ equivalent is not set
+const (
+	EnvSpotifyClientID     = "SPOTIFY_CLIENT_ID"
+	EnvSpotifyClientSecret = "SPOTIFY_CLIENT_SECRET"
+	EnvSpotifyRedirectURI  = "SPOTIFY_REDIRECT_URI"
+	EnvSpotifyRefreshToken = "SPOTIFY_REFRESH_TOKEN"
+)
+
+// lookupEnv returns the value of the first set variable in names
+func lookupEnv(names ...string) string {
+	for _, name := range names {
+		if value := os.Getenv(name); value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+// NewFromEnv creates a client configured from environment variables, each
+// read from its SPOTIGO_ name or else its SPOTIFY_ name:
+//
+//   - CLIENT_ID is required.
+//   - With REFRESH_TOKEN, the client acts for that user, using the
+//     Authorization Code flow if CLIENT_SECRET is set and PKCE otherwise.
+//   - With REDIRECT_URI but no refresh token, the user's token must already
+//     be in the token cache, e.g. from an earlier authorization.
+//   - Otherwise CLIENT_SECRET is required and the Client Credentials flow
+//     is used.
+//
+// User tokens are kept in a FileCacheHandler at SPOTIGO_CACHE_PATH (default:
+// .cache, or .cache-{SPOTIGO_CLIENT_USERNAME}), so refresh tokens rotated by
+// Spotify survive restarts. A cached token takes precedence over
+// REFRESH_TOKEN. opts are applied to the client as in NewClient.
+//
+// Example:
+//
+//	client, err := spotigo.NewFromEnv(ctx)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+func NewFromEnv(ctx context.Context, opts ...ClientOption) (*Client, error) {
+	clientID := lookupEnv(EnvClientID, EnvSpotifyClientID)
+	clientSecret := lookupEnv(EnvClientSecret, EnvSpotifyClientSecret)
+	redirectURI := lookupEnv(EnvRedirectURI, EnvSpotifyRedirectURI)
+	refreshToken := lookupEnv(EnvRefreshToken, EnvSpotifyRefreshToken)
+
+	if clientID == "" {
+		return nil, &SpotifyOAuthError{
+			ErrorType:        "missing_parameter",
+			ErrorDescription: "No client_id. Set a " + EnvClientID + " or " + EnvSpotifyClientID + " environment variable.",
+		}
+	}
+
+	if refreshToken == "" && redirectURI == "" {
+		if clientSecret == "" {
+			return nil, &SpotifyOAuthError{
+				ErrorType:        "missing_parameter",
+				ErrorDescription: "No client_secret. Set a " + EnvClientSecret + " or " + EnvSpotifyClientSecret + " environment variable, or a refresh token for PKCE.",
+			}
+		}
+		auth, err := NewClientCredentials(clientID, clientSecret)
+		if err != nil {
+			return nil, err
+		}
+		return NewClient(auth, opts...)
+	}
+
+	cache, err := NewFileCacheHandler("", os.Getenv(EnvUsername))
+	if err != nil {
+		return nil, err
+	}
+	base := &SpotifyAuthBase{
+		ClientID:        clientID,
+		ClientSecret:    clientSecret,
+		RedirectURI:     redirectURI,
+		HTTPClient:      newHTTPClient(5 * time.Second),
+		RequestsTimeout: 5 * time.Second,
+		ReplayGuard:     NewReplayGuard(DefaultReplayWindow),
+		CacheHandler:    cache,
+	}
+
+	// Refresh tokens rotated since the variable was set are in the cache
+	if cached, err := cache.GetCachedToken(ctx); err == nil && cached != nil {
+		base.TokenInfo = cached
+		if cached.RefreshToken == "" {
+			cached.RefreshToken = refreshToken
+		}
+	} else if refreshToken != "" {
+		base.TokenInfo = &TokenInfo{RefreshToken: refreshToken}
+	} else {
+		return nil, &SpotifyOAuthError{
+			ErrorType:        "no_token",
+			ErrorDescription: "No cached token. Authorize first or set a " + EnvRefreshToken + " or " + EnvSpotifyRefreshToken + " environment variable.",
+		}
+	}
+
+	var auth AuthManager = &SpotifyOAuth{SpotifyAuthBase: base}
+	if clientSecret == "" {
+		auth = &SpotifyPKCE{SpotifyAuthBase: base}
+	}
+	return NewClient(auth, opts...)
+}
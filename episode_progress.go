@@ -0,0 +1,231 @@
+package spotigo
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// ============================================================================
+// Episode Progress
+// ============================================================================
+
+// episodeEndMargin is how close to the end a watched episode must stop to be
+// reported as fully played
+const episodeEndMargin = 30 * time.Second
+
+// EpisodeProgress is how far the current user has listened to an episode
+type EpisodeProgress struct {
+	EpisodeID   string        // Episode ID
+	Name        string        // Episode name
+	Position    time.Duration // Resume position
+	Duration    time.Duration // Episode length
+	FullyPlayed bool          // Whether the episode has been played to the end
+}
+
+// Fraction returns the share of the episode listened to, from 0 to 1
+func (p EpisodeProgress) Fraction() float64 {
+	if p.FullyPlayed {
+		return 1
+	}
+	if p.Duration <= 0 {
+		return 0
+	}
+	return min(float64(p.Position)/float64(p.Duration), 1)
+}
+
+// Remaining returns the time left to listen
+func (p EpisodeProgress) Remaining() time.Duration {
+	if p.FullyPlayed {
+		return 0
+	}
+	return max(p.Duration-p.Position, 0)
+}
+
+// episodeProgress builds progress from an episode's resume point
+func episodeProgress(episode SimplifiedEpisode) EpisodeProgress {
+	progress := EpisodeProgress{
+		EpisodeID: episode.ID,
+		Name:      episode.Name,
+		Duration:  time.Duration(episode.DurationMs) * time.Millisecond,
+	}
+	if episode.ResumePoint != nil {
+		progress.Position = time.Duration(episode.ResumePoint.ResumePositionMs) * time.Millisecond
+		progress.FullyPlayed = episode.ResumePoint.FullyPlayed
+	}
+	return progress
+}
+
+// EpisodeProgress returns the current user's listening progress for
+// episodes given as IDs, URIs, or URLs, from their resume points. Requires
+// the user-read-playback-position scope.
+//
+// The result is keyed by the episodes as passed in. Episodes are looked up
+// in batches of 50; episodes Spotify does not know are left out of the map,
+// and IDs that cannot be parsed are reported in a *MultiError, returned
+// alongside the results for valid IDs.
+//
+// Example:
+//
+//	progress, err := client.EpisodeProgress(ctx, []string{"512ojhOuo1ktJprKbVcKyQ"})
+//	if err != nil {
+//		return err
+//	}
+//	p := progress["512ojhOuo1ktJprKbVcKyQ"]
+//	fmt.Printf("%s: %.0f%% played, %s left\n", p.Name, p.Fraction()*100, p.Remaining())
+func (c *Client) EpisodeProgress(ctx context.Context, ids []string, market ...string) (map[string]EpisodeProgress, error) {
+	episodeIDs, inputs, invalidIDs := uniqueIDs(ids, "episode")
+	episodes, err := c.episodesByID(ctx, episodeIDs, market...)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]EpisodeProgress, len(ids))
+	for id, episode := range episodes {
+		for _, input := range inputs[id] {
+			result[input] = episodeProgress(episode.SimplifiedEpisode)
+		}
+	}
+	return result, invalidIDs.ErrorOrNil()
+}
+
+// CurrentUserSavedEpisodesProgress returns listening progress for every
+// episode saved in the current user's library, most recently saved first.
+// Requires the user-library-read and user-read-playback-position scopes.
+//
+// Example:
+//
+//	progress, err := client.CurrentUserSavedEpisodesProgress(ctx)
+//	for _, p := range progress {
+//		if !p.FullyPlayed && p.Position > 0 {
+//			fmt.Println("Continue listening:", p.Name)
+//		}
+//	}
+func (c *Client) CurrentUserSavedEpisodesProgress(ctx context.Context) ([]EpisodeProgress, error) {
+	first, err := c.CurrentUserSavedEpisodes(ctx, &SavedEpisodesOptions{Limit: 50})
+	if err != nil {
+		return nil, err
+	}
+
+	var progress []EpisodeProgress
+	for saved, err := range IteratePages(c, ctx, first) {
+		if err != nil {
+			return nil, err
+		}
+		progress = append(progress, episodeProgress(saved.Episode.SimplifiedEpisode))
+	}
+	return progress, nil
+}
+
+// EpisodeProgressEvent reports listening progress observed by
+// WatchEpisodeProgress
+type EpisodeProgressEvent struct {
+	Progress EpisodeProgress // Latest progress of the episode
+	Playing  bool            // Whether the episode is playing (false when paused)
+	Stopped  bool            // The episode is no longer the current item; Progress is its last known position
+	Err      error           // Set if polling failed; other fields are empty
+}
+
+// DefaultEpisodeProgressInterval is the polling interval of
+// WatchEpisodeProgress when interval is not positive
+const DefaultEpisodeProgressInterval = 15 * time.Second
+
+// WatchEpisodeProgress polls the user's playback state every interval
+// (default: DefaultEpisodeProgressInterval) and reports progress while a
+// podcast episode is the current item: an event is sent whenever the
+// position or play/pause state changes, and a final event with Stopped set
+// when playback moves on to something else. An episode that stops within 30
+// seconds of its end is reported as fully played.
+//
+// Polling errors are sent as events with Err set and do not stop the
+// watcher. The returned channel is closed when ctx is cancelled.
+//
+// Example:
+//
+//	for event := range client.WatchEpisodeProgress(ctx, 15*time.Second) {
+//		if event.Err != nil {
+//			continue
+//		}
+//		saveProgress(event.Progress) // Sync to your own store
+//	}
+func (c *Client) WatchEpisodeProgress(ctx context.Context, interval time.Duration) <-chan EpisodeProgressEvent {
+	if interval <= 0 {
+		interval = DefaultEpisodeProgressInterval
+	}
+	events := make(chan EpisodeProgressEvent)
+
+	go func() {
+		defer close(events)
+
+		var last *EpisodeProgressEvent
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		send := func(event EpisodeProgressEvent) bool {
+			select {
+			case events <- event:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+
+		for {
+			state, err := c.CurrentUserPlaybackState(ctx, &CurrentlyPlayingOptions{AdditionalTypes: "episode"})
+			if err != nil {
+				if ctx.Err() != nil || !send(EpisodeProgressEvent{Err: err}) {
+					return
+				}
+			} else {
+				current := playingEpisodeProgress(state)
+				if last != nil && (current == nil || current.Progress.EpisodeID != last.Progress.EpisodeID) {
+					final := *last
+					final.Playing = false
+					final.Stopped = true
+					if final.Progress.Remaining() <= episodeEndMargin {
+						final.Progress.FullyPlayed = true
+					}
+					if !send(final) {
+						return
+					}
+					last = nil
+				}
+				if current != nil && (last == nil || *current != *last) {
+					if !send(*current) {
+						return
+					}
+					last = current
+				}
+			}
+
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return events
+}
+
+// playingEpisodeProgress returns a progress event for the episode in a
+// playback state, or nil if no episode is the current item
+func playingEpisodeProgress(state *PlaybackState) *EpisodeProgressEvent {
+	if state == nil || state.Item == nil || state.CurrentlyPlayingType != "episode" {
+		return nil
+	}
+	data, err := json.Marshal(state.Item)
+	if err != nil {
+		return nil
+	}
+	var episode SimplifiedEpisode
+	if err := json.Unmarshal(data, &episode); err != nil || episode.ID == "" {
+		return nil
+	}
+
+	progress := episodeProgress(episode)
+	progress.Position = time.Duration(state.ProgressMs) * time.Millisecond
+	progress.FullyPlayed = false
+	return &EpisodeProgressEvent{Progress: progress, Playing: state.IsPlaying}
+}
@@ -1,12 +1,16 @@
 package spotigo
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"strconv"
 	"strings"
 	"time"
+	"unicode/utf8"
 )
 
 // SpotifyBaseException is a marker interface for all Spotify-specific errors.
@@ -40,6 +44,10 @@ type SpotifyError struct {
 	Message    string // Error message (without URL prefix)
 	Reason     string
 	Headers    map[string][]string
+
+	ContentType string // Content-Type of the error response
+	Body        string // Start of a non-JSON response body, up to 512 bytes, for diagnostics
+	Gateway     bool   // A proxy in front of the API failed (502, 503, or 504 without a Spotify error body)
 }
 
 // Error implements the error interface with structured format
@@ -76,6 +84,19 @@ func (e *SpotifyError) IsRetryable() bool {
 		(e.HTTPStatus >= 500 && e.HTTPStatus < 600)
 }
 
+// IsGatewayError reports whether the error came from the gateway in front of
+// the API rather than from Spotify's application: a 502, 503, or 504 whose
+// body is not a Spotify JSON error, typically an HTML page. Gateway errors are
+// transient transport failures; application 5xx errors may repeat.
+func (e *SpotifyError) IsGatewayError() bool {
+	return e.Gateway
+}
+
+// Is reports whether target is ErrGateway and the error is a gateway error
+func (e *SpotifyError) Is(target error) bool {
+	return target == ErrGateway && e.Gateway
+}
+
 // RetryAfter extracts the Retry-After header value if present
 func (e *SpotifyError) RetryAfter() (time.Duration, bool) {
 	if e.Headers == nil {
@@ -137,6 +158,142 @@ func (e *SpotifyStateError) Error() string {
 // isSpotifyError marks this as a Spotify error
 func (e *SpotifyStateError) isSpotifyError() {}
 
+// ErrDeviceNotFound is returned when a playback device cannot be found.
+// Use errors.Is(err, ErrDeviceNotFound) to check for it.
+var ErrDeviceNotFound = errors.New("device not found")
+
+// DeviceNotFoundError represents a failed device lookup by name
+type DeviceNotFoundError struct {
+	Name string // Device name that was looked up
+}
+
+// Error implements the error interface
+func (e *DeviceNotFoundError) Error() string {
+	return fmt.Sprintf("device not found: %q", e.Name)
+}
+
+// Is reports whether target is ErrDeviceNotFound
+func (e *DeviceNotFoundError) Is(target error) bool {
+	return target == ErrDeviceNotFound
+}
+
+// isSpotifyError marks this as a Spotify error
+func (e *DeviceNotFoundError) isSpotifyError() {}
+
+// ErrIdentifierNotFound is returned when no catalog item has a given
+// external identifier (ISRC or UPC).
+// Use errors.Is(err, ErrIdentifierNotFound) to check for it.
+var ErrIdentifierNotFound = errors.New("identifier not found")
+
+// IdentifierNotFoundError represents a failed lookup by external identifier
+type IdentifierNotFoundError struct {
+	Kind  string // Identifier kind ("isrc" or "upc")
+	Value string // Normalized identifier that was looked up
+}
+
+// Error implements the error interface
+func (e *IdentifierNotFoundError) Error() string {
+	return fmt.Sprintf("no match for %s %q", e.Kind, e.Value)
+}
+
+// Is reports whether target is ErrIdentifierNotFound
+func (e *IdentifierNotFoundError) Is(target error) bool {
+	return target == ErrIdentifierNotFound
+}
+
+// isSpotifyError marks this as a Spotify error
+func (e *IdentifierNotFoundError) isSpotifyError() {}
+
+// ItemError records the failure of a single item in a batch operation
+type ItemError struct {
+	Index int    // Position of the item in the caller's input
+	ID    string // Entity ID, URI, or URL of the item (may be empty)
+	Err   error  // Underlying error
+}
+
+// Error implements the error interface
+func (e *ItemError) Error() string {
+	if e.ID != "" {
+		return fmt.Sprintf("[%d] %s: %v", e.Index, e.ID, e.Err)
+	}
+	return fmt.Sprintf("[%d] %v", e.Index, e.Err)
+}
+
+// Unwrap returns the underlying error
+func (e *ItemError) Unwrap() error {
+	return e.Err
+}
+
+// MultiError aggregates per-item failures from batch helpers.
+//
+// It implements Unwrap() []error so errors.Is and errors.As match against
+// any of the underlying item errors.
+//
+// Example:
+//
+//	_, err := client.PlaylistAddItems(ctx, playlistID, items)
+//	var multiErr *spotigo.MultiError
+//	if errors.As(err, &multiErr) {
+//		for _, itemErr := range multiErr.Errors {
+//			fmt.Printf("item %d (%s) failed: %v\n", itemErr.Index, itemErr.ID, itemErr.Err)
+//		}
+//	}
+type MultiError struct {
+	Errors []*ItemError
+}
+
+// Add records a failure for the item at index
+func (m *MultiError) Add(index int, id string, err error) {
+	if err == nil {
+		return
+	}
+	m.Errors = append(m.Errors, &ItemError{Index: index, ID: id, Err: err})
+}
+
+// Len returns the number of recorded failures
+func (m *MultiError) Len() int {
+	if m == nil {
+		return 0
+	}
+	return len(m.Errors)
+}
+
+// ErrorOrNil returns the MultiError if any failures were recorded, otherwise nil
+func (m *MultiError) ErrorOrNil() error {
+	if m.Len() == 0 {
+		return nil
+	}
+	return m
+}
+
+// Error implements the error interface with a one-line summary of all failures
+func (m *MultiError) Error() string {
+	switch m.Len() {
+	case 0:
+		return "no errors"
+	case 1:
+		return fmt.Sprintf("1 item failed: %v", m.Errors[0])
+	}
+
+	parts := make([]string, len(m.Errors))
+	for i, itemErr := range m.Errors {
+		parts[i] = itemErr.Error()
+	}
+	return fmt.Sprintf("%d items failed: %s", len(m.Errors), strings.Join(parts, "; "))
+}
+
+// Unwrap returns the per-item errors for use with errors.Is and errors.As
+func (m *MultiError) Unwrap() []error {
+	errs := make([]error, len(m.Errors))
+	for i, itemErr := range m.Errors {
+		errs[i] = itemErr
+	}
+	return errs
+}
+
+// isSpotifyError marks this as a Spotify error
+func (m *MultiError) isSpotifyError() {}
+
 // ErrorResponse represents the JSON structure of Spotify error responses
 type ErrorResponse struct {
 	Error struct {
@@ -146,6 +303,14 @@ type ErrorResponse struct {
 	} `json:"error"`
 }
 
+// ErrGateway matches SpotifyErrors from the gateway in front of the API (see
+// SpotifyError.IsGatewayError).
+// Use errors.Is(err, ErrGateway) to check for it.
+var ErrGateway = errors.New("gateway error")
+
+// errorBodySnippetMax is how much of a non-JSON error body SpotifyError keeps
+const errorBodySnippetMax = 512
+
 // WrapHTTPError wraps an HTTP error with Spotify error information.
 // Always creates a SpotifyError for HTTP error status codes (>= 400), even if err is nil.
 // If err is provided, it will be wrapped; otherwise, the SpotifyError is returned directly.
@@ -162,24 +327,43 @@ func WrapHTTPError(err error, statusCode int, method string, url string, body []
 	spotifyErr := &SpotifyError{
 		HTTPStatus: statusCode,
 		Code:       -1,
-		URL:        url,  // Structured field
-		Method:     method, // HTTP method
-		Message:    string(body), // Without URL prefix
-		Headers:    headers,
+		URL:        RedactString(url),          // Structured field
+		Method:     method,                     // HTTP method
+		Message:    RedactString(string(body)), // Without URL prefix
+		Headers:    RedactHeaders(headers),
+	}
+
+	if values := headers["Content-Type"]; len(values) > 0 {
+		spotifyErr.ContentType = values[0]
 	}
 
 	// Try to parse JSON error response
 	var errorResp ErrorResponse
-	if jsonErr := json.Unmarshal(body, &errorResp); jsonErr == nil {
+	jsonErr := json.Unmarshal(body, &errorResp)
+	if jsonErr == nil {
 		spotifyErr.Code = errorResp.Error.Status
 		if errorResp.Error.Message != "" {
-			spotifyErr.Message = errorResp.Error.Message // Clean message
+			spotifyErr.Message = RedactString(errorResp.Error.Message) // Clean message
 		}
 		if errorResp.Error.Reason != "" {
 			spotifyErr.Reason = errorResp.Error.Reason
 		}
 	} else if len(body) > 0 {
-		spotifyErr.Message = string(body)
+		// Gateways answer with HTML pages that would swamp the message
+		spotifyErr.Body = RedactString(truncateUTF8(string(body), errorBodySnippetMax))
+		spotifyErr.Message = spotifyErr.Body
+		if isHTMLBody(spotifyErr.ContentType, body) {
+			spotifyErr.Message = htmlTitle(body)
+			if spotifyErr.Message == "" {
+				spotifyErr.Message = http.StatusText(statusCode)
+			}
+		}
+	}
+
+	applicationError := jsonErr == nil && (errorResp.Error.Status != 0 || errorResp.Error.Message != "")
+	switch statusCode {
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		spotifyErr.Gateway = !applicationError
 	}
 
 	// If there's an underlying error, wrap it
@@ -190,6 +374,40 @@ func WrapHTTPError(err error, statusCode int, method string, url string, body []
 	return spotifyErr
 }
 
+// truncateUTF8 cuts s to at most n bytes without splitting a character
+func truncateUTF8(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n]
+}
+
+// isHTMLBody reports whether an error body is an HTML page
+func isHTMLBody(contentType string, body []byte) bool {
+	if strings.HasPrefix(strings.ToLower(contentType), "text/html") {
+		return true
+	}
+	return bytes.HasPrefix(bytes.TrimSpace(body), []byte("<"))
+}
+
+// htmlTitle returns the text of an HTML page's <title>, or ""
+func htmlTitle(body []byte) string {
+	lower := bytes.ToLower(body)
+	start := bytes.Index(lower, []byte("<title>"))
+	if start < 0 {
+		return ""
+	}
+	start += len("<title>")
+	end := bytes.Index(lower[start:], []byte("</title>"))
+	if end < 0 {
+		return ""
+	}
+	return strings.Join(strings.Fields(string(body[start:start+end])), " ")
+}
+
 // WrapRetryError wraps errors that occur during retry attempts
 func WrapRetryError(err error, url string, reason string) error {
 	if err == nil {
@@ -216,3 +434,332 @@ func WrapJSONError(err error) error {
 	}
 	return fmt.Errorf("failed to parse JSON response: %w", err)
 }
+
+// ErrNoDeadline is returned when the client requires a context deadline
+// (DeadlineRequire) and a call's context has none
+var ErrNoDeadline = errors.New("context has no deadline")
+
+// ErrDeadlineWouldExceed is returned when a retry is abandoned because its
+// backoff would outlast the context deadline.
+// Use errors.Is(err, ErrDeadlineWouldExceed) to check for it.
+var ErrDeadlineWouldExceed = errors.New("retry would exceed context deadline")
+
+// DeadlineWouldExceedError represents a retry abandoned before its backoff
+// because the context deadline would pass first
+type DeadlineWouldExceedError struct {
+	Attempt   int           // Attempts made, counting the first
+	Delay     time.Duration // Backoff the next retry needed
+	Remaining time.Duration // Time left before the deadline
+	Err       error         // Error of the last attempt
+}
+
+// Error implements the error interface
+func (e *DeadlineWouldExceedError) Error() string {
+	return fmt.Sprintf("giving up after %d attempts: retry in %s would exceed deadline in %s: %v",
+		e.Attempt, e.Delay.Round(time.Millisecond), e.Remaining.Round(time.Millisecond), e.Err)
+}
+
+// Is reports whether target is ErrDeadlineWouldExceed or
+// context.DeadlineExceeded, which the call would have failed with anyway
+func (e *DeadlineWouldExceedError) Is(target error) bool {
+	return target == ErrDeadlineWouldExceed || target == context.DeadlineExceeded
+}
+
+// Unwrap returns the error of the last attempt
+func (e *DeadlineWouldExceedError) Unwrap() error {
+	return e.Err
+}
+
+// isSpotifyError marks this as a Spotify error
+func (e *DeadlineWouldExceedError) isSpotifyError() {}
+
+// ErrCircuitOpen is returned when requests are rejected because the circuit
+// breaker is open after repeated server failures.
+// Use errors.Is(err, ErrCircuitOpen) to check for it.
+var ErrCircuitOpen = errors.New("circuit breaker open")
+
+// CircuitOpenError represents a request rejected by an open circuit breaker
+type CircuitOpenError struct {
+	Failures int       // Consecutive failures that opened the circuit
+	Until    time.Time // When the circuit allows a trial request again
+}
+
+// Error implements the error interface
+func (e *CircuitOpenError) Error() string {
+	return fmt.Sprintf("circuit breaker open after %d consecutive failures, retry after %s",
+		e.Failures, e.Until.Format(time.RFC3339))
+}
+
+// Is reports whether target is ErrCircuitOpen
+func (e *CircuitOpenError) Is(target error) bool {
+	return target == ErrCircuitOpen
+}
+
+// isSpotifyError marks this as a Spotify error
+func (e *CircuitOpenError) isSpotifyError() {}
+
+// ErrCallbackReplayed is returned when an OAuth callback's authorization code
+// or state has already been used.
+// Use errors.Is(err, ErrCallbackReplayed) to check for it.
+var ErrCallbackReplayed = errors.New("oauth callback replayed")
+
+// SpotifyReplayError represents a replayed OAuth callback
+type SpotifyReplayError struct {
+	*SpotifyOAuthError
+	Kind string // What was replayed: "code" or "state"
+}
+
+// Error implements the error interface
+func (e *SpotifyReplayError) Error() string {
+	return fmt.Sprintf("Replayed OAuth callback %s. %s", e.Kind, e.SpotifyOAuthError.Error())
+}
+
+// Is reports whether target is ErrCallbackReplayed
+func (e *SpotifyReplayError) Is(target error) bool {
+	return target == ErrCallbackReplayed
+}
+
+// isSpotifyError marks this as a Spotify error
+func (e *SpotifyReplayError) isSpotifyError() {}
+
+// ErrValidation is returned when arguments are rejected before any request
+// is sent.
+// Use errors.Is(err, ErrValidation) to check for it, or errors.As with
+// TooManyIDsError, InvalidMarketError, or MissingOptionError for details.
+var ErrValidation = errors.New("invalid request")
+
+// TooManyIDsError represents a batch call given more IDs than Spotify accepts
+type TooManyIDsError struct {
+	Kind string // What the IDs identify, e.g. "tracks"
+	Max  int    // Maximum accepted per request
+	Got  int    // Number given
+}
+
+// Error implements the error interface
+func (e *TooManyIDsError) Error() string {
+	return fmt.Sprintf("maximum %d %s per request, got %d", e.Max, e.Kind, e.Got)
+}
+
+// Is reports whether target is ErrValidation
+func (e *TooManyIDsError) Is(target error) bool {
+	return target == ErrValidation
+}
+
+// isSpotifyError marks this as a Spotify error
+func (e *TooManyIDsError) isSpotifyError() {}
+
+// InvalidMarketError represents a market that is not an ISO 3166-1 alpha-2
+// country code or "from_token"
+type InvalidMarketError struct {
+	Code string // The rejected market
+}
+
+// Error implements the error interface
+func (e *InvalidMarketError) Error() string {
+	return fmt.Sprintf("invalid country code: %s", e.Code)
+}
+
+// Is reports whether target is ErrValidation
+func (e *InvalidMarketError) Is(target error) bool {
+	return target == ErrValidation
+}
+
+// isSpotifyError marks this as a Spotify error
+func (e *InvalidMarketError) isSpotifyError() {}
+
+// InvalidSeedGenreError represents a recommendation genre seed Spotify
+// does not offer
+type InvalidSeedGenreError struct {
+	Genre string // The rejected genre, normalized
+}
+
+// Error implements the error interface
+func (e *InvalidSeedGenreError) Error() string {
+	return fmt.Sprintf("unknown genre seed: %s", e.Genre)
+}
+
+// Is reports whether target is ErrValidation
+func (e *InvalidSeedGenreError) Is(target error) bool {
+	return target == ErrValidation
+}
+
+// isSpotifyError marks this as a Spotify error
+func (e *InvalidSeedGenreError) isSpotifyError() {}
+
+// InvalidOptionError represents an argument or option with a value Spotify
+// would reject
+type InvalidOptionError struct {
+	Field  string // Name of the argument or option, e.g. "limit"
+	Reason string // What is wrong with it, e.g. "must be non-negative, got -1"
+}
+
+// Error implements the error interface
+func (e *InvalidOptionError) Error() string {
+	return fmt.Sprintf("%s %s", e.Field, e.Reason)
+}
+
+// Is reports whether target is ErrValidation
+func (e *InvalidOptionError) Is(target error) bool {
+	return target == ErrValidation
+}
+
+// isSpotifyError marks this as a Spotify error
+func (e *InvalidOptionError) isSpotifyError() {}
+
+// MissingOptionError represents a required argument or option that was not set
+type MissingOptionError struct {
+	Field string // Name of the missing argument or option, e.g. "opts.Name"
+}
+
+// Error implements the error interface
+func (e *MissingOptionError) Error() string {
+	return fmt.Sprintf("%s is required", e.Field)
+}
+
+// Is reports whether target is ErrValidation
+func (e *MissingOptionError) Is(target error) bool {
+	return target == ErrValidation
+}
+
+// isSpotifyError marks this as a Spotify error
+func (e *MissingOptionError) isSpotifyError() {}
+
+// ErrResponseTooLarge is returned when a response body exceeds the client's
+// MaxResponseBytes.
+// Use errors.Is(err, ErrResponseTooLarge) to check for it.
+var ErrResponseTooLarge = errors.New("response too large")
+
+// ResponseTooLargeError represents a response body that was abandoned at
+// the client's size limit
+type ResponseTooLargeError struct {
+	Limit int64 // MaxResponseBytes
+	Read  int64 // Bytes read before giving up
+}
+
+// Error implements the error interface
+func (e *ResponseTooLargeError) Error() string {
+	return fmt.Sprintf("response body exceeds %d bytes (read %d)", e.Limit, e.Read)
+}
+
+// Is reports whether target is ErrResponseTooLarge
+func (e *ResponseTooLargeError) Is(target error) bool {
+	return target == ErrResponseTooLarge
+}
+
+// isSpotifyError marks this as a Spotify error
+func (e *ResponseTooLargeError) isSpotifyError() {}
+
+// ErrNotInQueue is returned when an item is neither playing nor in the
+// user's playback queue
+var ErrNotInQueue = errors.New("item is not in the playback queue")
+
+// ErrNotPlaylistOwner is returned when deleting a playlist the current user
+// does not own.
+// Use errors.Is(err, ErrNotPlaylistOwner) to check for it.
+var ErrNotPlaylistOwner = errors.New("playlist is not owned by the current user")
+
+// NotPlaylistOwnerError represents a refused DeleteOwnPlaylist call
+type NotPlaylistOwnerError struct {
+	PlaylistID string // Playlist that was not deleted
+	OwnerID    string // User who owns it
+	UserID     string // Current user
+}
+
+// Error implements the error interface
+func (e *NotPlaylistOwnerError) Error() string {
+	return fmt.Sprintf("playlist %s is owned by %s, not the current user %s", e.PlaylistID, e.OwnerID, e.UserID)
+}
+
+// Is reports whether target is ErrNotPlaylistOwner
+func (e *NotPlaylistOwnerError) Is(target error) bool {
+	return target == ErrNotPlaylistOwner
+}
+
+// isSpotifyError marks this as a Spotify error
+func (e *NotPlaylistOwnerError) isSpotifyError() {}
+
+// ErrSnapshotMismatch is returned when a playlist is not at the snapshot a
+// write expected, because it was changed since the caller last read it.
+// Use errors.Is(err, ErrSnapshotMismatch) to check for it.
+var ErrSnapshotMismatch = errors.New("playlist snapshot mismatch")
+
+// SnapshotMismatchError represents a playlist write rejected because the
+// playlist changed
+type SnapshotMismatchError struct {
+	PlaylistID string // Playlist that was written to
+	Expected   string // Snapshot ID the caller expected
+	Actual     string // Snapshot ID the playlist is at
+}
+
+// Error implements the error interface
+func (e *SnapshotMismatchError) Error() string {
+	return fmt.Sprintf("playlist %s is at snapshot %s, expected %s", e.PlaylistID, e.Actual, e.Expected)
+}
+
+// Is reports whether target is ErrSnapshotMismatch
+func (e *SnapshotMismatchError) Is(target error) bool {
+	return target == ErrSnapshotMismatch
+}
+
+// isSpotifyError marks this as a Spotify error
+func (e *SnapshotMismatchError) isSpotifyError() {}
+
+// ErrUnknownField is returned in strict decoding mode when a response has
+// fields the models do not have.
+// Use errors.Is(err, ErrUnknownField) to check for it.
+var ErrUnknownField = errors.New("unknown response field")
+
+// UnknownFieldError lists response fields missing from the models
+type UnknownFieldError struct {
+	Fields []string // Dotted JSON paths of the unknown fields
+}
+
+// Error implements the error interface
+func (e *UnknownFieldError) Error() string {
+	return fmt.Sprintf("response has fields not in the model: %s", strings.Join(e.Fields, ", "))
+}
+
+// Is reports whether target is ErrUnknownField
+func (e *UnknownFieldError) Is(target error) bool {
+	return target == ErrUnknownField
+}
+
+// isSpotifyError marks this as a Spotify error
+func (e *UnknownFieldError) isSpotifyError() {}
+
+// ErrEndpointDeprecated is returned when Spotify refuses a sunset endpoint,
+// or the endpoint is disabled with WithDisabledEndpoints.
+// Use errors.Is(err, ErrEndpointDeprecated) to check for it.
+var ErrEndpointDeprecated = errors.New("endpoint deprecated")
+
+// EndpointDeprecatedError reports a call to an endpoint Spotify has sunset
+type EndpointDeprecatedError struct {
+	Endpoint DeprecatedEndpoint
+	Guidance string // What to use instead
+	Err      error  // Spotify's error, or nil if the endpoint is disabled
+}
+
+// Error implements the error interface
+func (e *EndpointDeprecatedError) Error() string {
+	msg := fmt.Sprintf("endpoint %s is deprecated by Spotify", e.Endpoint)
+	if e.Guidance != "" {
+		msg += ": " + e.Guidance
+	}
+	if e.Err != nil {
+		msg += fmt.Sprintf(" (%v)", e.Err)
+	}
+	return msg
+}
+
+// Is reports whether target is ErrEndpointDeprecated
+func (e *EndpointDeprecatedError) Is(target error) bool {
+	return target == ErrEndpointDeprecated
+}
+
+// Unwrap returns Spotify's error
+func (e *EndpointDeprecatedError) Unwrap() error {
+	return e.Err
+}
+
+// isSpotifyError marks this as a Spotify error
+func (e *EndpointDeprecatedError) isSpotifyError() {}
@@ -0,0 +1,7 @@
+module github.com/sv4u/spotigo/examples/nowplaying
+
+go 1.23
+
+require github.com/sv4u/spotigo v0.0.0
+
+replace github.com/sv4u/spotigo => ../..
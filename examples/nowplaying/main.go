@@ -0,0 +1,194 @@
+// Command nowplaying shows the current user's playback in the terminal,
+// redrawing a small status panel until interrupted.
+//
+// Prerequisites:
+//   - Set SPOTIGO_CLIENT_ID environment variable
+//   - Set SPOTIGO_CLIENT_SECRET environment variable
+//   - Set SPOTIGO_REDIRECT_URI environment variable (e.g., http://localhost:8080/callback)
+//   - Add redirect URI to your Spotify app settings
+//
+// Usage:
+//
+//	cd examples/nowplaying && go run . [-interval 2s] [-once]
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"os/signal"
+	"strings"
+	"time"
+
+	"github.com/sv4u/spotigo"
+)
+
+// clearScreen moves the cursor home and clears the terminal
+const clearScreen = "\033[H\033[2J"
+
+// progressWidth is the number of cells in the progress bar
+const progressWidth = 30
+
+func main() {
+	interval := flag.Duration("interval", 2*time.Second, "refresh interval")
+	once := flag.Bool("once", false, "print the current playback once and exit")
+	flag.Parse()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	client, err := newClient(ctx)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := run(ctx, client, os.Stdout, *interval, *once); err != nil && ctx.Err() == nil {
+		log.Fatal(err)
+	}
+}
+
+// newClient authorizes with the Authorization Code flow, reusing a cached
+// token when one exists
+func newClient(ctx context.Context) (*spotigo.Client, error) {
+	auth, err := spotigo.NewSpotifyOAuth("", "", "", "user-read-playback-state user-read-currently-playing")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OAuth: %w", err)
+	}
+
+	cache, err := spotigo.NewFileCacheHandler("", os.Getenv("SPOTIGO_CLIENT_USERNAME"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create token cache: %w", err)
+	}
+	auth.CacheHandler = cache
+
+	token, err := auth.GetCachedToken(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cached token: %w", err)
+	}
+	if token == nil {
+		code, err := auth.GetAuthorizationCode(ctx, true)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get authorization code: %w", err)
+		}
+		if err := auth.ExchangeCode(ctx, code); err != nil {
+			return nil, fmt.Errorf("failed to exchange code: %w", err)
+		}
+	}
+
+	return spotigo.NewClient(auth)
+}
+
+// run renders the playback state to out every interval until ctx is done.
+// With once set, it renders a single frame without clearing the screen.
+func run(ctx context.Context, client *spotigo.Client, out io.Writer, interval time.Duration, once bool) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		state, err := client.CurrentUserPlaybackState(ctx, &spotigo.CurrentlyPlayingOptions{
+			AdditionalTypes: "track,episode",
+		})
+		if err != nil {
+			return fmt.Errorf("failed to get playback state: %w", err)
+		}
+
+		if once {
+			_, err := fmt.Fprint(out, render(state))
+			return err
+		}
+		if _, err := fmt.Fprint(out, clearScreen+render(state)); err != nil {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// nowPlayingItem holds the fields of a track or episode shown in the panel
+type nowPlayingItem struct {
+	Name       string           `json:"name"`
+	DurationMs int              `json:"duration_ms"`
+	Artists    []spotigo.Artist `json:"artists"`
+	Album      *struct {
+		Name string `json:"name"`
+	} `json:"album"`
+	Show *struct {
+		Name string `json:"name"`
+	} `json:"show"`
+}
+
+// render formats the playback state as a status panel
+func render(state *spotigo.PlaybackState) string {
+	if state == nil || state.Item == nil {
+		return "Nothing playing\n"
+	}
+
+	var item nowPlayingItem
+	if data, err := json.Marshal(state.Item); err == nil {
+		_ = json.Unmarshal(data, &item)
+	}
+
+	var b strings.Builder
+	status := "▶"
+	if !state.IsPlaying {
+		status = "⏸"
+	}
+	fmt.Fprintf(&b, "%s %s\n", status, item.Name)
+
+	switch {
+	case len(item.Artists) > 0:
+		names := make([]string, len(item.Artists))
+		for i, artist := range item.Artists {
+			names[i] = artist.Name
+		}
+		fmt.Fprintf(&b, "  %s", strings.Join(names, ", "))
+		if item.Album != nil && item.Album.Name != "" {
+			fmt.Fprintf(&b, " — %s", item.Album.Name)
+		}
+		b.WriteString("\n")
+	case item.Show != nil:
+		fmt.Fprintf(&b, "  %s\n", item.Show.Name)
+	}
+
+	fmt.Fprintf(&b, "  %s %s / %s\n",
+		progressBar(state.ProgressMs, item.DurationMs),
+		formatMs(state.ProgressMs),
+		formatMs(item.DurationMs),
+	)
+
+	if state.Device != nil {
+		volume := ""
+		if state.Device.VolumePercent != nil {
+			volume = fmt.Sprintf(" (volume %d%%)", *state.Device.VolumePercent)
+		}
+		fmt.Fprintf(&b, "  on %s%s\n", state.Device.Name, volume)
+	}
+
+	return b.String()
+}
+
+// progressBar draws a fixed-width progress bar
+func progressBar(progressMs, durationMs int) string {
+	filled := 0
+	if durationMs > 0 {
+		filled = progressMs * progressWidth / durationMs
+	}
+	if filled > progressWidth {
+		filled = progressWidth
+	}
+	return "[" + strings.Repeat("=", filled) + strings.Repeat(" ", progressWidth-filled) + "]"
+}
+
+// formatMs formats milliseconds as m:ss
+func formatMs(ms int) string {
+	seconds := ms / 1000
+	return fmt.Sprintf("%d:%02d", seconds/60, seconds%60)
+}
@@ -0,0 +1,103 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sv4u/spotigo"
+	"github.com/sv4u/spotigo/tests"
+)
+
+// newTestClient creates a client pointed at a mock server
+func newTestClient(t *testing.T, server *httptest.Server) *spotigo.Client {
+	t.Helper()
+	auth := &tests.MockAuthManager{
+		Token: &spotigo.TokenInfo{AccessToken: "test_token", TokenType: "Bearer"},
+	}
+	client, err := spotigo.NewClient(auth, spotigo.WithAPIPrefix(server.URL+"/"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return client
+}
+
+func TestRunOnce(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/me/player" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{
+			"is_playing": true,
+			"progress_ms": 61000,
+			"device": {"id": "d1", "name": "Kitchen", "volume_percent": 40},
+			"item": {
+				"name": "Paranoid Android",
+				"duration_ms": 383000,
+				"artists": [{"name": "Radiohead"}],
+				"album": {"name": "OK Computer"}
+			}
+		}`))
+	}))
+	defer server.Close()
+
+	var out bytes.Buffer
+	if err := run(context.Background(), newTestClient(t, server), &out, time.Second, true); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, want := range []string{"▶ Paranoid Android", "Radiohead — OK Computer", "1:01 / 6:23", "on Kitchen (volume 40%)"} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("expected output to contain %q, got:\n%s", want, out.String())
+		}
+	}
+}
+
+func TestRunNothingPlaying(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	var out bytes.Buffer
+	if err := run(context.Background(), newTestClient(t, server), &out, time.Second, true); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out.String() != "Nothing playing\n" {
+		t.Errorf("unexpected output: %q", out.String())
+	}
+}
+
+func TestRunRefreshesUntilCancelled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	var out bytes.Buffer
+	err := run(ctx, newTestClient(t, server), &out, 10*time.Millisecond, false)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected context.DeadlineExceeded, got %v", err)
+	}
+	if frames := strings.Count(out.String(), clearScreen); frames < 2 {
+		t.Errorf("expected several frames, got %d", frames)
+	}
+}
+
+func TestProgressBar(t *testing.T) {
+	if got := progressBar(0, 0); got != "["+strings.Repeat(" ", progressWidth)+"]" {
+		t.Errorf("unexpected empty bar: %q", got)
+	}
+	if got := progressBar(200, 100); got != "["+strings.Repeat("=", progressWidth)+"]" {
+		t.Errorf("expected full bar when progress exceeds duration, got %q", got)
+	}
+}
@@ -0,0 +1,243 @@
+// Command playlistbackup saves the current user's playlists as JSON files,
+// one file per playlist, so they can be restored or diffed later.
+//
+// Prerequisites:
+//   - Set SPOTIGO_CLIENT_ID environment variable
+//   - Set SPOTIGO_CLIENT_SECRET environment variable
+//   - Set SPOTIGO_REDIRECT_URI environment variable (e.g., http://localhost:8080/callback)
+//   - Add redirect URI to your Spotify app settings
+//
+// Usage:
+//
+//	cd examples/playlistbackup && go run . [-out backups] [-playlist <id or URI>]
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"path/filepath"
+
+	"github.com/sv4u/spotigo"
+)
+
+// Backup is the JSON document written for each playlist
+type Backup struct {
+	ID          string        `json:"id"`
+	Name        string        `json:"name"`
+	Description string        `json:"description,omitempty"`
+	Owner       string        `json:"owner,omitempty"`
+	SnapshotID  string        `json:"snapshot_id"`
+	Public      *bool         `json:"public,omitempty"`
+	Tracks      []BackupTrack `json:"tracks"`
+}
+
+// BackupTrack is one playlist item in a Backup
+type BackupTrack struct {
+	URI     string   `json:"uri"`
+	Name    string   `json:"name"`
+	Artists []string `json:"artists,omitempty"`
+	Album   string   `json:"album,omitempty"`
+	AddedAt string   `json:"added_at,omitempty"`
+	IsLocal bool     `json:"is_local,omitempty"`
+}
+
+func main() {
+	outDir := flag.String("out", "playlist-backups", "directory to write backups to")
+	playlistID := flag.String("playlist", "", "back up a single playlist (ID or URI) instead of all of them")
+	flag.Parse()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	client, err := newClient(ctx)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var ids []string
+	if *playlistID != "" {
+		ids = []string{*playlistID}
+	}
+
+	paths, err := run(ctx, client, *outDir, ids)
+	for _, path := range paths {
+		fmt.Println("wrote", path)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+// newClient authorizes with the Authorization Code flow, reusing a cached
+// token when one exists
+func newClient(ctx context.Context) (*spotigo.Client, error) {
+	auth, err := spotigo.NewSpotifyOAuth("", "", "", "playlist-read-private playlist-read-collaborative")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OAuth: %w", err)
+	}
+
+	cache, err := spotigo.NewFileCacheHandler("", os.Getenv("SPOTIGO_CLIENT_USERNAME"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create token cache: %w", err)
+	}
+	auth.CacheHandler = cache
+
+	token, err := auth.GetCachedToken(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cached token: %w", err)
+	}
+	if token == nil {
+		code, err := auth.GetAuthorizationCode(ctx, true)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get authorization code: %w", err)
+		}
+		if err := auth.ExchangeCode(ctx, code); err != nil {
+			return nil, fmt.Errorf("failed to exchange code: %w", err)
+		}
+	}
+
+	return spotigo.NewClient(auth)
+}
+
+// run backs up the given playlists (or all of the current user's playlists
+// when ids is empty) into outDir and returns the paths written
+func run(ctx context.Context, client *spotigo.Client, outDir string, ids []string) ([]string, error) {
+	if len(ids) == 0 {
+		var err error
+		ids, err = userPlaylistIDs(ctx, client)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := os.MkdirAll(outDir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create output directory: %w", err)
+	}
+
+	var paths []string
+	for _, id := range ids {
+		backup, err := backupPlaylist(ctx, client, id)
+		if err != nil {
+			return paths, fmt.Errorf("failed to back up playlist %s: %w", id, err)
+		}
+
+		data, err := json.MarshalIndent(backup, "", "  ")
+		if err != nil {
+			return paths, fmt.Errorf("failed to encode playlist %s: %w", id, err)
+		}
+		path := filepath.Join(outDir, backup.ID+".json")
+		if err := os.WriteFile(path, data, 0o644); err != nil {
+			return paths, fmt.Errorf("failed to write %s: %w", path, err)
+		}
+		paths = append(paths, path)
+	}
+	return paths, nil
+}
+
+// userPlaylistIDs lists the IDs of every playlist in the current user's library
+func userPlaylistIDs(ctx context.Context, client *spotigo.Client) ([]string, error) {
+	page, err := client.CurrentUserPlaylists(ctx, &spotigo.CurrentUserPlaylistsOptions{Limit: 50})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list playlists: %w", err)
+	}
+
+	var ids []string
+	for page != nil {
+		for _, playlist := range page.Items {
+			ids = append(ids, playlist.ID)
+		}
+		page, err = spotigo.NextGeneric[spotigo.SimplifiedPlaylist](client, ctx, page)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list playlists: %w", err)
+		}
+	}
+	return ids, nil
+}
+
+// backupPlaylist fetches a playlist and all of its items
+func backupPlaylist(ctx context.Context, client *spotigo.Client, id string) (*Backup, error) {
+	playlist, err := client.Playlist(ctx, id, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	backup := &Backup{
+		ID:         playlist.ID,
+		Name:       playlist.Name,
+		SnapshotID: playlist.SnapshotID,
+		Public:     playlist.Public,
+		Tracks:     []BackupTrack{},
+	}
+	if playlist.Description != nil {
+		backup.Description = spotigo.UnescapePlaylistDescription(*playlist.Description)
+	}
+	if playlist.Owner != nil {
+		backup.Owner = playlist.Owner.ID
+	}
+
+	page, err := client.PlaylistTracks(ctx, playlist.ID, &spotigo.PlaylistTracksOptions{
+		Limit:           100,
+		AdditionalTypes: "track,episode",
+	})
+	for err == nil && page != nil {
+		for _, item := range page.Items {
+			if track, ok := toBackupTrack(item); ok {
+				backup.Tracks = append(backup.Tracks, track)
+			}
+		}
+		page, err = spotigo.NextGeneric[spotigo.PlaylistTrack](client, ctx, page)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return backup, nil
+}
+
+// toBackupTrack converts a playlist item; items removed from Spotify have a
+// null track and are skipped
+func toBackupTrack(item spotigo.PlaylistTrack) (BackupTrack, bool) {
+	if item.Track == nil {
+		return BackupTrack{}, false
+	}
+
+	var fields struct {
+		URI     string `json:"uri"`
+		Name    string `json:"name"`
+		Artists []struct {
+			Name string `json:"name"`
+		} `json:"artists"`
+		Album *struct {
+			Name string `json:"name"`
+		} `json:"album"`
+		Show *struct {
+			Name string `json:"name"`
+		} `json:"show"`
+	}
+	data, err := json.Marshal(item.Track)
+	if err != nil || json.Unmarshal(data, &fields) != nil {
+		return BackupTrack{}, false
+	}
+
+	track := BackupTrack{
+		URI:     fields.URI,
+		Name:    fields.Name,
+		AddedAt: item.AddedAt,
+		IsLocal: item.IsLocal,
+	}
+	for _, artist := range fields.Artists {
+		track.Artists = append(track.Artists, artist.Name)
+	}
+	switch {
+	case fields.Album != nil:
+		track.Album = fields.Album.Name
+	case fields.Show != nil:
+		track.Album = fields.Show.Name
+	}
+	return track, true
+}
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sv4u/spotigo"
+	"github.com/sv4u/spotigo/tests"
+)
+
+// newMockServer serves one playlist with two pages of items
+func newMockServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/me/playlists":
+			fmt.Fprint(w, `{"items": [{"id": "37i9dQZF1DXcBWIGoYBM5M", "name": "Mix"}], "total": 1, "next": null}`)
+		case r.URL.Path == "/playlists/37i9dQZF1DXcBWIGoYBM5M":
+			fmt.Fprint(w, `{
+				"id": "37i9dQZF1DXcBWIGoYBM5M",
+				"name": "Mix",
+				"description": "Rock &amp; roll",
+				"snapshot_id": "snap1",
+				"owner": {"id": "owner1"}
+			}`)
+		case r.URL.Path == "/playlists/37i9dQZF1DXcBWIGoYBM5M/tracks" && r.URL.Query().Get("offset") == "":
+			fmt.Fprintf(w, `{
+				"items": [
+					{"added_at": "2024-01-01T00:00:00Z", "track": {"uri": "spotify:track:1", "name": "One", "artists": [{"name": "A"}], "album": {"name": "First"}}},
+					{"added_at": "2024-01-02T00:00:00Z", "track": null}
+				],
+				"next": "%s/playlists/37i9dQZF1DXcBWIGoYBM5M/tracks?offset=2&limit=2",
+				"total": 3
+			}`, server.URL)
+		case r.URL.Path == "/playlists/37i9dQZF1DXcBWIGoYBM5M/tracks":
+			fmt.Fprint(w, `{
+				"items": [
+					{"added_at": "2024-01-03T00:00:00Z", "track": {"uri": "spotify:episode:2", "name": "Two", "show": {"name": "Podcast"}}}
+				],
+				"next": null,
+				"total": 3
+			}`)
+		default:
+			t.Errorf("unexpected request: %s", r.URL)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	return server
+}
+
+func TestRunBacksUpAllPlaylists(t *testing.T) {
+	server := newMockServer(t)
+	defer server.Close()
+
+	auth := &tests.MockAuthManager{
+		Token: &spotigo.TokenInfo{AccessToken: "test_token", TokenType: "Bearer"},
+	}
+	client, err := spotigo.NewClient(auth, spotigo.WithAPIPrefix(server.URL+"/"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	outDir := t.TempDir()
+	paths, err := run(context.Background(), client, outDir, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(paths) != 1 || paths[0] != filepath.Join(outDir, "37i9dQZF1DXcBWIGoYBM5M.json") {
+		t.Fatalf("unexpected paths: %v", paths)
+	}
+
+	data, err := os.ReadFile(paths[0])
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var backup Backup
+	if err := json.Unmarshal(data, &backup); err != nil {
+		t.Fatalf("invalid backup JSON: %v", err)
+	}
+
+	if backup.Name != "Mix" || backup.Owner != "owner1" || backup.SnapshotID != "snap1" {
+		t.Errorf("unexpected playlist metadata: %+v", backup)
+	}
+	if backup.Description != "Rock & roll" {
+		t.Errorf("expected unescaped description, got %q", backup.Description)
+	}
+	if len(backup.Tracks) != 2 {
+		t.Fatalf("expected 2 tracks (null track skipped), got %d", len(backup.Tracks))
+	}
+	if backup.Tracks[0].URI != "spotify:track:1" || backup.Tracks[0].Album != "First" || backup.Tracks[0].Artists[0] != "A" {
+		t.Errorf("unexpected first track: %+v", backup.Tracks[0])
+	}
+	if backup.Tracks[1].URI != "spotify:episode:2" || backup.Tracks[1].Album != "Podcast" {
+		t.Errorf("unexpected episode: %+v", backup.Tracks[1])
+	}
+}
@@ -0,0 +1,121 @@
+package spotigo
+
+import (
+	"context"
+)
+
+// ============================================================================
+// Chunked Follows
+// ============================================================================
+
+// followBatchSize is the maximum number of IDs per follow request
+const followBatchSize = 50
+
+// ChunkResult is the outcome of one request of a chunked operation
+type ChunkResult struct {
+	Start int      // Index of the chunk's first item in the caller's input
+	IDs   []string // IDs sent in the request
+	Err   error    // nil if the request succeeded
+}
+
+// BatchReport describes a chunked operation request by request
+type BatchReport struct {
+	Chunks    []ChunkResult // One per request, in order
+	Succeeded int           // Number of IDs in chunks that succeeded
+}
+
+// Failed returns the chunks whose request failed
+func (r *BatchReport) Failed() []ChunkResult {
+	var failed []ChunkResult
+	for _, chunk := range r.Chunks {
+		if chunk.Err != nil {
+			failed = append(failed, chunk)
+		}
+	}
+	return failed
+}
+
+// FollowArtistsAll follows any number of artists (IDs, URIs, or URLs) in
+// chunks of 50, continuing past failed chunks so one bad request does not
+// abort a migration. Duplicates are followed once.
+//
+// The report lists every request. Invalid IDs and the items of failed
+// chunks are also returned in a *MultiError, indexed by their position in
+// artistIDs, so they can be retried.
+//
+// Example:
+//
+//	report, err := client.FollowArtistsAll(ctx, exportedArtistIDs)
+//	for _, chunk := range report.Failed() {
+//		log.Printf("artists %d-%d failed: %v", chunk.Start, chunk.Start+len(chunk.IDs)-1, chunk.Err)
+//	}
+func (c *Client) FollowArtistsAll(ctx context.Context, artistIDs []string) (*BatchReport, error) {
+	return c.followAll(ctx, artistIDs, "artist", c.UserFollowArtists)
+}
+
+// UnfollowArtistsAll unfollows any number of artists in chunks of 50
+// (see FollowArtistsAll)
+func (c *Client) UnfollowArtistsAll(ctx context.Context, artistIDs []string) (*BatchReport, error) {
+	return c.followAll(ctx, artistIDs, "artist", c.UserUnfollowArtists)
+}
+
+// FollowUsersAll follows any number of users in chunks of 50
+// (see FollowArtistsAll). User IDs are sent as given.
+func (c *Client) FollowUsersAll(ctx context.Context, userIDs []string) (*BatchReport, error) {
+	return c.followAll(ctx, userIDs, "user", c.UserFollowUsers)
+}
+
+// UnfollowUsersAll unfollows any number of users in chunks of 50
+// (see FollowArtistsAll). User IDs are sent as given.
+func (c *Client) UnfollowUsersAll(ctx context.Context, userIDs []string) (*BatchReport, error) {
+	return c.followAll(ctx, userIDs, "user", c.UserUnfollowUsers)
+}
+
+// followAll normalizes and de-duplicates items, then sends them to call in
+// chunks of followBatchSize
+func (c *Client) followAll(ctx context.Context, items []string, entityType string, call func(context.Context, []string) error) (*BatchReport, error) {
+	failed := &MultiError{}
+
+	// Keep the first input index of each ID for reporting
+	var ids []string
+	indexes := make(map[string]int)
+	for i, item := range items {
+		id := item
+		if entityType == "user" {
+			if id == "" {
+				failed.Add(i, item, &MissingOptionError{Field: "user ID"})
+				continue
+			}
+		} else {
+			var err error
+			if id, err = GetID(item, entityType); err != nil {
+				failed.Add(i, item, err)
+				continue
+			}
+		}
+		if _, seen := indexes[id]; !seen {
+			indexes[id] = i
+			ids = append(ids, id)
+		}
+	}
+
+	report := &BatchReport{}
+	for start := 0; start < len(ids); start += followBatchSize {
+		chunk := ids[start:min(start+followBatchSize, len(ids))]
+		err := ctx.Err()
+		if err == nil {
+			err = call(ctx, chunk)
+		}
+
+		report.Chunks = append(report.Chunks, ChunkResult{Start: indexes[chunk[0]], IDs: chunk, Err: err})
+		if err != nil {
+			for _, id := range chunk {
+				failed.Add(indexes[id], items[indexes[id]], err)
+			}
+			continue
+		}
+		report.Succeeded += len(chunk)
+	}
+
+	return report, failed.ErrorOrNil()
+}
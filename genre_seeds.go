@@ -0,0 +1,123 @@
+package spotigo
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ============================================================================
+// Recommendation Genre Seeds
+// ============================================================================
+
+// DefaultGenreSeedsCacheTTL is how long GenreSeeds results are cached by
+// default
+const DefaultGenreSeedsCacheTTL = 24 * time.Hour
+
+// fallbackGenreSeeds are the genre seeds Spotify offered when the endpoint
+// was sunset, sorted
+var fallbackGenreSeeds = []string{
+	"acoustic", "afrobeat", "alt-rock", "alternative", "ambient", "anime",
+	"black-metal", "bluegrass", "blues", "bossanova", "brazil", "breakbeat",
+	"british", "cantopop", "chicago-house", "children", "chill", "classical",
+	"club", "comedy", "country", "dance", "dancehall", "death-metal",
+	"deep-house", "detroit-techno", "disco", "disney", "drum-and-bass", "dub",
+	"dubstep", "edm", "electro", "electronic", "emo", "folk", "forro",
+	"french", "funk", "garage", "german", "gospel", "goth", "grindcore",
+	"groove", "grunge", "guitar", "happy", "hard-rock", "hardcore",
+	"hardstyle", "heavy-metal", "hip-hop", "holidays", "honky-tonk", "house",
+	"idm", "indian", "indie", "indie-pop", "industrial", "iranian", "j-dance",
+	"j-idol", "j-pop", "j-rock", "jazz", "k-pop", "kids", "latin", "latino",
+	"malay", "mandopop", "metal", "metal-misc", "metalcore", "minimal-techno",
+	"movies", "mpb", "new-age", "new-release", "opera", "pagode", "party",
+	"philippines-opm", "piano", "pop", "pop-film", "post-dubstep",
+	"power-pop", "progressive-house", "psych-rock", "punk", "punk-rock",
+	"r-n-b", "rainy-day", "reggae", "reggaeton", "road-trip", "rock",
+	"rock-n-roll", "rockabilly", "romance", "sad", "salsa", "samba",
+	"sertanejo", "show-tunes", "singer-songwriter", "ska", "sleep",
+	"songwriter", "soul", "soundtracks", "spanish", "study", "summer",
+	"swedish", "synth-pop", "tango", "techno", "trance", "trip-hop",
+	"turkish", "work-out", "world-music",
+}
+
+// normalizeGenreSeed lowercases a genre and joins its words with hyphens,
+// so that "Hip Hop" becomes "hip-hop"
+func normalizeGenreSeed(genre string) string {
+	return strings.Join(strings.Fields(strings.ToLower(genre)), "-")
+}
+
+// IsValidGenreSeed reports whether genre, once normalized ("Hip Hop" to
+// "hip-hop"), is one of the built-in genre seeds. It needs no client or
+// request; use Client.GenreSeeds for the live list.
+func IsValidGenreSeed(genre string) bool {
+	_, found := slices.BinarySearch(fallbackGenreSeeds, normalizeGenreSeed(genre))
+	return found
+}
+
+// genreSeedsCache holds a copy of the genre seeds
+type genreSeedsCache struct {
+	mu        sync.Mutex
+	genres    []string
+	fetchedAt time.Time
+}
+
+// WithGenreSeedsCacheTTL sets how long GenreSeeds results are cached.
+// 0 uses DefaultGenreSeedsCacheTTL; a negative TTL disables caching.
+func WithGenreSeedsCacheTTL(ttl time.Duration) ClientOption {
+	return func(c *Client) {
+		c.GenreSeedsCacheTTL = ttl
+	}
+}
+
+// GenreSeeds returns the genres recommendations can be seeded with, sorted.
+//
+// Results of RecommendationGenreSeeds are cached for GenreSeedsCacheTTL
+// (default: 24 hours). When the endpoint fails, e.g. offline or because
+// Spotify has sunset it for the app, the built-in list is returned instead;
+// a sunset endpoint's fallback is cached too, so it is not retried on every
+// call. Only context errors are returned.
+//
+// Example:
+//
+//	genres, err := client.GenreSeeds(ctx)
+//	if err != nil {
+//		return err
+//	}
+//	for _, genre := range genres {
+//		fmt.Printf("<option>%s</option>\n", genre)
+//	}
+func (c *Client) GenreSeeds(ctx context.Context) ([]string, error) {
+	ttl := c.GenreSeedsCacheTTL
+	if ttl == 0 {
+		ttl = DefaultGenreSeedsCacheTTL
+	}
+
+	c.genreSeeds.mu.Lock()
+	defer c.genreSeeds.mu.Unlock()
+
+	if ttl > 0 && c.genreSeeds.genres != nil && time.Since(c.genreSeeds.fetchedAt) < ttl {
+		return slices.Clone(c.genreSeeds.genres), nil
+	}
+
+	genres, err := c.RecommendationGenreSeeds(ctx)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, err
+		}
+		if c.Logger != nil {
+			c.Logger.Warn("Using built-in genre seeds: %v", err)
+		}
+		if !errors.Is(err, ErrEndpointDeprecated) {
+			return slices.Clone(fallbackGenreSeeds), nil
+		}
+		genres = slices.Clone(fallbackGenreSeeds)
+	}
+	slices.Sort(genres)
+
+	c.genreSeeds.genres = genres
+	c.genreSeeds.fetchedAt = time.Now()
+	return slices.Clone(genres), nil
+}
@@ -0,0 +1,273 @@
+package spotigo
+
+import (
+	"bufio"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ============================================================================
+// History Stores
+// ============================================================================
+
+// JSONLHistoryStore implements HistoryStore as an append-only JSON Lines
+// file, one PlayHistoryItem per line
+type JSONLHistoryStore struct {
+	Path string
+
+	mu sync.Mutex
+}
+
+// NewJSONLHistoryStore creates a new JSON Lines history store
+func NewJSONLHistoryStore(path string) *JSONLHistoryStore {
+	return &JSONLHistoryStore{Path: path}
+}
+
+// LastPlayedAt returns the newest played_at time in the file
+// Returns the zero time if the file doesn't exist
+func (s *JSONLHistoryStore) LastPlayedAt(ctx context.Context) (time.Time, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var last time.Time
+	for item, err := range s.items(ctx) {
+		if err != nil {
+			return time.Time{}, err
+		}
+		playedAt, err := time.Parse(time.RFC3339, item.PlayedAt)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid played_at %q in %s: %w", item.PlayedAt, s.Path, err)
+		}
+		if playedAt.After(last) {
+			last = playedAt
+		}
+	}
+	return last, nil
+}
+
+// Items reads every item in the file, oldest first
+func (s *JSONLHistoryStore) Items(ctx context.Context) ([]PlayHistoryItem, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var items []PlayHistoryItem
+	for item, err := range s.items(ctx) {
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+// items iterates over the lines of the file
+func (s *JSONLHistoryStore) items(ctx context.Context) iter.Seq2[PlayHistoryItem, error] {
+	return func(yield func(PlayHistoryItem, error) bool) {
+		file, err := os.Open(s.Path)
+		if err != nil {
+			if os.IsNotExist(err) {
+				return
+			}
+			yield(PlayHistoryItem{}, fmt.Errorf("failed to open history file: %w", err))
+			return
+		}
+		defer file.Close()
+
+		scanner := bufio.NewScanner(file)
+		scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+		for lineNum := 1; scanner.Scan(); lineNum++ {
+			if err := ctx.Err(); err != nil {
+				yield(PlayHistoryItem{}, err)
+				return
+			}
+			line := strings.TrimSpace(scanner.Text())
+			if line == "" {
+				continue
+			}
+			var item PlayHistoryItem
+			if err := json.Unmarshal([]byte(line), &item); err != nil {
+				yield(PlayHistoryItem{}, fmt.Errorf("failed to decode %s line %d: %w", s.Path, lineNum, err))
+				return
+			}
+			if !yield(item, nil) {
+				return
+			}
+		}
+		if err := scanner.Err(); err != nil {
+			yield(PlayHistoryItem{}, fmt.Errorf("failed to read history file: %w", err))
+		}
+	}
+}
+
+// Append writes items to the end of the file, creating it if needed
+func (s *JSONLHistoryStore) Append(ctx context.Context, items []PlayHistoryItem) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	dir := filepath.Dir(s.Path)
+	if dir != "." && dir != "" {
+		if err := os.MkdirAll(dir, 0700); err != nil {
+			return fmt.Errorf("failed to create history directory: %w", err)
+		}
+	}
+
+	file, err := os.OpenFile(s.Path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to open history file: %w", err)
+	}
+
+	writer := bufio.NewWriter(file)
+	encoder := json.NewEncoder(writer)
+	for _, item := range items {
+		if err := encoder.Encode(item); err != nil {
+			file.Close()
+			return fmt.Errorf("failed to encode play history item: %w", err)
+		}
+	}
+	if err := writer.Flush(); err != nil {
+		file.Close()
+		return fmt.Errorf("failed to write history file: %w", err)
+	}
+	return file.Close()
+}
+
+// SQLiteHistoryStore implements HistoryStore on a SQLite database.
+//
+// The store uses database/sql and does not import a driver; open db with the
+// SQLite driver of your choice (e.g. modernc.org/sqlite or
+// github.com/mattn/go-sqlite3). Plays are keyed by played_at, so appending an
+// item twice stores it once.
+type SQLiteHistoryStore struct {
+	DB    *sql.DB
+	Table string
+}
+
+// DefaultHistoryTable is the default table name of SQLiteHistoryStore
+const DefaultHistoryTable = "play_history"
+
+// NewSQLiteHistoryStore creates a SQLite history store, creating the table if
+// it doesn't exist. table defaults to DefaultHistoryTable.
+//
+// Example:
+//
+//	db, err := sql.Open("sqlite", "history.db")
+//	if err != nil {
+//		return err
+//	}
+//	store, err := spotigo.NewSQLiteHistoryStore(ctx, db, "")
+func NewSQLiteHistoryStore(ctx context.Context, db *sql.DB, table string) (*SQLiteHistoryStore, error) {
+	if db == nil {
+		return nil, fmt.Errorf("db is nil")
+	}
+	if table == "" {
+		table = DefaultHistoryTable
+	}
+	if !isSQLIdentifier(table) {
+		return nil, fmt.Errorf("invalid table name: %q", table)
+	}
+
+	store := &SQLiteHistoryStore{DB: db, Table: table}
+	query := fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s (
+	played_at TEXT PRIMARY KEY,
+	played_at_ms INTEGER NOT NULL,
+	track_id TEXT NOT NULL,
+	track_name TEXT NOT NULL,
+	context_uri TEXT,
+	item TEXT NOT NULL
+)`, table)
+	if _, err := db.ExecContext(ctx, query); err != nil {
+		return nil, fmt.Errorf("failed to create history table: %w", err)
+	}
+
+	return store, nil
+}
+
+// LastPlayedAt returns the newest played_at time in the table
+// Returns the zero time if the table is empty
+func (s *SQLiteHistoryStore) LastPlayedAt(ctx context.Context) (time.Time, error) {
+	var lastMs sql.NullInt64
+	query := fmt.Sprintf("SELECT MAX(played_at_ms) FROM %s", s.Table)
+	if err := s.DB.QueryRowContext(ctx, query).Scan(&lastMs); err != nil {
+		return time.Time{}, fmt.Errorf("failed to query last played time: %w", err)
+	}
+	if !lastMs.Valid {
+		return time.Time{}, nil
+	}
+	return time.UnixMilli(lastMs.Int64).UTC(), nil
+}
+
+// Append inserts items in a single transaction, ignoring plays already stored
+func (s *SQLiteHistoryStore) Append(ctx context.Context, items []PlayHistoryItem) error {
+	tx, err := s.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := fmt.Sprintf(
+		"INSERT OR IGNORE INTO %s (played_at, played_at_ms, track_id, track_name, context_uri, item) VALUES (?, ?, ?, ?, ?, ?)",
+		s.Table,
+	)
+	stmt, err := tx.PrepareContext(ctx, query)
+	if err != nil {
+		return fmt.Errorf("failed to prepare insert: %w", err)
+	}
+	defer stmt.Close()
+
+	for _, item := range items {
+		playedAt, err := time.Parse(time.RFC3339, item.PlayedAt)
+		if err != nil {
+			return fmt.Errorf("invalid played_at %q: %w", item.PlayedAt, err)
+		}
+		data, err := json.Marshal(item)
+		if err != nil {
+			return fmt.Errorf("failed to encode play history item: %w", err)
+		}
+		var contextURI sql.NullString
+		if item.Context != nil && item.Context.URI != "" {
+			contextURI = sql.NullString{String: item.Context.URI, Valid: true}
+		}
+		if _, err := stmt.ExecContext(ctx,
+			playedAt.UTC().Format(time.RFC3339Nano),
+			playedAt.UnixMilli(),
+			item.Track.ID,
+			item.Track.Name,
+			contextURI,
+			string(data),
+		); err != nil {
+			return fmt.Errorf("failed to insert play history item: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit play history: %w", err)
+	}
+	return nil
+}
+
+// isSQLIdentifier reports whether name is a plain SQL identifier
+func isSQLIdentifier(name string) bool {
+	for i, r := range name {
+		switch {
+		case r == '_', r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z':
+		case r >= '0' && r <= '9' && i > 0:
+		default:
+			return false
+		}
+	}
+	return name != ""
+}
@@ -0,0 +1,201 @@
+package spotigo
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// ============================================================================
+// External Identifier Lookups (ISRC, UPC)
+// ============================================================================
+
+// isrcPattern matches a normalized ISRC: country code, registrant code,
+// year, and designation code
+var isrcPattern = regexp.MustCompile(`^[A-Z]{2}[A-Z0-9]{3}[0-9]{7}$`)
+
+// upcPattern matches a normalized UPC-A (12 digits) or EAN-13 (13 digits)
+var upcPattern = regexp.MustCompile(`^[0-9]{12,13}$`)
+
+// NormalizeISRC uppercases an ISRC and removes hyphens and spaces, e.g.
+// "us-um7-19-00001" becomes "USUM71900001". Returns an error if the result
+// is not a valid ISRC.
+func NormalizeISRC(isrc string) (string, error) {
+	normalized := strings.ToUpper(stripIdentifierSeparators(isrc))
+	if !isrcPattern.MatchString(normalized) {
+		return "", &InvalidOptionError{Field: "ISRC", Reason: fmt.Sprintf("must be 2 letters, 3 alphanumerics, and 7 digits, got %q", isrc)}
+	}
+	return normalized, nil
+}
+
+// NormalizeUPC removes hyphens and spaces from a UPC or EAN-13 barcode.
+// Returns an error if the result is not 12 or 13 digits.
+func NormalizeUPC(upc string) (string, error) {
+	normalized := stripIdentifierSeparators(upc)
+	if !upcPattern.MatchString(normalized) {
+		return "", &InvalidOptionError{Field: "UPC", Reason: fmt.Sprintf("must be 12 or 13 digits, got %q", upc)}
+	}
+	return normalized, nil
+}
+
+// stripIdentifierSeparators removes characters commonly used to group
+// identifier digits
+func stripIdentifierSeparators(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '-' || r == ' ' || r == '.' {
+			return -1
+		}
+		return r
+	}, strings.TrimSpace(s))
+}
+
+// sameUPC reports whether two barcodes are equal, treating a UPC-A and the
+// EAN-13 formed by prefixing it with 0 as the same code
+func sameUPC(a, b string) bool {
+	return strings.TrimLeft(a, "0") == strings.TrimLeft(b, "0")
+}
+
+// TracksByISRC returns all tracks with the given ISRC, most popular first.
+// The same recording is often released several times (single, album,
+// compilation), each as a separate track. Returns an empty slice if there
+// are no matches.
+//
+// The optional market parameter restricts results to a specific country.
+func (c *Client) TracksByISRC(ctx context.Context, isrc string, market ...string) ([]Track, error) {
+	normalized, err := NormalizeISRC(isrc)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := &SearchOptions{Limit: 50}
+	if len(market) > 0 {
+		opts.Market = market[0]
+	}
+	result, err := c.Search(ctx, "isrc:"+normalized, "track", opts)
+	if err != nil {
+		return nil, err
+	}
+
+	tracks := make([]Track, 0)
+	if result.Tracks != nil {
+		for _, track := range result.Tracks.Items {
+			// Search matching is fuzzy; drop tracks that report another ISRC
+			if track.ExternalIDs != nil && track.ExternalIDs.ISRC != nil &&
+				!strings.EqualFold(*track.ExternalIDs.ISRC, normalized) {
+				continue
+			}
+			tracks = append(tracks, track)
+		}
+	}
+
+	sortByPopularity(tracks, func(t Track) int { return t.Popularity })
+	return tracks, nil
+}
+
+// TrackByISRC returns the best match for an ISRC: the most popular track
+// with that ISRC. Returns an *IdentifierNotFoundError (matching
+// ErrIdentifierNotFound) if there is none.
+//
+// Example:
+//
+//	track, err := client.TrackByISRC(ctx, "USUM71900001", "US")
+//	if errors.Is(err, spotigo.ErrIdentifierNotFound) {
+//		// Not in the catalog
+//	}
+func (c *Client) TrackByISRC(ctx context.Context, isrc string, market ...string) (*Track, error) {
+	tracks, err := c.TracksByISRC(ctx, isrc, market...)
+	if err != nil {
+		return nil, err
+	}
+	if len(tracks) == 0 {
+		normalized, _ := NormalizeISRC(isrc)
+		return nil, &IdentifierNotFoundError{Kind: "isrc", Value: normalized}
+	}
+	return &tracks[0], nil
+}
+
+// AlbumsByUPC returns all albums with the given UPC or EAN-13, most popular
+// first. Search results are simplified albums, so matches are fetched as
+// full albums (one extra request per 20 matches). Returns an empty slice if
+// there are no matches.
+//
+// The optional market parameter restricts results to a specific country.
+func (c *Client) AlbumsByUPC(ctx context.Context, upc string, market ...string) ([]Album, error) {
+	normalized, err := NormalizeUPC(upc)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := &SearchOptions{Limit: 50}
+	if len(market) > 0 {
+		opts.Market = market[0]
+	}
+	result, err := c.Search(ctx, "upc:"+normalized, "album", opts)
+	if err != nil {
+		return nil, err
+	}
+
+	albums := make([]Album, 0)
+	if result.Albums == nil || len(result.Albums.Items) == 0 {
+		return albums, nil
+	}
+
+	ids := make([]string, 0, len(result.Albums.Items))
+	for _, album := range result.Albums.Items {
+		ids = append(ids, album.ID)
+	}
+
+	for start := 0; start < len(ids); start += 20 {
+		end := min(start+20, len(ids))
+		resp, err := c.Albums(ctx, ids[start:end], market...)
+		if err != nil {
+			return nil, err
+		}
+		for _, album := range resp.Albums {
+			if album.ID == "" {
+				continue
+			}
+			// Search matching is fuzzy; drop albums that report another barcode
+			if ext := album.ExternalIDs; ext != nil && (ext.UPC != nil || ext.EAN != nil) {
+				matches := (ext.UPC != nil && sameUPC(*ext.UPC, normalized)) ||
+					(ext.EAN != nil && sameUPC(*ext.EAN, normalized))
+				if !matches {
+					continue
+				}
+			}
+			albums = append(albums, album)
+		}
+	}
+
+	sortByPopularity(albums, func(a Album) int { return a.Popularity })
+	return albums, nil
+}
+
+// AlbumByUPC returns the best match for a UPC or EAN-13: the most popular
+// album with that barcode. Returns an *IdentifierNotFoundError (matching
+// ErrIdentifierNotFound) if there is none.
+//
+// Example:
+//
+//	album, err := client.AlbumByUPC(ctx, "0602577435235")
+func (c *Client) AlbumByUPC(ctx context.Context, upc string, market ...string) (*Album, error) {
+	albums, err := c.AlbumsByUPC(ctx, upc, market...)
+	if err != nil {
+		return nil, err
+	}
+	if len(albums) == 0 {
+		normalized, _ := NormalizeUPC(upc)
+		return nil, &IdentifierNotFoundError{Kind: "upc", Value: normalized}
+	}
+	return &albums[0], nil
+}
+
+// sortByPopularity stably sorts items by descending popularity, keeping
+// search relevance order for ties
+func sortByPopularity[T any](items []T, popularity func(T) int) {
+	slices.SortStableFunc(items, func(a, b T) int {
+		return popularity(b) - popularity(a)
+	})
+}
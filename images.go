@@ -0,0 +1,256 @@
+package spotigo
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"image"
+	"image/color"
+	"image/jpeg"
+	_ "image/png" // Cover images may be prepared from PNGs
+	"io"
+	"net/http"
+)
+
+// ============================================================================
+// Images and Cover Art
+// ============================================================================
+
+// maxImageDownload caps the size of a downloaded image
+const maxImageDownload = 32 << 20
+
+// maxCoverImagePayload is Spotify's limit on the base64-encoded cover image
+const maxCoverImagePayload = 256 * 1024
+
+// maxCoverImageDimension is the largest width or height PrepareCoverImage
+// produces; Spotify shows covers at up to 640 pixels
+const maxCoverImageDimension = 1024
+
+// coverImageQualities are the JPEG qualities PrepareCoverImage tries
+var coverImageQualities = []int{90, 80, 70, 60, 50}
+
+// Download fetches the image's bytes. A nil httpClient uses
+// http.DefaultClient; image URLs are public, so no token is needed.
+//
+// Example:
+//
+//	data, err := album.Images[0].Download(ctx, nil)
+func (img Image) Download(ctx context.Context, httpClient *http.Client) ([]byte, error) {
+	if img.URL == "" {
+		return nil, &MissingOptionError{Field: "image URL"}
+	}
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, img.URL, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxImageDownload+1))
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, WrapHTTPError(nil, resp.StatusCode, http.MethodGet, img.URL, data, resp.Header)
+	}
+	if len(data) > maxImageDownload {
+		return nil, fmt.Errorf("image at %s exceeds %d bytes", img.URL, maxImageDownload)
+	}
+
+	return data, nil
+}
+
+// DownloadDecoded fetches and decodes the image (see Download)
+func (img Image) DownloadDecoded(ctx context.Context, httpClient *http.Client) (image.Image, error) {
+	data, err := img.Download(ctx, httpClient)
+	if err != nil {
+		return nil, err
+	}
+	decoded, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("decoding image at %s: %w", img.URL, err)
+	}
+	return decoded, nil
+}
+
+// BestImageFor picks the image to display at width pixels: the smallest one
+// at least that wide, or the widest if none is. Images without a size (as in
+// some playlist covers) are only picked if no image has one. The second
+// result is false if images is empty.
+//
+// Example:
+//
+//	if thumb, ok := spotigo.BestImageFor(album.Images, 64); ok {
+//		fmt.Println(thumb.URL)
+//	}
+func BestImageFor(images []Image, width int) (Image, bool) {
+	if len(images) == 0 {
+		return Image{}, false
+	}
+
+	best := -1
+	for i, img := range images {
+		if img.Width == nil {
+			continue
+		}
+		if best < 0 {
+			best = i
+			continue
+		}
+		current, candidate := *images[best].Width, *img.Width
+		switch {
+		case current < width && candidate > current:
+			best = i // Closer to large enough
+		case candidate >= width && candidate < current:
+			best = i // Large enough and smaller
+		}
+	}
+	if best < 0 {
+		return images[0], true
+	}
+	return images[best], true
+}
+
+// PrepareCoverImage converts a JPEG or PNG image into JPEG data
+// PlaylistUploadCoverImage accepts. JPEGs that already fit are returned
+// unchanged; other images are scaled down to at most 1024 pixels on a side
+// and re-encoded at decreasing quality, then smaller sizes, until the
+// base64-encoded data fits in Spotify's 256KB limit.
+//
+// Example:
+//
+//	data, err := os.ReadFile("cover.png")
+//	if err != nil {
+//		return err
+//	}
+//	cover, err := spotigo.PrepareCoverImage(data)
+//	if err != nil {
+//		return err
+//	}
+//	_, err = client.PlaylistUploadCoverImage(ctx, playlistID, cover)
+func PrepareCoverImage(data []byte) ([]byte, error) {
+	if isJPEG(data) && base64.StdEncoding.EncodedLen(len(data)) <= maxCoverImagePayload {
+		return data, nil
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("decoding cover image: %w", err)
+	}
+	return encodeCoverImage(img)
+}
+
+// PlaylistUploadCoverImageFrom reads an image from r, converts it with
+// PrepareCoverImage, and uploads it as the playlist's cover, reporting the
+// response status like PlaylistUploadCoverImageStatus. JPEG and PNG are
+// supported; other formats work once their decoder is registered with the
+// image package, e.g. by importing golang.org/x/image/webp.
+//
+// Example:
+//
+//	f, err := os.Open("cover.png")
+//	if err != nil {
+//		return err
+//	}
+//	defer f.Close()
+//	_, err = client.PlaylistUploadCoverImageFrom(ctx, playlistID, f)
+func (c *Client) PlaylistUploadCoverImageFrom(ctx context.Context, playlistID string, r io.Reader) (*AcceptedResult, error) {
+	data, err := io.ReadAll(io.LimitReader(r, maxImageDownload+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > maxImageDownload {
+		return nil, fmt.Errorf("cover image exceeds %d bytes", maxImageDownload)
+	}
+
+	cover, err := PrepareCoverImage(data)
+	if err != nil {
+		return nil, err
+	}
+	return c.PlaylistUploadCoverImageStatus(ctx, playlistID, cover)
+}
+
+// PlaylistUploadCoverImageDecoded encodes img as a JPEG that fits the cover
+// image limit (see PrepareCoverImage) and uploads it as the playlist's cover
+func (c *Client) PlaylistUploadCoverImageDecoded(ctx context.Context, playlistID string, img image.Image) (*AcceptedResult, error) {
+	cover, err := encodeCoverImage(img)
+	if err != nil {
+		return nil, err
+	}
+	return c.PlaylistUploadCoverImageStatus(ctx, playlistID, cover)
+}
+
+// encodeCoverImage encodes img as a JPEG that fits the cover image limit
+func encodeCoverImage(img image.Image) ([]byte, error) {
+	bounds := img.Bounds()
+	if bounds.Dx() == 0 || bounds.Dy() == 0 {
+		return nil, fmt.Errorf("cover image is empty")
+	}
+
+	scale := min(1, float64(maxCoverImageDimension)/float64(max(bounds.Dx(), bounds.Dy())))
+	for {
+		width := max(1, int(float64(bounds.Dx())*scale))
+		height := max(1, int(float64(bounds.Dy())*scale))
+		scaled := img
+		if width != bounds.Dx() || height != bounds.Dy() {
+			scaled = resizeImage(img, width, height)
+		}
+
+		var buf bytes.Buffer
+		for _, quality := range coverImageQualities {
+			buf.Reset()
+			if err := jpeg.Encode(&buf, scaled, &jpeg.Options{Quality: quality}); err != nil {
+				return nil, err
+			}
+			if base64.StdEncoding.EncodedLen(buf.Len()) <= maxCoverImagePayload {
+				return buf.Bytes(), nil
+			}
+		}
+
+		if width <= 64 && height <= 64 {
+			return nil, fmt.Errorf("cover image does not fit in %d bytes", maxCoverImagePayload)
+		}
+		scale *= 0.75
+	}
+}
+
+// resizeImage scales img down to width by height, averaging the source
+// pixels that cover each destination pixel
+func resizeImage(img image.Image, width, height int) *image.RGBA {
+	bounds := img.Bounds()
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+
+	for y := 0; y < height; y++ {
+		y0 := bounds.Min.Y + y*bounds.Dy()/height
+		y1 := max(y0+1, bounds.Min.Y+(y+1)*bounds.Dy()/height)
+		for x := 0; x < width; x++ {
+			x0 := bounds.Min.X + x*bounds.Dx()/width
+			x1 := max(x0+1, bounds.Min.X+(x+1)*bounds.Dx()/width)
+
+			var r, g, b, a, n uint64
+			for sy := y0; sy < y1; sy++ {
+				for sx := x0; sx < x1; sx++ {
+					pr, pg, pb, pa := img.At(sx, sy).RGBA()
+					r, g, b, a = r+uint64(pr), g+uint64(pg), b+uint64(pb), a+uint64(pa)
+					n++
+				}
+			}
+			dst.Set(x, y, color.RGBA64{R: uint16(r / n), G: uint16(g / n), B: uint16(b / n), A: uint16(a / n)})
+		}
+	}
+
+	return dst
+}
+
+// isJPEG reports whether data starts with the JPEG magic bytes
+func isJPEG(data []byte) bool {
+	return len(data) >= 2 && data[0] == 0xFF && data[1] == 0xD8
+}
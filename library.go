@@ -0,0 +1,118 @@
+package spotigo
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// ============================================================================
+// Library Helpers
+// ============================================================================
+
+// libraryContainsBatchSize is the maximum number of IDs per contains request
+const libraryContainsBatchSize = 50
+
+// libraryContainsTypes lists the item types with a saved-status endpoint,
+// in the order they are checked
+var libraryContainsTypes = []string{"track", "album", "episode", "show", "audiobook"}
+
+// LibraryContains reports whether each item is saved in the current user's
+// library. Items may be tracks, albums, episodes, shows, and audiobooks in any
+// mix, given as Spotify URIs or URLs (raw IDs are ambiguous across types).
+// Each type is checked with its own contains endpoint in batches of 50.
+//
+// The result is keyed by the items as passed in. Items that cannot be checked
+// (raw IDs, unsupported types such as artists) are left out of the map and
+// reported in a *MultiError, returned alongside the results for valid items.
+//
+// Example:
+//
+//	saved, err := client.LibraryContains(ctx, []string{
+//		"spotify:track:4iV5W9uYEdYUVa79Axb7Rh",
+//		"spotify:album:4aawyAB9vmqN3uQ7FjRGTy",
+//		"spotify:show:5CfCWKI5pZ28U0uOzXkDHe",
+//	})
+//	if saved["spotify:track:4iV5W9uYEdYUVa79Axb7Rh"] {
+//		// Render a filled heart
+//	}
+func (c *Client) LibraryContains(ctx context.Context, uris []string) (map[string]bool, error) {
+	// Group items by type, keeping the inputs that map to each ID
+	ids := make(map[string][]string)
+	inputs := make(map[string]map[string][]string)
+	invalidItems := &MultiError{}
+
+	for i, item := range uris {
+		itemType, id, err := libraryItemType(item)
+		if err != nil {
+			invalidItems.Add(i, item, err)
+			continue
+		}
+		if inputs[itemType] == nil {
+			inputs[itemType] = make(map[string][]string)
+		}
+		if _, seen := inputs[itemType][id]; !seen {
+			ids[itemType] = append(ids[itemType], id)
+		}
+		inputs[itemType][id] = append(inputs[itemType][id], item)
+	}
+
+	result := make(map[string]bool, len(uris))
+	for _, itemType := range libraryContainsTypes {
+		typeIDs := ids[itemType]
+		for start := 0; start < len(typeIDs); start += libraryContainsBatchSize {
+			batch := typeIDs[start:min(start+libraryContainsBatchSize, len(typeIDs))]
+
+			saved, err := c.libraryContainsBatch(ctx, itemType, batch)
+			if err != nil {
+				return nil, err
+			}
+			if len(saved) != len(batch) {
+				return nil, fmt.Errorf("%s contains returned %d results for %d IDs", itemType, len(saved), len(batch))
+			}
+
+			for j, id := range batch {
+				for _, item := range inputs[itemType][id] {
+					result[item] = saved[j]
+				}
+			}
+		}
+	}
+
+	if invalidItems.Len() > 0 {
+		return result, fmt.Errorf("some items could not be checked (checked %d items): %w", len(result), invalidItems)
+	}
+
+	return result, nil
+}
+
+// libraryItemType determines the type and ID of a URI or URL
+func libraryItemType(item string) (string, string, error) {
+	if !IsURI(item) && !strings.Contains(item, "spotify.com") {
+		return "", "", fmt.Errorf("a Spotify URI or URL is required to determine the item type")
+	}
+	for _, itemType := range libraryContainsTypes {
+		if id, err := GetID(item, itemType); err == nil {
+			return itemType, id, nil
+		}
+	}
+	return "", "", fmt.Errorf("unsupported item type (supported: %s)", strings.Join(libraryContainsTypes, ", "))
+}
+
+// libraryContainsBatch calls the contains endpoint for one item type
+func (c *Client) libraryContainsBatch(ctx context.Context, itemType string, ids []string) ([]bool, error) {
+	switch itemType {
+	case "track":
+		return c.CurrentUserSavedTracksContains(ctx, ids)
+	case "album":
+		return c.CurrentUserSavedAlbumsContains(ctx, ids)
+	case "episode":
+		return c.CurrentUserSavedEpisodesContains(ctx, ids)
+	case "show":
+		return c.CurrentUserSavedShowsContains(ctx, ids)
+	case "audiobook":
+		return c.CurrentUserSavedAudiobooksContains(ctx, ids)
+	default:
+		return nil, fmt.Errorf("unsupported item type: %s", itemType)
+	}
+}
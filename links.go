@@ -0,0 +1,244 @@
+package spotigo
+
+import (
+	"net/url"
+)
+
+// ============================================================================
+// Links
+// ============================================================================
+
+// webBase is the origin of Spotify's web player links
+const webBase = "https://open.spotify.com/"
+
+// EmbedTheme selects the color scheme of an embedded player
+type EmbedTheme int
+
+const (
+	EmbedThemeDefault EmbedTheme = iota // Background colored after the artwork
+	EmbedThemeDark                      // Dark background
+)
+
+// itemURI returns the Spotify URI of an item, or "" without an ID
+func itemURI(kind, id string) string {
+	if id == "" {
+		return ""
+	}
+	return "spotify:" + kind + ":" + id
+}
+
+// itemWebURL returns the open.spotify.com link of an item, or "" without
+// an ID
+func itemWebURL(kind, id string) string {
+	if id == "" {
+		return ""
+	}
+	return webBase + kind + "/" + url.PathEscape(id)
+}
+
+// itemEmbedURL returns the embedded player link of an item, or "" without
+// an ID
+func itemEmbedURL(kind, id string, theme EmbedTheme) string {
+	if id == "" {
+		return ""
+	}
+	link := webBase + "embed/" + kind + "/" + url.PathEscape(id)
+	if theme == EmbedThemeDark {
+		link += "?theme=0"
+	}
+	return link
+}
+
+// ExternalURL returns the track's open.spotify.com link, built from its ID.
+// Like the other link methods on models, it returns "" for items without an
+// ID, such as local files, and does not depend on fields filtered out of
+// the response.
+//
+// Example:
+//
+//	fmt.Printf("Now playing: %s %s\n", track.Name, track.ExternalURL())
+//	// Now playing: Mr. Brightside https://open.spotify.com/track/003vvx7Niy0yvhvHt4a68B
+func (t *Track) ExternalURL() string {
+	return itemWebURL("track", t.ID)
+}
+
+// SpotifyURI returns the track's Spotify URI, built from its ID
+func (t *Track) SpotifyURI() string {
+	return itemURI("track", t.ID)
+}
+
+// EmbedURL returns the link of an embedded player for the track
+func (t *Track) EmbedURL(theme EmbedTheme) string {
+	return itemEmbedURL("track", t.ID, theme)
+}
+
+// ExternalURL returns the track's open.spotify.com link, built from its ID
+func (t *SimplifiedTrack) ExternalURL() string {
+	return itemWebURL("track", t.ID)
+}
+
+// SpotifyURI returns the track's Spotify URI, built from its ID
+func (t *SimplifiedTrack) SpotifyURI() string {
+	return itemURI("track", t.ID)
+}
+
+// EmbedURL returns the link of an embedded player for the track
+func (t *SimplifiedTrack) EmbedURL(theme EmbedTheme) string {
+	return itemEmbedURL("track", t.ID, theme)
+}
+
+// ExternalURL returns the artist's open.spotify.com link, built from its ID
+func (a *Artist) ExternalURL() string {
+	return itemWebURL("artist", a.ID)
+}
+
+// SpotifyURI returns the artist's Spotify URI, built from its ID
+func (a *Artist) SpotifyURI() string {
+	return itemURI("artist", a.ID)
+}
+
+// EmbedURL returns the link of an embedded player for the artist
+func (a *Artist) EmbedURL(theme EmbedTheme) string {
+	return itemEmbedURL("artist", a.ID, theme)
+}
+
+// ExternalURL returns the artist's open.spotify.com link, built from its ID
+func (a *SimplifiedArtist) ExternalURL() string {
+	return itemWebURL("artist", a.ID)
+}
+
+// SpotifyURI returns the artist's Spotify URI, built from its ID
+func (a *SimplifiedArtist) SpotifyURI() string {
+	return itemURI("artist", a.ID)
+}
+
+// EmbedURL returns the link of an embedded player for the artist
+func (a *SimplifiedArtist) EmbedURL(theme EmbedTheme) string {
+	return itemEmbedURL("artist", a.ID, theme)
+}
+
+// ExternalURL returns the album's open.spotify.com link, built from its ID
+func (a *Album) ExternalURL() string {
+	return itemWebURL("album", a.ID)
+}
+
+// SpotifyURI returns the album's Spotify URI, built from its ID
+func (a *Album) SpotifyURI() string {
+	return itemURI("album", a.ID)
+}
+
+// EmbedURL returns the link of an embedded player for the album
+func (a *Album) EmbedURL(theme EmbedTheme) string {
+	return itemEmbedURL("album", a.ID, theme)
+}
+
+// ExternalURL returns the album's open.spotify.com link, built from its ID
+func (a *SimplifiedAlbum) ExternalURL() string {
+	return itemWebURL("album", a.ID)
+}
+
+// SpotifyURI returns the album's Spotify URI, built from its ID
+func (a *SimplifiedAlbum) SpotifyURI() string {
+	return itemURI("album", a.ID)
+}
+
+// EmbedURL returns the link of an embedded player for the album
+func (a *SimplifiedAlbum) EmbedURL(theme EmbedTheme) string {
+	return itemEmbedURL("album", a.ID, theme)
+}
+
+// ExternalURL returns the playlist's open.spotify.com link, built from its ID
+func (p *SimplifiedPlaylist) ExternalURL() string {
+	return itemWebURL("playlist", p.ID)
+}
+
+// SpotifyURI returns the playlist's Spotify URI, built from its ID
+func (p *SimplifiedPlaylist) SpotifyURI() string {
+	return itemURI("playlist", p.ID)
+}
+
+// EmbedURL returns the link of an embedded player for the playlist, for an
+// <iframe> src
+//
+// Example:
+//
+//	src := playlist.EmbedURL(spotigo.EmbedThemeDark)
+//	// https://open.spotify.com/embed/playlist/37i9dQZF1DXcBWIGoYBM5M?theme=0
+func (p *SimplifiedPlaylist) EmbedURL(theme EmbedTheme) string {
+	return itemEmbedURL("playlist", p.ID, theme)
+}
+
+// ExternalURL returns the show's open.spotify.com link, built from its ID
+func (s *SimplifiedShow) ExternalURL() string {
+	return itemWebURL("show", s.ID)
+}
+
+// SpotifyURI returns the show's Spotify URI, built from its ID
+func (s *SimplifiedShow) SpotifyURI() string {
+	return itemURI("show", s.ID)
+}
+
+// EmbedURL returns the link of an embedded player for the show
+func (s *SimplifiedShow) EmbedURL(theme EmbedTheme) string {
+	return itemEmbedURL("show", s.ID, theme)
+}
+
+// ExternalURL returns the episode's open.spotify.com link, built from its ID
+func (e *SimplifiedEpisode) ExternalURL() string {
+	return itemWebURL("episode", e.ID)
+}
+
+// SpotifyURI returns the episode's Spotify URI, built from its ID
+func (e *SimplifiedEpisode) SpotifyURI() string {
+	return itemURI("episode", e.ID)
+}
+
+// EmbedURL returns the link of an embedded player for the episode
+func (e *SimplifiedEpisode) EmbedURL(theme EmbedTheme) string {
+	return itemEmbedURL("episode", e.ID, theme)
+}
+
+// ExternalURL returns the audiobook's open.spotify.com link, built from its ID
+func (a *SimplifiedAudiobook) ExternalURL() string {
+	return itemWebURL("audiobook", a.ID)
+}
+
+// SpotifyURI returns the audiobook's Spotify URI, built from its ID
+func (a *SimplifiedAudiobook) SpotifyURI() string {
+	return itemURI("audiobook", a.ID)
+}
+
+// EmbedURL returns the link of an embedded player for the audiobook
+func (a *SimplifiedAudiobook) EmbedURL(theme EmbedTheme) string {
+	return itemEmbedURL("audiobook", a.ID, theme)
+}
+
+// ExternalURL returns the chapter's open.spotify.com link, built from its ID
+func (c *Chapter) ExternalURL() string {
+	return itemWebURL("chapter", c.ID)
+}
+
+// SpotifyURI returns the chapter's Spotify URI, built from its ID
+func (c *Chapter) SpotifyURI() string {
+	return itemURI("chapter", c.ID)
+}
+
+// ExternalURL returns the user profile's open.spotify.com link, built from its ID
+func (u *PublicUser) ExternalURL() string {
+	return itemWebURL("user", u.ID)
+}
+
+// SpotifyURI returns the user profile's Spotify URI, built from its ID
+func (u *PublicUser) SpotifyURI() string {
+	return itemURI("user", u.ID)
+}
+
+// ExternalURL returns the user profile's open.spotify.com link, built from its ID
+func (u *User) ExternalURL() string {
+	return itemWebURL("user", u.ID)
+}
+
+// SpotifyURI returns the user profile's Spotify URI, built from its ID
+func (u *User) SpotifyURI() string {
+	return itemURI("user", u.ID)
+}
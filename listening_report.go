@@ -0,0 +1,230 @@
+package spotigo
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// ============================================================================
+// Listening Reports
+// ============================================================================
+
+// Tally is one entry of a distribution: how many of the items considered
+// have a value, and what fraction of them that is
+type Tally struct {
+	Name     string  // Genre, decade ("1990s"), or artist name
+	Count    int     // Number of items with the value
+	Fraction float64 // Count divided by the number of items considered
+}
+
+// AudioProfile is the average audio features of a set of tracks
+type AudioProfile struct {
+	Tracks           int     // Number of tracks with audio features
+	Danceability     float64 // 0.0 to 1.0
+	Energy           float64 // 0.0 to 1.0
+	Valence          float64 // 0.0 (sad) to 1.0 (happy)
+	Acousticness     float64 // 0.0 to 1.0
+	Instrumentalness float64 // 0.0 to 1.0
+	Speechiness      float64 // 0.0 to 1.0
+	Liveness         float64 // 0.0 to 1.0
+	Loudness         float64 // Decibels
+	Tempo            float64 // Beats per minute
+}
+
+// ListeningReport summarizes a user's listening over a time range
+type ListeningReport struct {
+	Range             TimeRange         // Time range of the top items
+	TopTracks         []Track           // Top tracks, up to 99
+	TopArtists        []Artist          // Top artists, up to 99
+	RecentlyPlayed    []PlayHistoryItem // Last 50 plays, newest first
+	Genres            []Tally           // Genres of the top artists, most common first
+	Decades           []Tally           // Release decades of the top tracks, most common first
+	RecentArtists     []Tally           // Artists of the recent plays, most played first
+	AveragePopularity float64           // Mean popularity (0-100) of the top tracks
+	AudioProfile      *AudioProfile     // Averaged top track audio features; nil if unavailable
+}
+
+// ListeningReport builds a "wrapped"-style summary of the current user's
+// listening from their top tracks and artists for timeRange (empty for the
+// default, TimeRangeMedium) and their recently played tracks.
+//
+// The audio profile needs audio features, which Spotify has sunset for
+// most apps (see EndpointAudioFeatures); when they are refused the report
+// is returned without one. Requires the user-top-read and
+// user-read-recently-played scopes.
+//
+// Example:
+//
+//	report, err := client.ListeningReport(ctx, spotigo.TimeRangeLong)
+//	if err != nil {
+//		return err
+//	}
+//	for _, genre := range report.Genres[:min(5, len(report.Genres))] {
+//		fmt.Printf("%s: %.0f%% of your top artists\n", genre.Name, genre.Fraction*100)
+//	}
+func (c *Client) ListeningReport(ctx context.Context, timeRange TimeRange) (*ListeningReport, error) {
+	opts := &TopItemsOptions{Range: timeRange}
+	tracks, err := c.CurrentUserTopTracksAll(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	artists, err := c.CurrentUserTopArtistsAll(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	recent, err := c.CurrentUserRecentlyPlayed(ctx, &RecentlyPlayedOptions{Limit: 50})
+	if err != nil {
+		return nil, err
+	}
+
+	recentTracks := make([]Track, len(recent.Items))
+	for i, item := range recent.Items {
+		recentTracks[i] = item.Track
+	}
+
+	report := &ListeningReport{
+		Range:             timeRange,
+		TopTracks:         tracks,
+		TopArtists:        artists,
+		RecentlyPlayed:    recent.Items,
+		Genres:            GenreDistribution(artists),
+		Decades:           DecadeDistribution(tracks),
+		RecentArtists:     artistDistribution(recentTracks),
+		AveragePopularity: AveragePopularity(tracks),
+	}
+
+	var ids []string
+	for _, track := range tracks {
+		if track.ID != "" && !track.IsLocal {
+			ids = append(ids, track.ID)
+		}
+	}
+	if len(ids) > 0 {
+		features, err := c.AudioFeaturesMultiple(ctx, ids)
+		switch {
+		case err == nil:
+			report.AudioProfile = AverageAudioFeatures(features)
+		case !errors.Is(err, ErrEndpointDeprecated):
+			return nil, err
+		}
+	}
+	return report, nil
+}
+
+// GenreDistribution counts the genres of artists. Fractions are of the
+// artists with any genre, so they do not sum to 1 when artists have several.
+func GenreDistribution(artists []Artist) []Tally {
+	counts := map[string]int{}
+	considered := 0
+	for _, artist := range artists {
+		if len(artist.Genres) == 0 {
+			continue
+		}
+		considered++
+		for _, genre := range artist.Genres {
+			counts[genre]++
+		}
+	}
+	return tallies(counts, considered)
+}
+
+// DecadeDistribution counts the release decades ("1990s") of tracks' albums.
+// Tracks without a release date are not considered.
+func DecadeDistribution(tracks []Track) []Tally {
+	counts := map[string]int{}
+	considered := 0
+	for _, track := range tracks {
+		if track.Album == nil || track.Album.ReleaseDate.Year == 0 {
+			continue
+		}
+		considered++
+		counts[fmt.Sprintf("%ds", track.Album.ReleaseDate.Year/10*10)]++
+	}
+	return tallies(counts, considered)
+}
+
+// artistDistribution counts the primary artists of tracks
+func artistDistribution(tracks []Track) []Tally {
+	counts := map[string]int{}
+	considered := 0
+	for _, track := range tracks {
+		if len(track.Artists) == 0 {
+			continue
+		}
+		considered++
+		counts[track.Artists[0].Name]++
+	}
+	return tallies(counts, considered)
+}
+
+// tallies converts counts of considered items to tallies, most common first
+// and then by name
+func tallies(counts map[string]int, considered int) []Tally {
+	result := make([]Tally, 0, len(counts))
+	for name, count := range counts {
+		result = append(result, Tally{Name: name, Count: count, Fraction: float64(count) / float64(considered)})
+	}
+	slices.SortFunc(result, func(a, b Tally) int {
+		if n := cmp.Compare(b.Count, a.Count); n != 0 {
+			return n
+		}
+		return cmp.Compare(a.Name, b.Name)
+	})
+	return result
+}
+
+// AveragePopularity returns the mean popularity (0-100) of tracks, or 0 for
+// none. Local tracks, which have no popularity, are not considered.
+func AveragePopularity(tracks []Track) float64 {
+	total, considered := 0, 0
+	for _, track := range tracks {
+		if track.IsLocal {
+			continue
+		}
+		total += track.Popularity
+		considered++
+	}
+	if considered == 0 {
+		return 0
+	}
+	return float64(total) / float64(considered)
+}
+
+// AverageAudioFeatures averages audio features, skipping the empty entries
+// Spotify returns for tracks without features. Returns nil if none remain.
+func AverageAudioFeatures(features []AudioFeatures) *AudioProfile {
+	var profile AudioProfile
+	for _, f := range features {
+		if f.ID == "" {
+			continue
+		}
+		profile.Tracks++
+		profile.Danceability += f.Danceability
+		profile.Energy += f.Energy
+		profile.Valence += f.Valence
+		profile.Acousticness += f.Acousticness
+		profile.Instrumentalness += f.Instrumentalness
+		profile.Speechiness += f.Speechiness
+		profile.Liveness += f.Liveness
+		profile.Loudness += f.Loudness
+		profile.Tempo += f.Tempo
+	}
+	if profile.Tracks == 0 {
+		return nil
+	}
+
+	n := float64(profile.Tracks)
+	profile.Danceability /= n
+	profile.Energy /= n
+	profile.Valence /= n
+	profile.Acousticness /= n
+	profile.Instrumentalness /= n
+	profile.Speechiness /= n
+	profile.Liveness /= n
+	profile.Loudness /= n
+	profile.Tempo /= n
+	return &profile
+}
@@ -0,0 +1,172 @@
+package spotigo
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// ============================================================================
+// Local Files
+// ============================================================================
+
+// localURIPrefix starts the URIs of local files
+const localURIPrefix = "spotify:local:"
+
+// LocalTrack is a local file in a playlist. Local files have no Spotify ID
+// and cannot be played through the API; only their tags are known.
+type LocalTrack struct {
+	URI        string `json:"uri"`                   // spotify:local: URI
+	Name       string `json:"name"`                  // Track title
+	Artist     string `json:"artist,omitempty"`      // First artist, if tagged
+	Album      string `json:"album,omitempty"`       // Album, if tagged
+	DurationMs int    `json:"duration_ms,omitempty"` // Duration, if known
+}
+
+// IsLocalURI reports whether uri identifies a local file
+func IsLocalURI(uri string) bool {
+	return strings.HasPrefix(uri, localURIPrefix)
+}
+
+// ParseLocalURI reads the tags encoded in a local file URI,
+// spotify:local:{artist}:{album}:{title}:{seconds}, where each part is
+// URL-encoded and any but the title may be empty.
+//
+// Example:
+//
+//	local, err := spotigo.ParseLocalURI("spotify:local:Nick+Drake:Pink+Moon:Place+To+Be:163")
+//	fmt.Println(local.Name, local.DurationMs) // Place To Be 163000
+func ParseLocalURI(uri string) (*LocalTrack, error) {
+	rest, ok := strings.CutPrefix(uri, localURIPrefix)
+	parts := strings.Split(rest, ":")
+	if !ok || len(parts) != 4 {
+		return nil, fmt.Errorf("invalid local file URI: %s", uri)
+	}
+
+	for i, part := range parts[:3] {
+		unescaped, err := url.QueryUnescape(part)
+		if err != nil {
+			return nil, fmt.Errorf("invalid local file URI: %s: %w", uri, err)
+		}
+		parts[i] = unescaped
+	}
+
+	local := &LocalTrack{URI: uri, Artist: parts[0], Album: parts[1], Name: parts[2]}
+	if parts[3] != "" {
+		seconds, err := strconv.Atoi(parts[3])
+		if err != nil {
+			return nil, fmt.Errorf("invalid local file URI: %s: bad duration", uri)
+		}
+		local.DurationMs = seconds * 1000
+	}
+	return local, nil
+}
+
+// AsLocal returns the track as a LocalTrack if it is a local file. Tags
+// missing from the track object are filled in from its URI.
+func (t *Track) AsLocal() (*LocalTrack, bool) {
+	if !t.IsLocal && !IsLocalURI(t.URI) {
+		return nil, false
+	}
+
+	local := &LocalTrack{URI: t.URI, Name: t.Name, DurationMs: t.DurationMs}
+	if len(t.Artists) > 0 {
+		local.Artist = t.Artists[0].Name
+	}
+	if t.Album != nil {
+		local.Album = t.Album.Name
+	}
+
+	if parsed, err := ParseLocalURI(t.URI); err == nil {
+		local.Name = cmp.Or(local.Name, parsed.Name)
+		local.Artist = cmp.Or(local.Artist, parsed.Artist)
+		local.Album = cmp.Or(local.Album, parsed.Album)
+		local.DurationMs = cmp.Or(local.DurationMs, parsed.DurationMs)
+	}
+	return local, true
+}
+
+// AsLocal decodes the item as a local file, returning false for anything
+// else
+func (p PlaylistTrack) AsLocal() (*LocalTrack, bool) {
+	if p.Kind() != PlaylistItemLocal {
+		return nil, false
+	}
+	track, ok := p.AsTrack()
+	if !ok {
+		return nil, false
+	}
+	return track.AsLocal()
+}
+
+// Item converts the item to a PlaylistItem, with exactly one of Track,
+// Episode, or Local set, or none for unavailable items
+func (p PlaylistTrack) Item() PlaylistItem {
+	item := PlaylistItem{AddedAt: p.AddedAt, AddedBy: p.AddedBy, IsLocal: p.IsLocal}
+	switch p.Kind() {
+	case PlaylistItemTrack:
+		item.Track, _ = p.AsTrack()
+	case PlaylistItemEpisode:
+		item.Episode, _ = p.AsEpisode()
+	case PlaylistItemLocal:
+		item.Local, _ = p.AsLocal()
+		item.IsLocal = true
+	}
+	return item
+}
+
+// UnmarshalJSON decodes a playlist item, placing its track object in Track,
+// Episode, or Local according to its type
+func (p *PlaylistItem) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		AddedAt string          `json:"added_at"`
+		AddedBy *PublicUser     `json:"added_by"`
+		IsLocal bool            `json:"is_local"`
+		Track   json.RawMessage `json:"track"`
+		Episode *Episode        `json:"episode"`
+		Local   *LocalTrack     `json:"local"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	*p = PlaylistItem{
+		AddedAt: raw.AddedAt,
+		AddedBy: raw.AddedBy,
+		IsLocal: raw.IsLocal || raw.Local != nil,
+		Episode: raw.Episode,
+		Local:   raw.Local,
+	}
+	if len(raw.Track) == 0 || string(raw.Track) == "null" {
+		return nil
+	}
+
+	var kind struct {
+		Type string `json:"type"`
+		URI  string `json:"uri"`
+	}
+	if err := json.Unmarshal(raw.Track, &kind); err != nil {
+		return err
+	}
+	if kind.Type == "episode" || strings.HasPrefix(kind.URI, "spotify:episode:") {
+		return json.Unmarshal(raw.Track, &p.Episode)
+	}
+
+	var track Track
+	if err := json.Unmarshal(raw.Track, &track); err != nil {
+		return err
+	}
+	if local, ok := track.AsLocal(); ok || raw.IsLocal {
+		if local == nil {
+			local = &LocalTrack{URI: track.URI, Name: track.Name, DurationMs: track.DurationMs}
+		}
+		p.Local = local
+		p.IsLocal = true
+		return nil
+	}
+	p.Track = &track
+	return nil
+}
@@ -0,0 +1,313 @@
+package spotigo
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// ============================================================================
+// Market Availability and Track Relinking
+// ============================================================================
+
+// MarketFromToken is the market value that makes Spotify use the country of
+// the user the access token belongs to
+const MarketFromToken = "from_token"
+
+// playableInMarket decides availability from the fields Spotify returns.
+// When a request is made with a market, Spotify relinks tracks and reports
+// is_playable (and restrictions) instead of available_markets.
+func playableInMarket(market string, isPlayable *bool, restrictions *Restrictions, availableMarkets []string) bool {
+	if restrictions != nil && restrictions.Reason != "" {
+		return false
+	}
+	if isPlayable != nil {
+		return *isPlayable
+	}
+	if market == "" || market == MarketFromToken {
+		// No market to check against; without is_playable, assume playable
+		return true
+	}
+	return slices.ContainsFunc(availableMarkets, func(m string) bool {
+		return strings.EqualFold(m, market)
+	})
+}
+
+// PlayableIn reports whether the track can be played in market (an ISO
+// 3166-1 alpha-2 country code).
+//
+// For results requested with a market, is_playable and restrictions are
+// authoritative and market is only used as a fallback. Otherwise the track
+// must list market in available_markets.
+//
+// Example:
+//
+//	if !track.PlayableIn("DE") {
+//		// Greyed out for German users
+//	}
+func (t Track) PlayableIn(market string) bool {
+	return playableInMarket(market, t.IsPlayable, t.Restrictions, t.AvailableMarkets)
+}
+
+// PlayableIn reports whether the track can be played in market
+// (see Track.PlayableIn)
+func (t SimplifiedTrack) PlayableIn(market string) bool {
+	return playableInMarket(market, t.IsPlayable, t.Restrictions, t.AvailableMarkets)
+}
+
+// PlayableIn reports whether the album is available in market
+// (see Track.PlayableIn)
+func (a Album) PlayableIn(market string) bool {
+	return playableInMarket(market, nil, a.Restrictions, a.AvailableMarkets)
+}
+
+// PlayableIn reports whether the album is available in market
+// (see Track.PlayableIn)
+func (a SimplifiedAlbum) PlayableIn(market string) bool {
+	return playableInMarket(market, nil, a.Restrictions, a.AvailableMarkets)
+}
+
+// IsRelinked reports whether Spotify substituted this track for the one
+// requested because the original is unavailable in the request's market.
+// LinkedFrom holds the originally requested track.
+func (t Track) IsRelinked() bool {
+	return t.LinkedFrom != nil
+}
+
+// RequestedID returns the ID of the track that was originally requested,
+// which differs from ID for relinked tracks
+func (t Track) RequestedID() string {
+	if t.LinkedFrom != nil && t.LinkedFrom.ID != "" {
+		return t.LinkedFrom.ID
+	}
+	return t.ID
+}
+
+// Playable is implemented by catalog items whose availability can be checked
+// per market
+type Playable interface {
+	PlayableIn(market string) bool
+}
+
+// FilterPlayable returns the items that are playable in market, preserving
+// order. The input slice is not modified.
+//
+// Example:
+//
+//	tracks, _ := client.ArtistTopTracks(ctx, artistID, "US")
+//	playable := spotigo.FilterPlayable(tracks.Tracks, "US")
+func FilterPlayable[T Playable](items []T, market string) []T {
+	playable := make([]T, 0, len(items))
+	for _, item := range items {
+		if item.PlayableIn(market) {
+			playable = append(playable, item)
+		}
+	}
+	return playable
+}
+
+// WithMarketFromToken adds market=from_token to catalog requests that accept
+// a market and don't specify one. Spotify then relinks tracks for the user's
+// country and reports is_playable, so greyed-out tracks can be detected
+// with PlayableIn.
+//
+// It only applies when the auth manager acts for a user (see
+// UserScopedAuth); Client Credentials tokens have no country, so requests
+// made with them are left unchanged.
+// A default market set with WithDefaultMarket or ContextWithMarket takes
+// precedence.
+func WithMarketFromToken() ClientOption {
+	return WithAutoFromTokenMarket(true)
+}
+
+// WithAutoFromTokenMarket turns WithMarketFromToken on or off, for apps
+// that read the setting from configuration.
+//
+// Example:
+//
+//	client, err := spotigo.NewClient(auth, spotigo.WithAutoFromTokenMarket(cfg.UserMarket))
+func WithAutoFromTokenMarket(enabled bool) ClientOption {
+	return func(c *Client) {
+		c.MarketFromToken = enabled
+	}
+}
+
+// UserScopedAuth is implemented by auth managers that know whether their
+// tokens belong to a user. Auth managers that don't implement it are
+// treated as user-scoped, except ClientCredentials.
+type UserScopedAuth interface {
+	UserScoped() bool
+}
+
+// UserScoped implements UserScopedAuth; Client Credentials tokens belong to
+// the app and have no country
+func (c *ClientCredentials) UserScoped() bool {
+	return false
+}
+
+// userScoped reports whether the client's tokens belong to a user
+func (c *Client) userScoped() bool {
+	if auth, ok := c.AuthManager.(UserScopedAuth); ok {
+		return auth.UserScoped()
+	}
+	return true
+}
+
+// marketEndpointPattern matches API paths that accept a market parameter
+var marketEndpointPattern = regexp.MustCompile(`^(` +
+	`tracks(/[^/]+)?|` +
+	`albums(/[^/]+(/tracks)?)?|` +
+	`artists/[^/]+/(top-tracks|albums)|` +
+	`search|` +
+	`playlists/[^/]+(/tracks)?|` +
+	`shows(/[^/]+(/episodes)?)?|` +
+	`episodes(/[^/]+)?|` +
+	`audiobooks(/[^/]+(/chapters)?)?|` +
+	`chapters(/[^/]+)?|` +
+	`me/(tracks|albums|shows|episodes|audiobooks|player|player/currently-playing)` +
+	`)$`)
+
+// browseCountryPattern matches browse paths that take a country instead of
+// a market
+var browseCountryPattern = regexp.MustCompile(`^browse/(categories(/[^/]+(/playlists)?)?|featured-playlists|new-releases)$`)
+
+// localeEndpointPattern matches API paths that accept a locale parameter
+var localeEndpointPattern = regexp.MustCompile(`^browse/(categories(/[^/]+)?|featured-playlists)$`)
+
+// localePattern matches an ISO 639-1 language code, optionally followed by
+// an underscore and an ISO 3166-1 alpha-2 country code (e.g. "es_MX")
+var localePattern = regexp.MustCompile(`^[a-z]{2}(_[A-Z]{2})?$`)
+
+// WithDefaultMarket adds market to requests that accept a market and don't
+// specify one, and uses it as the country of browse requests. Explicit
+// market arguments and ContextWithMarket take precedence.
+//
+// Example:
+//
+//	client, err := spotigo.NewClient(auth, spotigo.WithDefaultMarket("US"))
+func WithDefaultMarket(market string) ClientOption {
+	return func(c *Client) {
+		c.DefaultMarket = market
+	}
+}
+
+// WithDefaultLocale adds locale (e.g. "es_MX") to requests that accept a
+// locale and don't specify one. ContextWithLocale takes precedence.
+func WithDefaultLocale(locale string) ClientOption {
+	return func(c *Client) {
+		c.DefaultLocale = locale
+	}
+}
+
+// marketContextKey and localeContextKey are the context keys for per-request
+// market and locale defaults; noLocaleContextKey disables the default locale
+type (
+	marketContextKey   struct{}
+	localeContextKey   struct{}
+	noLocaleContextKey struct{}
+)
+
+// ContextWithMarket returns a context whose requests default to market,
+// overriding the client's default market. Explicit market arguments still
+// take precedence.
+//
+// Example:
+//
+//	// Serve a request for a user in Germany
+//	ctx = spotigo.ContextWithMarket(ctx, "DE")
+//	album, err := client.Album(ctx, albumID)
+func ContextWithMarket(ctx context.Context, market string) context.Context {
+	return context.WithValue(ctx, marketContextKey{}, market)
+}
+
+// ContextWithLocale returns a context whose requests default to locale,
+// overriding the client's default locale
+func ContextWithLocale(ctx context.Context, locale string) context.Context {
+	return context.WithValue(ctx, localeContextKey{}, locale)
+}
+
+// requestMarket returns the default market for a request: the context's,
+// then the client's, then from_token if MarketFromToken applies
+func (c *Client) requestMarket(ctx context.Context) string {
+	if market, ok := ctx.Value(marketContextKey{}).(string); ok && market != "" {
+		return market
+	}
+	if c.DefaultMarket != "" {
+		return c.DefaultMarket
+	}
+	if c.MarketFromToken && c.userScoped() {
+		return MarketFromToken
+	}
+	return ""
+}
+
+// contextWithoutLocale returns a context whose requests get no default
+// locale, e.g. to ask for Spotify's default language
+func contextWithoutLocale(ctx context.Context) context.Context {
+	return context.WithValue(ctx, noLocaleContextKey{}, true)
+}
+
+// requestLocale returns the default locale for a request: the context's,
+// then the client's
+func (c *Client) requestLocale(ctx context.Context) string {
+	if ctx.Value(noLocaleContextKey{}) != nil {
+		return ""
+	}
+	if locale, ok := ctx.Value(localeContextKey{}).(string); ok && locale != "" {
+		return locale
+	}
+	return c.DefaultLocale
+}
+
+// applyMarketDefaults adds the default market, browse country, and locale to
+// params for requests that accept them and don't set them.
+// params is copied rather than modified.
+func (c *Client) applyMarketDefaults(ctx context.Context, method, urlStr string, params url.Values) (url.Values, error) {
+	if method != "GET" {
+		return params, nil
+	}
+	// Absolute URLs are pagination links, which already carry the market
+	if strings.HasPrefix(urlStr, "http://") || strings.HasPrefix(urlStr, "https://") {
+		return params, nil
+	}
+
+	path, _, _ := strings.Cut(strings.TrimPrefix(urlStr, "/"), "?")
+	defaults := url.Values{}
+
+	market := c.requestMarket(ctx)
+	if market != "" {
+		if marketEndpointPattern.MatchString(path) && !params.Has("market") {
+			defaults.Set("market", market)
+		}
+		if market != MarketFromToken && browseCountryPattern.MatchString(path) && !params.Has("country") {
+			defaults.Set("country", market)
+		}
+		if len(defaults) > 0 {
+			if err := validateMarketParameter(market); err != nil {
+				return nil, fmt.Errorf("invalid default market: %w", err)
+			}
+		}
+	}
+
+	if locale := c.requestLocale(ctx); locale != "" && localeEndpointPattern.MatchString(path) && !params.Has("locale") {
+		if !localePattern.MatchString(locale) {
+			return nil, fmt.Errorf("invalid default locale: %q", locale)
+		}
+		defaults.Set("locale", locale)
+	}
+
+	if len(defaults) == 0 {
+		return params, nil
+	}
+	withDefaults := make(url.Values, len(params)+len(defaults))
+	for key, values := range params {
+		withDefaults[key] = values
+	}
+	for key, values := range defaults {
+		withDefaults[key] = values
+	}
+	return withDefaults, nil
+}
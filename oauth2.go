@@ -20,6 +20,7 @@ import (
 	"strconv"
 	"sort"
 	"strings"
+	"sync"
 	"time"
 )
 
@@ -69,6 +70,14 @@ type TokenInfo struct {
 	AdditionalFields map[string]interface{} `json:"-"`
 }
 
+// Expiry returns when the access token expires, or the zero time if unknown
+func (t *TokenInfo) Expiry() time.Time {
+	if t == nil || t.ExpiresAt == 0 {
+		return time.Time{}
+	}
+	return time.Unix(int64(t.ExpiresAt), 0)
+}
+
 // SpotifyAuthBase provides base functionality for all auth managers
 type SpotifyAuthBase struct {
 	ClientID        string
@@ -80,6 +89,18 @@ type SpotifyAuthBase struct {
 	CacheHandler    CacheHandler // Will be defined in cache.go
 	Proxies         map[string]string
 	RequestsTimeout time.Duration
+	ReplayGuard     *ReplayGuard  // Rejects reused authorization codes and states (nil disables)
+	ExpirySkew      time.Duration // Treat tokens as expired this long before they expire (default: DefaultExpirySkew)
+
+	// CredentialsProvider, if set, supplies ClientID and ClientSecret before
+	// each token request (see CredentialsProvider)
+	CredentialsProvider CredentialsProvider
+
+	// OnTokenRefreshed, if set, is called with a copy of each token obtained
+	// by a refresh, after it is cached. Spotify may rotate the refresh token
+	// on refresh, so servers that store tokens outside the CacheHandler
+	// should persist this one before the old refresh token stops working.
+	OnTokenRefreshed func(TokenInfo)
 }
 
 // ensureValue checks if a value is provided, otherwise gets it from environment
@@ -106,6 +127,7 @@ func newHTTPClient(timeout time.Duration) *http.Client {
 		TLSClientConfig: &tls.Config{
 			MinVersion: tls.VersionTLS12,
 		},
+		Proxy:               http.ProxyFromEnvironment,
 		MaxIdleConns:        100,
 		MaxIdleConnsPerHost: 10,
 		IdleConnTimeout:     90 * time.Second,
@@ -152,6 +174,7 @@ func NewSpotifyAuthBase(clientID, clientSecret, redirectURI, scope string) (*Spo
 		RedirectURI:     redirectURI,
 		HTTPClient:      newHTTPClient(5 * time.Second),
 		RequestsTimeout: 5 * time.Second,
+		ReplayGuard:     NewReplayGuard(DefaultReplayWindow),
 	}
 
 	// Normalize scope if provided
@@ -162,13 +185,31 @@ func NewSpotifyAuthBase(clientID, clientSecret, redirectURI, scope string) (*Spo
 	return base, nil
 }
 
-// IsTokenExpired checks if token expires within 60 seconds
+// DefaultExpirySkew is how long before expiry tokens are refreshed when
+// ExpirySkew is not set
+const DefaultExpirySkew = 60 * time.Second
+
+// expirySkew returns ExpirySkew, or DefaultExpirySkew if it is not set,
+// capped at half the token's lifetime so a skew longer than the lifetime
+// does not make every new token count as expired
+func (b *SpotifyAuthBase) expirySkew(tokenInfo *TokenInfo) time.Duration {
+	skew := DefaultExpirySkew
+	if b.ExpirySkew > 0 {
+		skew = b.ExpirySkew
+	}
+	if tokenInfo != nil && tokenInfo.ExpiresIn > 0 {
+		skew = min(skew, time.Duration(tokenInfo.ExpiresIn)*time.Second/2)
+	}
+	return skew
+}
+
+// IsTokenExpired checks if token expires within ExpirySkew (default: 60
+// seconds, at most half the token's lifetime)
 func (b *SpotifyAuthBase) IsTokenExpired(tokenInfo *TokenInfo) bool {
 	if tokenInfo == nil || tokenInfo.ExpiresAt == 0 {
 		return true
 	}
-	now := int(time.Now().Unix())
-	return tokenInfo.ExpiresAt-now < 60
+	return time.Until(tokenInfo.Expiry()) < b.expirySkew(tokenInfo)
 }
 
 // NormalizeScope converts scope input to normalized space-separated string
@@ -255,6 +296,17 @@ func (b *SpotifyAuthBase) IsScopeSubset(requested, granted string) bool {
 	return true
 }
 
+// tokenRefreshed saves a refreshed token to the cache and reports it to
+// OnTokenRefreshed
+func (b *SpotifyAuthBase) tokenRefreshed(ctx context.Context, tokenInfo *TokenInfo) {
+	if b.CacheHandler != nil {
+		_ = b.CacheHandler.SaveTokenToCache(ctx, tokenInfo) // Ignore cache errors
+	}
+	if b.OnTokenRefreshed != nil {
+		b.OnTokenRefreshed(*tokenInfo)
+	}
+}
+
 // GetAuthHeader generates Basic authentication header
 // Format: "Basic {base64(client_id:client_secret)}"
 func (b *SpotifyAuthBase) GetAuthHeader() string {
@@ -310,13 +362,13 @@ func HandleOAuthError(httpError error, body []byte) error {
 		if oauthErr.Error != "" {
 			return &SpotifyOAuthError{
 				ErrorType:        oauthErr.Error,
-				ErrorDescription: oauthErr.ErrorDescription,
+				ErrorDescription: RedactString(oauthErr.ErrorDescription),
 			}
 		}
 	}
 
 	// If JSON parse failed or error field is empty, use body as text
-	errorText := strings.TrimSpace(string(body))
+	errorText := RedactString(strings.TrimSpace(string(body)))
 	if errorText == "" {
 		errorText = "Unknown OAuth error"
 	}
@@ -339,6 +391,12 @@ func (b *SpotifyAuthBase) Close() {
 // ClientCredentials implements the Client Credentials OAuth2 flow
 type ClientCredentials struct {
 	*SpotifyAuthBase
+
+	// OnPrefetchError, if set, is called when a background token fetch
+	// started by StartPrefetch fails
+	OnPrefetchError func(error)
+
+	mu sync.Mutex // Serializes token fetches and TokenInfo updates
 }
 
 // NewClientCredentials creates a new Client Credentials auth manager
@@ -361,6 +419,7 @@ func NewClientCredentials(clientID, clientSecret string) (*ClientCredentials, er
 		RedirectURI:     "", // Not needed for Client Credentials
 		HTTPClient:      newHTTPClient(5 * time.Second),
 		RequestsTimeout: 5 * time.Second,
+		ReplayGuard:     NewReplayGuard(DefaultReplayWindow),
 	}
 
 	return &ClientCredentials{SpotifyAuthBase: base}, nil
@@ -368,8 +427,24 @@ func NewClientCredentials(clientID, clientSecret string) (*ClientCredentials, er
 
 // GetAccessToken retrieves or refreshes the access token
 func (c *ClientCredentials) GetAccessToken(ctx context.Context) (string, error) {
+	return c.accessToken(ctx, false)
+}
+
+// ForceRefreshToken requests a new access token even if the current one
+// is still valid
+func (c *ClientCredentials) ForceRefreshToken(ctx context.Context) error {
+	_, err := c.accessToken(ctx, true)
+	return err
+}
+
+// accessToken returns a valid token, requesting a new one if there is none
+// or force is set
+func (c *ClientCredentials) accessToken(ctx context.Context, force bool) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
 	// Check cache first (if cache handler is set)
-	if c.CacheHandler != nil {
+	if c.CacheHandler != nil && !force {
 		cachedToken, err := c.CacheHandler.GetCachedToken(ctx)
 		if err == nil && cachedToken != nil {
 			// Check if token is expired
@@ -381,10 +456,15 @@ func (c *ClientCredentials) GetAccessToken(ctx context.Context) (string, error)
 	}
 
 	// Check if we have a non-expired token in memory
-	if c.TokenInfo != nil && !c.IsTokenExpired(c.TokenInfo) {
+	if c.TokenInfo != nil && !c.IsTokenExpired(c.TokenInfo) && !force {
 		return c.TokenInfo.AccessToken, nil
 	}
 
+	// Load current credentials (supports rotation)
+	if err := c.loadCredentials(ctx); err != nil {
+		return "", err
+	}
+
 	// Request new token with retry logic for transient network errors
 	const maxRetries = 3
 	var lastErr error
@@ -467,11 +547,7 @@ func (c *ClientCredentials) GetAccessToken(ctx context.Context) (string, error)
 
 		// Store token
 		c.TokenInfo = tokenInfo
-
-		// Save to cache if cache handler is set
-		if c.CacheHandler != nil {
-			_ = c.CacheHandler.SaveTokenToCache(ctx, tokenInfo) // Ignore cache errors
-		}
+		c.tokenRefreshed(ctx, tokenInfo)
 
 		return tokenInfo.AccessToken, nil
 	}
@@ -507,6 +583,9 @@ func isTransientNetworkError(err error) bool {
 
 // GetCachedToken returns the cached token info
 func (c *ClientCredentials) GetCachedToken(ctx context.Context) (*TokenInfo, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
 	// Check cache handler first
 	if c.CacheHandler != nil {
 		cachedToken, err := c.CacheHandler.GetCachedToken(ctx)
@@ -530,6 +609,55 @@ func (c *ClientCredentials) RefreshToken(ctx context.Context) error {
 	return err
 }
 
+// prefetchRetryDelay is how long StartPrefetch waits after a failed fetch
+const prefetchRetryDelay = 10 * time.Second
+
+// minPrefetchInterval is the shortest time StartPrefetch waits between
+// fetches, so tokens that arrive already near expiry don't cause a tight loop
+const minPrefetchInterval = time.Second
+
+// StartPrefetch keeps a valid token on hand in the background, fetching a
+// new one as soon as the current one comes within ExpirySkew of expiring, so
+// requests after a long idle period do not wait for the token endpoint.
+// It returns immediately; fetching stops when ctx is cancelled. Failed
+// fetches are retried every 10 seconds and reported to OnPrefetchError.
+//
+// Example:
+//
+//	auth, err := spotigo.NewClientCredentials("", "")
+//	if err != nil {
+//		return err
+//	}
+//	auth.ExpirySkew = 5 * time.Minute
+//	auth.StartPrefetch(ctx)
+func (c *ClientCredentials) StartPrefetch(ctx context.Context) {
+	go func() {
+		for {
+			wait := prefetchRetryDelay
+			if _, err := c.GetAccessToken(ctx); err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+				if c.OnPrefetchError != nil {
+					c.OnPrefetchError(err)
+				}
+			} else {
+				c.mu.Lock()
+				wait = time.Until(c.TokenInfo.Expiry()) - c.expirySkew(c.TokenInfo)
+				c.mu.Unlock()
+			}
+
+			timer := time.NewTimer(max(wait, minPrefetchInterval))
+			select {
+			case <-timer.C:
+			case <-ctx.Done():
+				timer.Stop()
+				return
+			}
+		}
+	}()
+}
+
 // SpotifyOAuth implements the Authorization Code OAuth2 flow
 type SpotifyOAuth struct {
 	*SpotifyAuthBase
@@ -697,6 +825,11 @@ func (o *SpotifyOAuth) GetAuthorizationCode(ctx context.Context, openBrowser boo
 
 // ExchangeCode exchanges authorization code for tokens
 func (o *SpotifyOAuth) ExchangeCode(ctx context.Context, code string) error {
+	// Load current credentials (supports rotation)
+	if err := o.loadCredentials(ctx); err != nil {
+		return err
+	}
+
 	data := url.Values{}
 	data.Set("grant_type", "authorization_code")
 	data.Set("code", code)
@@ -710,8 +843,15 @@ func (o *SpotifyOAuth) ExchangeCode(ctx context.Context, code string) error {
 	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 	req.Header.Set("Authorization", o.GetAuthHeader())
 
+	// Reject codes that were already exchanged
+	if err := o.ReplayGuard.Use("code", code); err != nil {
+		return err
+	}
+
 	resp, err := o.HTTPClient.Do(req)
 	if err != nil {
+		// The code never reached Spotify, so it may be retried
+		o.ReplayGuard.Forget("code", code)
 		return fmt.Errorf("failed to execute request: %w", err)
 	}
 	defer resp.Body.Close()
@@ -762,6 +902,11 @@ func (o *SpotifyOAuth) RefreshToken(ctx context.Context) error {
 		}
 	}
 
+	// Load current credentials (supports rotation)
+	if err := o.loadCredentials(ctx); err != nil {
+		return err
+	}
+
 	data := url.Values{}
 	data.Set("grant_type", "refresh_token")
 	data.Set("refresh_token", o.TokenInfo.RefreshToken)
@@ -803,11 +948,7 @@ func (o *SpotifyOAuth) RefreshToken(ctx context.Context) error {
 
 	// Update token info
 	o.TokenInfo = newTokenInfo
-
-	// Save to cache
-	if o.CacheHandler != nil {
-		_ = o.CacheHandler.SaveTokenToCache(ctx, o.TokenInfo)
-	}
+	o.tokenRefreshed(ctx, newTokenInfo)
 
 	return nil
 }
@@ -965,6 +1106,7 @@ func NewSpotifyPKCE(clientID, redirectURI, scope string) (*SpotifyPKCE, error) {
 		RedirectURI:     redirectURI,
 		HTTPClient:      newHTTPClient(5 * time.Second),
 		RequestsTimeout: 5 * time.Second,
+		ReplayGuard:     NewReplayGuard(DefaultReplayWindow),
 	}
 
 	// Normalize scope if provided
@@ -1112,6 +1254,11 @@ func (p *SpotifyPKCE) ExchangeCode(ctx context.Context, code string) error {
 		return fmt.Errorf("code verifier not set")
 	}
 
+	// Load current credentials (supports rotation)
+	if err := p.loadCredentials(ctx); err != nil {
+		return err
+	}
+
 	data := url.Values{}
 	data.Set("grant_type", "authorization_code")
 	data.Set("code", code)
@@ -1127,8 +1274,15 @@ func (p *SpotifyPKCE) ExchangeCode(ctx context.Context, code string) error {
 	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 	// No Basic auth header for PKCE
 
+	// Reject codes that were already exchanged
+	if err := p.ReplayGuard.Use("code", code); err != nil {
+		return err
+	}
+
 	resp, err := p.HTTPClient.Do(req)
 	if err != nil {
+		// The code never reached Spotify, so it may be retried
+		p.ReplayGuard.Forget("code", code)
 		return fmt.Errorf("failed to execute request: %w", err)
 	}
 	defer resp.Body.Close()
@@ -1232,6 +1386,11 @@ func (p *SpotifyPKCE) RefreshToken(ctx context.Context) error {
 		}
 	}
 
+	// Load current credentials (supports rotation)
+	if err := p.loadCredentials(ctx); err != nil {
+		return err
+	}
+
 	data := url.Values{}
 	data.Set("grant_type", "refresh_token")
 	data.Set("refresh_token", p.TokenInfo.RefreshToken)
@@ -1274,11 +1433,7 @@ func (p *SpotifyPKCE) RefreshToken(ctx context.Context) error {
 
 	// Update token info
 	p.TokenInfo = newTokenInfo
-
-	// Save to cache
-	if p.CacheHandler != nil {
-		_ = p.CacheHandler.SaveTokenToCache(ctx, p.TokenInfo)
-	}
+	p.tokenRefreshed(ctx, newTokenInfo)
 
 	return nil
 }
@@ -1404,6 +1559,7 @@ func NewSpotifyImplicitGrant(clientID, redirectURI, scope string) (*SpotifyImpli
 		RedirectURI:     redirectURI,
 		HTTPClient:      newHTTPClient(5 * time.Second),
 		RequestsTimeout: 5 * time.Second,
+		ReplayGuard:     NewReplayGuard(DefaultReplayWindow),
 	}
 
 	if scope != "" {
@@ -1491,16 +1647,9 @@ func (i *SpotifyImplicitGrant) ParseTokenFromURL(urlStr string) error {
 	scope := fragmentValues.Get("scope")
 	receivedState := fragmentValues.Get("state")
 
-	// Validate state
-	if i.State != "" && receivedState != i.State {
-		return &SpotifyStateError{
-			SpotifyOAuthError: &SpotifyOAuthError{
-				ErrorType:        "state_mismatch",
-				ErrorDescription: "State parameter mismatch",
-			},
-			LocalState:  i.State,
-			RemoteState: receivedState,
-		}
+	// Validate state and reject replayed callbacks
+	if err := i.verifyCallbackState(i.State, receivedState); err != nil {
+		return err
 	}
 
 	// Create token info
@@ -0,0 +1,161 @@
+package spotigo
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"sync"
+	"time"
+)
+
+// ============================================================================
+// OAuth Callback Replay Protection
+// ============================================================================
+
+// DefaultReplayWindow is how long used authorization codes and states are
+// remembered. Spotify authorization codes expire after 10 minutes.
+const DefaultReplayWindow = 10 * time.Minute
+
+// ReplayGuard remembers recently used authorization codes and states so a
+// callback URL submitted twice (e.g. a double click or browser refresh) is
+// rejected with a *SpotifyReplayError instead of reaching the token endpoint.
+//
+// Values are stored as SHA-256 hashes. A nil *ReplayGuard accepts everything.
+type ReplayGuard struct {
+	Window time.Duration // How long values are remembered (default: DefaultReplayWindow)
+
+	mu   sync.Mutex
+	seen map[string]time.Time
+}
+
+// NewReplayGuard creates a new replay guard
+func NewReplayGuard(window time.Duration) *ReplayGuard {
+	return &ReplayGuard{Window: window}
+}
+
+// Use records value of the given kind ("code" or "state") as used.
+// Returns a *SpotifyReplayError if it was already used within the window.
+func (g *ReplayGuard) Use(kind, value string) error {
+	if g == nil || value == "" {
+		return nil
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	now := time.Now()
+	window := g.Window
+	if window <= 0 {
+		window = DefaultReplayWindow
+	}
+
+	// Drop expired entries
+	for key, usedAt := range g.seen {
+		if now.Sub(usedAt) > window {
+			delete(g.seen, key)
+		}
+	}
+
+	key := replayKey(kind, value)
+	if _, ok := g.seen[key]; ok {
+		return &SpotifyReplayError{
+			SpotifyOAuthError: &SpotifyOAuthError{
+				ErrorType:        "replayed_" + kind,
+				ErrorDescription: "Authorization " + kind + " has already been used",
+			},
+			Kind: kind,
+		}
+	}
+
+	if g.seen == nil {
+		g.seen = make(map[string]time.Time)
+	}
+	g.seen[key] = now
+	return nil
+}
+
+// Forget removes a value so it can be used again, e.g. after a request
+// that never reached Spotify
+func (g *ReplayGuard) Forget(kind, value string) {
+	if g == nil || value == "" {
+		return
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	delete(g.seen, replayKey(kind, value))
+}
+
+// replayKey hashes a value so codes are not kept in memory in plain text
+func replayKey(kind, value string) string {
+	sum := sha256.Sum256([]byte(kind + ":" + value))
+	return hex.EncodeToString(sum[:])
+}
+
+// CheckCallbackState records the state of an OAuth callback as used and
+// rejects replays. ExchangeCallback calls it; web apps that parse the
+// redirect themselves should call it before ExchangeCode. States must be
+// unique per authorization.
+func (b *SpotifyAuthBase) CheckCallbackState(state string) error {
+	return b.ReplayGuard.Use("state", state)
+}
+
+// verifyCallbackState checks a callback's state against the state sent with
+// the authorization request (if any) and records it as used
+func (b *SpotifyAuthBase) verifyCallbackState(expected, received string) error {
+	if expected != "" && received != expected {
+		return &SpotifyStateError{
+			SpotifyOAuthError: &SpotifyOAuthError{
+				ErrorType:        "state_mismatch",
+				ErrorDescription: "State parameter mismatch",
+			},
+			LocalState:  expected,
+			RemoteState: received,
+		}
+	}
+	return b.CheckCallbackState(received)
+}
+
+// ExchangeCallback completes the authorization code flow from the URL
+// Spotify redirected the user to: it checks the state against o.State,
+// rejects a state that was already used, and exchanges the code for tokens.
+// If the exchange fails, the state is forgotten so the callback can be
+// retried; a reused code is still rejected by ExchangeCode.
+//
+// Example:
+//
+//	http.HandleFunc("/callback", func(w http.ResponseWriter, r *http.Request) {
+//		if err := auth.ExchangeCallback(r.Context(), r.URL.String()); err != nil {
+//			http.Error(w, err.Error(), http.StatusBadRequest)
+//		}
+//	})
+func (o *SpotifyOAuth) ExchangeCallback(ctx context.Context, callbackURL string) error {
+	code, state, err := ParseAuthResponseURL(callbackURL)
+	if err != nil {
+		return err
+	}
+	if err := o.verifyCallbackState(o.State, state); err != nil {
+		return err
+	}
+	if err := o.ExchangeCode(ctx, code); err != nil {
+		o.ReplayGuard.Forget("state", state)
+		return err
+	}
+	return nil
+}
+
+// ExchangeCallback completes the PKCE flow from the URL Spotify redirected
+// the user to (see SpotifyOAuth.ExchangeCallback)
+func (p *SpotifyPKCE) ExchangeCallback(ctx context.Context, callbackURL string) error {
+	code, state, err := ParseAuthResponseURL(callbackURL)
+	if err != nil {
+		return err
+	}
+	if err := p.verifyCallbackState(p.State, state); err != nil {
+		return err
+	}
+	if err := p.ExchangeCode(ctx, code); err != nil {
+		p.ReplayGuard.Forget("state", state)
+		return err
+	}
+	return nil
+}
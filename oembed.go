@@ -0,0 +1,104 @@
+package spotigo
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// ============================================================================
+// oEmbed
+// ============================================================================
+
+// oEmbedEndpoint is Spotify's oEmbed endpoint
+const oEmbedEndpoint = "https://open.spotify.com/oembed"
+
+// maxOEmbedBody caps the size of an oEmbed response, which is normally
+// under 2 KB
+const maxOEmbedBody = 64 << 10
+
+// OEmbed is the embed metadata for a Spotify item
+type OEmbed struct {
+	Type            string `json:"type"`    // Always "rich"
+	Version         string `json:"version"` // oEmbed version
+	Title           string `json:"title"`
+	HTML            string `json:"html"` // <iframe> embed player markup
+	Width           int    `json:"width"`
+	Height          int    `json:"height"`
+	IframeURL       string `json:"iframe_url"`
+	ThumbnailURL    string `json:"thumbnail_url"`
+	ThumbnailWidth  int    `json:"thumbnail_width"`
+	ThumbnailHeight int    `json:"thumbnail_height"`
+	ProviderName    string `json:"provider_name"`
+	ProviderURL     string `json:"provider_url"`
+}
+
+// OEmbed fetches embed metadata (player HTML, title, and thumbnail) for a
+// track, album, playlist, artist, show, or episode from Spotify's oEmbed
+// endpoint. The item may be given as a URL, URI, or share link. The endpoint
+// is public, so no token is sent and the request does not count against the
+// app's rate limit.
+//
+// Example:
+//
+//	embed, err := client.OEmbed(ctx, "https://open.spotify.com/track/4iV5W9uYEdYUVa79Axb7Rh")
+//	if err != nil {
+//		return err
+//	}
+//	fmt.Println(embed.Title, embed.ThumbnailURL)
+func (c *Client) OEmbed(ctx context.Context, spotifyURL string) (*OEmbed, error) {
+	link, err := c.ResolveShareLink(ctx, spotifyURL)
+	if err != nil {
+		return nil, err
+	}
+	kind, id, err := ParseAny(link)
+	if err != nil {
+		return nil, err
+	}
+
+	params := url.Values{}
+	params.Set("url", itemWebURL(kind, id))
+	endpoint := oEmbedEndpoint + "?" + params.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	if c.UserAgent != "" {
+		req.Header.Set("User-Agent", c.UserAgent)
+	}
+
+	client := c.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	limit := int64(maxOEmbedBody)
+	if c.MaxResponseBytes > 0 {
+		limit = min(limit, c.MaxResponseBytes)
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, limit+1))
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, WrapHTTPError(nil, resp.StatusCode, http.MethodGet, endpoint, body, resp.Header)
+	}
+	if int64(len(body)) > limit {
+		return nil, &ResponseTooLargeError{Limit: limit, Read: int64(len(body))}
+	}
+
+	var result OEmbed
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, WrapJSONError(err)
+	}
+
+	return &result, nil
+}
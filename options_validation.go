@@ -0,0 +1,129 @@
+package spotigo
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ============================================================================
+// Options Validation
+// ============================================================================
+
+// The Validate methods below check every field of an options struct and
+// report all problems at once, joined with errors.Join. Each problem is an
+// *InvalidOptionError, *InvalidMarketError, or similar matching
+// ErrValidation, so errors.As finds the first and errors.Is(err,
+// ErrValidation) matches any. Methods taking the options call Validate
+// before sending a request; call it directly to check configuration early.
+
+// validateListOptions checks the fields shared by paginated, market-aware
+// options
+func validateListOptions(limit, offset int, market string) error {
+	return errors.Join(validatePaginationParams(limit, offset), validateMarketParameter(market))
+}
+
+// Validate reports all invalid fields of the search options. A nil
+// receiver is valid.
+//
+// Example:
+//
+//	opts := &spotigo.SearchOptions{Market: "XX", Limit: -1}
+//	if err := opts.Validate(); err != nil {
+//		fmt.Println(err)
+//		// limit must be non-negative, got -1
+//		// invalid country code: XX
+//	}
+func (o *SearchOptions) Validate() error {
+	if o == nil {
+		return nil
+	}
+	errs := []error{validateListOptions(o.Limit, o.Offset, o.Market)}
+	for _, t := range o.Types {
+		if err := t.Validate(); err != nil {
+			errs = append(errs, &InvalidOptionError{Field: "type", Reason: fmt.Sprintf("must be a search type, got %q", string(t))})
+		}
+	}
+	if o.IncludeExternal != "" && o.IncludeExternal != "audio" {
+		errs = append(errs, &InvalidOptionError{Field: "include_external", Reason: fmt.Sprintf("must be \"audio\", got %q", o.IncludeExternal)})
+	}
+	return errors.Join(errs...)
+}
+
+// Validate reports all invalid fields of the playlist tracks options. A nil
+// receiver is valid.
+func (o *PlaylistTracksOptions) Validate() error {
+	if o == nil {
+		return nil
+	}
+	errs := []error{validateListOptions(o.Limit, o.Offset, o.Market)}
+	if o.AdditionalTypes != "" {
+		for _, t := range strings.Split(o.AdditionalTypes, ",") {
+			if t = strings.TrimSpace(t); t != "track" && t != "episode" {
+				errs = append(errs, &InvalidOptionError{Field: "additional_types", Reason: fmt.Sprintf("may only contain track and episode, got %q", t)})
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Validate reports all invalid fields of the album tracks options. A nil
+// receiver is valid.
+func (o *AlbumTracksOptions) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return validateListOptions(o.Limit, o.Offset, o.Market)
+}
+
+// Validate reports all invalid fields of the show episodes options. A nil
+// receiver is valid.
+func (o *ShowEpisodesOptions) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return validateListOptions(o.Limit, o.Offset, o.Market)
+}
+
+// Validate reports all invalid fields of the saved tracks options. A nil
+// receiver is valid.
+func (o *SavedTracksOptions) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return validateListOptions(o.Limit, o.Offset, o.Market)
+}
+
+// Validate reports all invalid fields of the saved episodes options. A nil
+// receiver is valid.
+func (o *SavedEpisodesOptions) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return validateListOptions(o.Limit, o.Offset, o.Market)
+}
+
+// Validate reports all invalid fields of the audiobook chapters options. A
+// nil receiver is valid.
+func (o *AudiobookChaptersOptions) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return validateListOptions(o.Limit, o.Offset, o.Market)
+}
+
+// Validate reports all invalid fields of the top items options. A nil
+// receiver is valid.
+func (o *TopItemsOptions) Validate() error {
+	if o == nil {
+		return nil
+	}
+	errs := []error{validatePaginationParams(o.Limit, o.Offset)}
+	if o.Offset > TopItemsMaxOffset {
+		errs = append(errs, &InvalidOptionError{Field: "offset", Reason: fmt.Sprintf("must be at most %d for top items, got %d", TopItemsMaxOffset, o.Offset)})
+	}
+	if _, err := o.timeRange(); err != nil {
+		errs = append(errs, &InvalidOptionError{Field: "time range", Reason: fmt.Sprintf("must be short_term, medium_term, or long_term, got %q", o.rangeValue())})
+	}
+	return errors.Join(errs...)
+}
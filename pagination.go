@@ -0,0 +1,471 @@
+package spotigo
+
+import (
+	"context"
+	"encoding/json"
+	"iter"
+	"time"
+)
+
+// ============================================================================
+// Pagination Helpers
+// ============================================================================
+
+// CurrentUserFollowedArtistsAll retrieves every artist the current user follows.
+//
+// It follows the After cursor until the list is exhausted. opts.Limit controls
+// the page size (default: 50); opts.After can be used to resume from a cursor.
+// For very large follow lists, use CurrentUserFollowedArtistsIter to avoid
+// holding every artist in memory.
+//
+// Example:
+//
+//	artists, err := client.CurrentUserFollowedArtistsAll(ctx, nil)
+func (c *Client) CurrentUserFollowedArtistsAll(ctx context.Context, opts *FollowedArtistsOptions) ([]Artist, error) {
+	var artists []Artist
+	for artist, err := range c.CurrentUserFollowedArtistsIter(ctx, opts) {
+		if err != nil {
+			return nil, err
+		}
+		artists = append(artists, artist)
+	}
+	return artists, nil
+}
+
+// CurrentUserFollowedArtistsIter returns an iterator over every artist the
+// current user follows, fetching pages lazily as the loop advances.
+//
+// Iteration stops after the first error, which is yielded with a zero Artist.
+//
+// Example:
+//
+//	for artist, err := range client.CurrentUserFollowedArtistsIter(ctx, nil) {
+//		if err != nil {
+//			return err
+//		}
+//		fmt.Println(artist.Name)
+//	}
+func (c *Client) CurrentUserFollowedArtistsIter(ctx context.Context, opts *FollowedArtistsOptions) iter.Seq2[Artist, error] {
+	pageOpts := FollowedArtistsOptions{Type: "artist", Limit: 50}
+	if opts != nil {
+		if opts.Limit > 0 {
+			pageOpts.Limit = opts.Limit
+		}
+		pageOpts.After = opts.After
+	}
+
+	return func(yield func(Artist, error) bool) {
+		first, err := c.CurrentUserFollowedArtists(ctx, &pageOpts)
+		if err != nil {
+			yield(Artist{}, err)
+			return
+		}
+		for artist, err := range IterateCursor(c, ctx, first) {
+			if !yield(artist, err) {
+				return
+			}
+		}
+	}
+}
+
+// UserPlaylistsAll retrieves every public playlist owned or followed by a user.
+//
+// It follows the Next links until the list is exhausted. opts.Limit controls
+// the page size (default: 50); opts.Offset sets where to start.
+//
+// Example:
+//
+//	playlists, err := client.UserPlaylistsAll(ctx, "spotify", nil)
+func (c *Client) UserPlaylistsAll(ctx context.Context, userID string, opts *UserPlaylistsOptions) ([]SimplifiedPlaylist, error) {
+	pageOpts := UserPlaylistsOptions{Limit: 50}
+	if opts != nil {
+		if opts.Limit > 0 {
+			pageOpts.Limit = opts.Limit
+		}
+		pageOpts.Offset = opts.Offset
+	}
+
+	first, err := c.UserPlaylists(ctx, userID, &pageOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	var playlists []SimplifiedPlaylist
+	for playlist, err := range IteratePages(c, ctx, first) {
+		if err != nil {
+			return nil, err
+		}
+		playlists = append(playlists, playlist)
+	}
+	return playlists, nil
+}
+
+// CurrentUserTopTracksAll retrieves the current user's top tracks up to
+// Spotify's cap of 99, paging so that no request goes past
+// TopItemsMaxOffset. opts.Range sets the time range and opts.Offset where to
+// start; opts.Limit is ignored.
+//
+// Example:
+//
+//	tracks, err := client.CurrentUserTopTracksAll(ctx, &spotigo.TopItemsOptions{Range: spotigo.TimeRangeLong})
+func (c *Client) CurrentUserTopTracksAll(ctx context.Context, opts *TopItemsOptions) ([]Track, error) {
+	return topItemsAll(ctx, opts, c.CurrentUserTopTracks)
+}
+
+// CurrentUserTopArtistsAll retrieves the current user's top artists up to
+// Spotify's cap of 99 (see CurrentUserTopTracksAll)
+func (c *Client) CurrentUserTopArtistsAll(ctx context.Context, opts *TopItemsOptions) ([]Artist, error) {
+	return topItemsAll(ctx, opts, c.CurrentUserTopArtists)
+}
+
+// topItemsAll pages through top items with fetch. Pages before
+// TopItemsMaxOffset end there, so the last request starts at it and
+// returns the last items Spotify serves.
+func topItemsAll[T any](ctx context.Context, opts *TopItemsOptions, fetch func(context.Context, *TopItemsOptions) (*Paging[T], error)) ([]T, error) {
+	var pageOpts TopItemsOptions
+	if opts != nil {
+		pageOpts = *opts
+	}
+
+	var items []T
+	for {
+		pageOpts.Limit = 50
+		if pageOpts.Offset < TopItemsMaxOffset {
+			pageOpts.Limit = min(pageOpts.Limit, TopItemsMaxOffset-pageOpts.Offset)
+		}
+
+		page, err := fetch(ctx, &pageOpts)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, page.Items...)
+
+		pageOpts.Offset += len(page.Items)
+		if pageOpts.Offset > TopItemsMaxOffset || len(page.Items) < pageOpts.Limit || pageOpts.Offset >= page.Total {
+			return items, nil
+		}
+	}
+}
+
+// CurrentUserRecentlyPlayedIter returns an iterator over the current user's
+// play history, newest first, following the before cursor until Spotify
+// stops returning pages (Spotify keeps roughly the last 50 plays).
+//
+// Example:
+//
+//	for item, err := range client.CurrentUserRecentlyPlayedIter(ctx, nil) {
+//		if err != nil {
+//			return err
+//		}
+//		fmt.Println(item.PlayedAt, item.Track.Name)
+//	}
+func (c *Client) CurrentUserRecentlyPlayedIter(ctx context.Context, opts *RecentlyPlayedOptions) iter.Seq2[PlayHistoryItem, error] {
+	pageOpts := RecentlyPlayedOptions{Limit: 50}
+	if opts != nil {
+		pageOpts = *opts
+		if pageOpts.Limit == 0 {
+			pageOpts.Limit = 50
+		}
+	}
+
+	return func(yield func(PlayHistoryItem, error) bool) {
+		first, err := c.CurrentUserRecentlyPlayed(ctx, &pageOpts)
+		if err != nil {
+			yield(PlayHistoryItem{}, err)
+			return
+		}
+		for item, err := range IterateCursor(c, ctx, first) {
+			if !yield(item, err) {
+				return
+			}
+		}
+	}
+}
+
+// IteratePages returns an iterator over every item of an offset-paginated
+// result, starting with the items in page and fetching Next pages lazily
+// until the results are exhausted.
+//
+// Iteration stops after the first error, which is yielded with a zero T.
+//
+// Example:
+//
+//	page, err := client.PlaylistTracks(ctx, playlistID, nil)
+//	if err != nil {
+//		return err
+//	}
+//	for item, err := range spotigo.IteratePages(client, ctx, page) {
+//		if err != nil {
+//			return err
+//		}
+//		fmt.Println(item.AddedAt)
+//	}
+func IteratePages[T any](c *Client, ctx context.Context, page *Paging[T]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		progress := newPageProgress(ctx)
+		next := func(ctx context.Context, page *Paging[T]) (*Paging[T], error) {
+			return NextGeneric[T](c, ctx, page)
+		}
+		for page, err := range pageSequence(ctx, page, next) {
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+			progress.page(c, page.Offset, len(page.Items), page.Total, page.Limit)
+			for _, item := range page.Items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// IterateCursor returns an iterator over every item of a cursor-paginated
+// result, starting with the items in page and fetching Next pages lazily
+// until the results are exhausted.
+//
+// Pages wrapped in a single-key envelope (such as {"artists": {...}} from
+// the followed artists endpoint) are unwrapped automatically. Iteration stops
+// after the first error, which is yielded with a zero T.
+//
+// Example:
+//
+//	page, err := client.CurrentUserRecentlyPlayed(ctx, nil)
+//	if err != nil {
+//		return err
+//	}
+//	for item, err := range spotigo.IterateCursor(client, ctx, page) {
+//		if err != nil {
+//			return err
+//		}
+//		fmt.Println(item.Track.Name)
+//	}
+func IterateCursor[T any](c *Client, ctx context.Context, page *CursorPaging[T]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		progress := newPageProgress(ctx)
+		next := func(ctx context.Context, page *CursorPaging[T]) (*CursorPaging[T], error) {
+			if page.Next == nil || *page.Next == "" || len(page.Items) == 0 {
+				return nil, nil
+			}
+			return fetchCursorPage[T](c, ctx, *page.Next)
+		}
+		for page, err := range pageSequence(ctx, page, next) {
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+			progress.page(c, -1, len(page.Items), page.Total, page.Limit)
+			for _, item := range page.Items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// readAheadKey is the context key for the read-ahead depth
+type readAheadKey struct{}
+
+// ContextWithReadAhead returns a context that makes the pagination helpers
+// (IteratePages, IterateCursor, and the *All and *Iter methods) fetch up to
+// depth pages in the background while the caller consumes the current one,
+// hiding request latency on long lists. Pages fetched ahead are discarded
+// if the caller stops early. A depth of 0 fetches each page on demand
+// (default).
+//
+// Example:
+//
+//	ctx = spotigo.ContextWithReadAhead(ctx, 2)
+//	for item, err := range spotigo.IteratePages(client, ctx, page) {
+//		// The next two pages load while this one renders
+//	}
+func ContextWithReadAhead(ctx context.Context, depth int) context.Context {
+	return context.WithValue(ctx, readAheadKey{}, depth)
+}
+
+// pageResult is a page fetched ahead, or the error that ended fetching
+type pageResult[P any] struct {
+	page *P
+	err  error
+}
+
+// pageSequence returns the pages starting at first, fetching each following
+// page with next until it returns nil. An error ends the sequence. With a
+// read-ahead depth in ctx, pages are fetched in the background.
+func pageSequence[P any](ctx context.Context, first *P, next func(context.Context, *P) (*P, error)) iter.Seq2[*P, error] {
+	return func(yield func(*P, error) bool) {
+		if first == nil {
+			return
+		}
+
+		depth, _ := ctx.Value(readAheadKey{}).(int)
+		if depth <= 0 {
+			for page := first; page != nil; {
+				if !yield(page, nil) {
+					return
+				}
+				var err error
+				if page, err = next(ctx, page); err != nil {
+					yield(nil, err)
+					return
+				}
+			}
+			return
+		}
+
+		// Stop fetching when the caller stops iterating
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		results := make(chan pageResult[P], depth)
+		go func() {
+			defer close(results)
+			page := first
+			for {
+				var err error
+				page, err = next(ctx, page)
+				if page == nil && err == nil {
+					return
+				}
+				select {
+				case results <- pageResult[P]{page: page, err: err}:
+				case <-ctx.Done():
+					return
+				}
+				if err != nil {
+					return
+				}
+			}
+		}()
+
+		if !yield(first, nil) {
+			return
+		}
+		for result := range results {
+			if !yield(result.page, result.err) || result.err != nil {
+				return
+			}
+		}
+	}
+}
+
+// fetchCursorPage fetches a cursor page by URL, unwrapping single-key
+// envelopes like {"artists": {...}}
+func fetchCursorPage[T any](c *Client, ctx context.Context, pageURL string) (*CursorPaging[T], error) {
+	var raw map[string]json.RawMessage
+	if err := c._get(ctx, pageURL, nil, &raw); err != nil {
+		return nil, err
+	}
+
+	body := json.RawMessage(nil)
+	if _, ok := raw["items"]; !ok && len(raw) == 1 {
+		for _, inner := range raw {
+			body = inner
+		}
+	}
+	if body == nil {
+		data, err := json.Marshal(raw)
+		if err != nil {
+			return nil, WrapJSONError(err)
+		}
+		body = data
+	}
+
+	var page CursorPaging[T]
+	if err := json.Unmarshal(body, &page); err != nil {
+		return nil, WrapJSONError(err)
+	}
+	return &page, nil
+}
+
+// PaginationProgress reports how far a paginated fetch has got
+type PaginationProgress struct {
+	Fetched int           // Items fetched so far
+	Total   int           // Total items reported by Spotify (0 if unknown)
+	Offset  int           // Offset of the latest page (-1 for cursor-paginated results)
+	Pages   int           // Pages fetched so far
+	Elapsed time.Duration // Time since iteration started
+	ETA     time.Duration // Estimated time left, including rate limit waits (0 if unknown)
+}
+
+// paginationProgressKey is the context key for the progress callback
+type paginationProgressKey struct{}
+
+// ContextWithPaginationProgress returns a context that makes the pagination
+// helpers (IteratePages, IterateCursor, and the *All and *Iter methods) call
+// fn after each page is fetched, so long exports can render progress.
+//
+// The ETA is based on the time taken by the pages fetched so far and on the
+// rate limit budget: if the remaining pages exceed it, the wait until the
+// window resets is included. It is 0 until a second page has been fetched.
+//
+// Example:
+//
+//	ctx = spotigo.ContextWithPaginationProgress(ctx, func(p spotigo.PaginationProgress) {
+//		fmt.Fprintf(os.Stderr, "\r%d/%d (ETA %s)", p.Fetched, p.Total, p.ETA.Round(time.Second))
+//	})
+//	playlists, err := client.UserPlaylistsAll(ctx, "spotify", nil)
+func ContextWithPaginationProgress(ctx context.Context, fn func(PaginationProgress)) context.Context {
+	return context.WithValue(ctx, paginationProgressKey{}, fn)
+}
+
+// pageProgress accumulates progress for one iteration
+type pageProgress struct {
+	fn      func(PaginationProgress)
+	start   time.Time
+	fetched int
+	pages   int
+}
+
+// newPageProgress returns a progress tracker for ctx, or nil if ctx has no
+// progress callback
+func newPageProgress(ctx context.Context) *pageProgress {
+	fn, ok := ctx.Value(paginationProgressKey{}).(func(PaginationProgress))
+	if !ok || fn == nil {
+		return nil
+	}
+	return &pageProgress{fn: fn, start: time.Now()}
+}
+
+// page records a fetched page and reports progress
+func (p *pageProgress) page(c *Client, offset, items, total, limit int) {
+	if p == nil {
+		return
+	}
+	p.fetched += items
+	p.pages++
+
+	// Offset pages may start past the beginning of the results
+	position := p.fetched
+	if offset >= 0 {
+		position = offset + items
+	}
+
+	progress := PaginationProgress{
+		Fetched: p.fetched,
+		Total:   total,
+		Offset:  offset,
+		Pages:   p.pages,
+		Elapsed: time.Since(p.start),
+	}
+
+	// The first page was fetched before iteration started, so its timing is
+	// unknown; estimate from the pages fetched since
+	if p.pages > 1 && total > position {
+		pageSize := max(limit, items, 1)
+		pagesLeft := (total - position + pageSize - 1) / pageSize
+		progress.ETA = progress.Elapsed / time.Duration(p.pages-1) * time.Duration(pagesLeft)
+
+		if state := c.RateLimitState(); state.Known && pagesLeft > state.Remaining-c.RateLimitReserve {
+			if wait := time.Until(state.Reset); wait > 0 {
+				progress.ETA += wait
+			}
+		}
+	}
+
+	p.fn(progress)
+}
@@ -0,0 +1,124 @@
+package spotigo
+
+import (
+	"context"
+	"strings"
+)
+
+// ============================================================================
+// Playback Context Expansion
+// ============================================================================
+
+// playbackContextFields limits expanded playlists to their details, leaving
+// out the first page of items
+const playbackContextFields = "collaborative,description,external_urls,followers,href,id,images,name,owner,public,snapshot_id,type,uri"
+
+// ExpandedContext is the full object playback is coming from. Type says
+// which field is set; for contexts without an object to fetch, such as the
+// user's Liked Songs ("collection"), only Type and URI are set.
+type ExpandedContext struct {
+	Type      string // "playlist", "album", "artist", "show", "audiobook", or another context type
+	URI       string
+	Playlist  *Playlist
+	Album     *Album
+	Artist    *Artist
+	Show      *Show
+	Audiobook *Audiobook
+}
+
+// Name returns the context's display name, e.g. the playlist or album name.
+// It is "Liked Songs" for the user's collection and "" for unknown types.
+func (e *ExpandedContext) Name() string {
+	switch {
+	case e.Playlist != nil:
+		return e.Playlist.Name
+	case e.Album != nil:
+		return e.Album.Name
+	case e.Artist != nil:
+		return e.Artist.Name
+	case e.Show != nil:
+		return e.Show.Name
+	case e.Audiobook != nil:
+		return e.Audiobook.Name
+	case e.Type == "collection":
+		return "Liked Songs"
+	}
+	return ""
+}
+
+// Images returns the context's cover images, if it has any
+func (e *ExpandedContext) Images() []Image {
+	switch {
+	case e.Playlist != nil:
+		return e.Playlist.Images
+	case e.Album != nil:
+		return e.Album.Images
+	case e.Artist != nil:
+		return e.Artist.Images
+	case e.Show != nil:
+		return e.Show.Images
+	case e.Audiobook != nil:
+		return e.Audiobook.Images
+	}
+	return nil
+}
+
+// ExpandContext fetches the playlist, album, artist, show, or audiobook that
+// state is playing from. Returns nil if state has no context, as when single
+// tracks were queued. Playlists are fetched without their items.
+//
+// Example:
+//
+//	state, err := client.CurrentUserPlaybackState(ctx, nil)
+//	if err != nil {
+//		return err
+//	}
+//	from, err := client.ExpandContext(ctx, state)
+//	if err != nil {
+//		return err
+//	}
+//	if from != nil {
+//		fmt.Printf("Playing from %s %q\n", from.Type, from.Name())
+//	}
+func (c *Client) ExpandContext(ctx context.Context, state *PlaybackState) (*ExpandedContext, error) {
+	if state == nil || state.Context == nil || state.Context.URI == "" {
+		return nil, nil
+	}
+
+	uri := state.Context.URI
+	expanded := &ExpandedContext{Type: state.Context.Type, URI: uri}
+	if expanded.Type == "" || strings.HasSuffix(uri, ":collection") {
+		expanded.Type = contextTypeOf(uri)
+	}
+
+	var err error
+	switch expanded.Type {
+	case "playlist":
+		expanded.Playlist, err = c.Playlist(ctx, uri, &PlaylistOptions{Fields: playbackContextFields})
+	case "album":
+		expanded.Album, err = c.Album(ctx, uri)
+	case "artist":
+		expanded.Artist, err = c.Artist(ctx, uri)
+	case "show":
+		expanded.Show, err = c.Show(ctx, uri)
+	case "audiobook":
+		expanded.Audiobook, err = c.GetAudiobook(ctx, uri)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return expanded, nil
+}
+
+// contextTypeOf returns the context type of a URI, e.g. "playlist" for
+// spotify:user:name:playlist:ID and "collection" for spotify:user:name:collection
+func contextTypeOf(uri string) string {
+	if strings.HasSuffix(uri, ":collection") {
+		return "collection"
+	}
+	if kind, _, err := ParseAny(uri); err == nil {
+		return kind
+	}
+	return ""
+}
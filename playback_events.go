@@ -0,0 +1,339 @@
+package spotigo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// ============================================================================
+// Playback Events
+// ============================================================================
+
+// seekTolerance is how far the playback position may drift from the position
+// expected from elapsed time before the change is reported as a seek
+const seekTolerance = 2 * time.Second
+
+// PlaybackEvent describes a change in playback state observed by WatchPlayback
+type PlaybackEvent struct {
+	State          *PlaybackState `json:"state"`           // Playback state after the change; nil when nothing is playing
+	ItemChanged    bool           `json:"item_changed"`    // A different track or episode is playing
+	PlayingChanged bool           `json:"playing_changed"` // Playback was paused or resumed
+	DeviceChanged  bool           `json:"device_changed"`  // Playback moved to another device
+	ModeChanged    bool           `json:"mode_changed"`    // Shuffle or repeat changed
+	Seeked         bool           `json:"seeked"`          // The position jumped within the same item
+	Err            error          `json:"-"`               // Set if polling failed; other fields are empty
+}
+
+// DefaultPlaybackPollInterval is the polling interval of WatchPlayback and
+// PlaybackEventsHandler when interval is not positive
+const DefaultPlaybackPollInterval = 5 * time.Second
+
+// WatchPlayback polls the user's playback state every interval (default:
+// DefaultPlaybackPollInterval) and sends an event whenever the playing item,
+// play/pause state, device, shuffle or repeat mode changes, or the position
+// jumps. Ordinary progress is not reported. The first successful poll is
+// always reported.
+//
+// Polling errors are sent as events with Err set and do not stop the
+// watcher. The returned channel is closed when ctx is cancelled.
+//
+// Example:
+//
+//	for event := range client.WatchPlayback(ctx, 5*time.Second) {
+//		if event.Err != nil {
+//			log.Printf("playback poll failed: %v", event.Err)
+//			continue
+//		}
+//		if event.ItemChanged {
+//			fmt.Println("now playing something else")
+//		}
+//	}
+func (c *Client) WatchPlayback(ctx context.Context, interval time.Duration) <-chan PlaybackEvent {
+	if interval <= 0 {
+		interval = DefaultPlaybackPollInterval
+	}
+	events := make(chan PlaybackEvent)
+
+	go func() {
+		defer close(events)
+
+		var previous *PlaybackState
+		var previousAt time.Time
+		first := true
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			state, err := c.CurrentUserPlaybackState(ctx, &CurrentlyPlayingOptions{AdditionalTypes: "track,episode"})
+			now := time.Now()
+			var event PlaybackEvent
+			send := false
+			if err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+				event = PlaybackEvent{Err: err}
+				send = true
+			} else {
+				if state != nil && state.Device == nil && state.Item == nil {
+					state = nil // Nothing is playing
+				}
+				event = diffPlayback(previous, state, now.Sub(previousAt))
+				send = first || event.ItemChanged || event.PlayingChanged || event.DeviceChanged ||
+					event.ModeChanged || event.Seeked
+				previous = state
+				previousAt = now
+				first = false
+			}
+
+			if send {
+				select {
+				case events <- event:
+				case <-ctx.Done():
+					return
+				}
+			}
+
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return events
+}
+
+// diffPlayback compares two playback states taken elapsed apart
+func diffPlayback(previous, current *PlaybackState, elapsed time.Duration) PlaybackEvent {
+	event := PlaybackEvent{State: current}
+	if previous == nil || current == nil {
+		event.ItemChanged = (previous == nil) != (current == nil)
+		return event
+	}
+
+	event.ItemChanged = playbackItemID(previous.Item) != playbackItemID(current.Item)
+	event.PlayingChanged = previous.IsPlaying != current.IsPlaying
+	event.DeviceChanged = playbackDeviceID(previous) != playbackDeviceID(current)
+	event.ModeChanged = previous.ShuffleState != current.ShuffleState || previous.RepeatState != current.RepeatState
+
+	if !event.ItemChanged {
+		expected := previous.ProgressMs
+		if previous.IsPlaying {
+			expected += int(elapsed.Milliseconds())
+		}
+		drift := time.Duration(current.ProgressMs-expected) * time.Millisecond
+		event.Seeked = drift > seekTolerance || drift < -seekTolerance
+	}
+
+	return event
+}
+
+// playbackDeviceID returns the ID of the device in a playback state, or ""
+func playbackDeviceID(state *PlaybackState) string {
+	if state.Device == nil || state.Device.ID == nil {
+		return ""
+	}
+	return *state.Device.ID
+}
+
+// playbackItemID returns the ID of a playback state item (track or episode),
+// falling back to its URI for local tracks, or "" if unknown
+func playbackItemID(item interface{}) string {
+	fields := playbackItemFieldsOf(item)
+	if fields.ID != "" {
+		return fields.ID
+	}
+	return fields.URI
+}
+
+// playbackItemFields are the fields tracks and episodes have in common
+type playbackItemFields struct {
+	ID         string `json:"id"`
+	URI        string `json:"uri"`
+	DurationMs int    `json:"duration_ms"`
+	LinkedFrom *struct {
+		URI string `json:"uri"`
+	} `json:"linked_from"` // Tracks relinked for the user's market
+}
+
+// is reports whether the item is uri, or was relinked from it
+func (f playbackItemFields) is(uri string) bool {
+	return f.URI == uri || (f.LinkedFrom != nil && f.LinkedFrom.URI == uri)
+}
+
+// playbackItemFieldsOf extracts the common fields of a playback state item,
+// which is decoded untyped
+func playbackItemFieldsOf(item interface{}) playbackItemFields {
+	var fields playbackItemFields
+	if item == nil {
+		return fields
+	}
+	data, err := json.Marshal(item)
+	if err != nil {
+		return fields
+	}
+	json.Unmarshal(data, &fields)
+	return fields
+}
+
+// sseKeepAlive is how often an idle event stream sends a comment line so
+// proxies do not close the connection
+const sseKeepAlive = 15 * time.Second
+
+// PlaybackEventsHandler serves playback events as Server-Sent Events.
+//
+// All connected subscribers share one WatchPlayback poller, which starts
+// with the first subscriber and stops when the last one disconnects, so API
+// usage does not grow with the number of browser tabs. Each event is sent as
+// "event: playback" with the PlaybackEvent as JSON data, and polling errors
+// as "event: error" with {"error": "..."}. New subscribers immediately
+// receive the latest event.
+type PlaybackEventsHandler struct {
+	client   *Client
+	interval time.Duration
+
+	mu          sync.Mutex
+	subscribers map[chan []byte]struct{}
+	last        []byte
+	cancel      context.CancelFunc
+}
+
+// PlaybackEventsHandler returns an http.Handler that streams playback
+// events polled every interval (default: DefaultPlaybackPollInterval) to
+// browsers as Server-Sent Events.
+//
+// Example:
+//
+//	http.Handle("/now-playing", client.PlaybackEventsHandler(5*time.Second))
+//
+//	// In the browser:
+//	// new EventSource("/now-playing").addEventListener("playback", e => {
+//	//	const event = JSON.parse(e.data)
+//	// })
+func (c *Client) PlaybackEventsHandler(interval time.Duration) *PlaybackEventsHandler {
+	return &PlaybackEventsHandler{
+		client:      c,
+		interval:    interval,
+		subscribers: make(map[chan []byte]struct{}),
+	}
+}
+
+// ServeHTTP streams events until the client disconnects
+func (h *PlaybackEventsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming not supported", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	messages, last := h.subscribe()
+	defer h.unsubscribe(messages)
+
+	if last != nil {
+		if _, err := w.Write(last); err != nil {
+			return
+		}
+		flusher.Flush()
+	}
+
+	keepAlive := time.NewTicker(sseKeepAlive)
+	defer keepAlive.Stop()
+
+	for {
+		select {
+		case message := <-messages:
+			if _, err := w.Write(message); err != nil {
+				return
+			}
+		case <-keepAlive.C:
+			if _, err := fmt.Fprint(w, ": keep-alive\n\n"); err != nil {
+				return
+			}
+		case <-r.Context().Done():
+			return
+		}
+		flusher.Flush()
+	}
+}
+
+// subscribe registers a subscriber, starting the poller if it is the first,
+// and returns its message channel and the latest message
+func (h *PlaybackEventsHandler) subscribe() (chan []byte, []byte) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	messages := make(chan []byte, 8)
+	h.subscribers[messages] = struct{}{}
+	if h.cancel == nil {
+		ctx, cancel := context.WithCancel(context.Background())
+		h.cancel = cancel
+		go h.broadcast(ctx, h.client.WatchPlayback(ctx, h.interval))
+	}
+	return messages, h.last
+}
+
+// unsubscribe removes a subscriber, stopping the poller if it was the last
+func (h *PlaybackEventsHandler) unsubscribe(messages chan []byte) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	delete(h.subscribers, messages)
+	if len(h.subscribers) == 0 && h.cancel != nil {
+		h.cancel()
+		h.cancel = nil
+		h.last = nil
+	}
+}
+
+// broadcast formats watcher events and fans them out to subscribers.
+// Subscribers that fall behind miss intermediate events; each event carries
+// the full state, so they catch up with the next one.
+func (h *PlaybackEventsHandler) broadcast(ctx context.Context, events <-chan PlaybackEvent) {
+	for event := range events {
+		message, err := formatPlaybackEvent(event)
+		if err != nil {
+			continue
+		}
+
+		h.mu.Lock()
+		if ctx.Err() == nil {
+			h.last = message
+			for messages := range h.subscribers {
+				select {
+				case messages <- message:
+				default:
+				}
+			}
+		}
+		h.mu.Unlock()
+	}
+}
+
+// formatPlaybackEvent encodes an event as a Server-Sent Events message
+func formatPlaybackEvent(event PlaybackEvent) ([]byte, error) {
+	name := "playback"
+	var data []byte
+	var err error
+	if event.Err != nil {
+		name = "error"
+		data, err = json.Marshal(map[string]string{"error": event.Err.Error()})
+	} else {
+		data, err = json.Marshal(event)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return []byte(fmt.Sprintf("event: %s\ndata: %s\n\n", name, data)), nil
+}
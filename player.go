@@ -0,0 +1,286 @@
+package spotigo
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"strings"
+)
+
+// ============================================================================
+// Playback Helpers
+// ============================================================================
+
+// PlayTracks starts playback of one or more tracks or episodes.
+//
+// Each item can be a Spotify URI, URL, or raw ID. Raw IDs are treated as
+// tracks. Playback starts on the user's currently active device.
+//
+// Example:
+//
+//	err := client.PlayTracks(ctx, "6b2oQwSGFkzsMtQruIWm2p", "spotify:track:0Svkvt5I79wficMFgaqEQJ")
+func (c *Client) PlayTracks(ctx context.Context, ids ...string) error {
+	if len(ids) == 0 {
+		return &MissingOptionError{Field: "ids"}
+	}
+
+	uris := make([]string, len(ids))
+	for i, id := range ids {
+		uri, err := ItemURI(id)
+		if err != nil {
+			return err
+		}
+		uris[i] = uri
+	}
+
+	return c.CurrentUserStartPlayback(ctx, &StartPlaybackOptions{
+		URIs: uris,
+	})
+}
+
+// PlayContext starts playback of a context (album, playlist, or show) at a
+// zero-based position within that context.
+//
+// contextURI can be a Spotify URI or URL. Artist contexts do not support an
+// offset, so offsetPosition must be 0 for them.
+//
+// Example:
+//
+//	// Start the third track of an album
+//	err := client.PlayContext(ctx, "spotify:album:04xe676vyiTeYNXw15o9jT", 2)
+func (c *Client) PlayContext(ctx context.Context, contextURI string, offsetPosition int) error {
+	uri, err := toContextURI(contextURI)
+	if err != nil {
+		return err
+	}
+
+	if offsetPosition < 0 {
+		return &InvalidOptionError{Field: "offset position", Reason: fmt.Sprintf("must be non-negative, got %d", offsetPosition)}
+	}
+
+	opts := &StartPlaybackOptions{
+		ContextURI: uri,
+	}
+	if offsetPosition > 0 {
+		if strings.HasPrefix(uri, "spotify:artist:") {
+			return &InvalidOptionError{Field: "offset", Reason: "is not supported for artist contexts, got " + uri}
+		}
+		opts.Offset = map[string]interface{}{"position": offsetPosition}
+	}
+
+	return c.CurrentUserStartPlayback(ctx, opts)
+}
+
+// PlayContextFromURI starts playback of a context (album or playlist)
+// beginning at a specific track within it.
+//
+// contextURI and trackURI can be Spotify URIs or URLs. trackURI can also be
+// a raw track ID.
+//
+// Example:
+//
+//	err := client.PlayContextFromURI(ctx,
+//		"spotify:playlist:2oCEWyyAPbZp9xhVSxZavx",
+//		"spotify:track:6b2oQwSGFkzsMtQruIWm2p",
+//	)
+func (c *Client) PlayContextFromURI(ctx context.Context, contextURI, trackURI string) error {
+	ctxURI, err := toContextURI(contextURI)
+	if err != nil {
+		return err
+	}
+	if strings.HasPrefix(ctxURI, "spotify:artist:") {
+		return &InvalidOptionError{Field: "offset", Reason: "is not supported for artist contexts, got " + ctxURI}
+	}
+
+	itemURI, err := ItemURI(trackURI)
+	if err != nil {
+		return err
+	}
+
+	return c.CurrentUserStartPlayback(ctx, &StartPlaybackOptions{
+		ContextURI: ctxURI,
+		Offset:     map[string]interface{}{"uri": itemURI},
+	})
+}
+
+// AddContextToQueueOptions holds options for CurrentUserAddContextToQueue
+type AddContextToQueueOptions struct {
+	DeviceID string // Device ID
+	Market   string // ISO 3166-1 alpha-2 country code for track relinking
+	Shuffle  bool   // Enqueue the items in random order
+	Limit    int    // Maximum number of items to enqueue (0 = all)
+}
+
+// CurrentUserAddContextToQueue adds every track of an album or every item
+// of a playlist to the user's playback queue, in order. Returns the number
+// of items queued.
+//
+// The queue endpoint accepts one item per request, so large contexts take
+// one request per item; use opts.Limit to cap them. Local files and
+// unavailable playlist items are skipped. If enqueuing fails part way, the
+// returned count tells how many items were queued.
+//
+// Example:
+//
+//	// Queue 20 random tracks from a playlist
+//	n, err := client.CurrentUserAddContextToQueue(ctx, "spotify:playlist:37i9dQZF1DXcBWIGoYBM5M",
+//		&spotigo.AddContextToQueueOptions{Shuffle: true, Limit: 20})
+func (c *Client) CurrentUserAddContextToQueue(ctx context.Context, contextURI string, opts *AddContextToQueueOptions) (int, error) {
+	uri, err := toContextURI(contextURI)
+	if err != nil {
+		return 0, err
+	}
+
+	var queueOpts AddContextToQueueOptions
+	if opts != nil {
+		queueOpts = *opts
+	}
+	if queueOpts.Limit < 0 {
+		return 0, &InvalidOptionError{Field: "limit", Reason: fmt.Sprintf("must be non-negative, got %d", queueOpts.Limit)}
+	}
+
+	// Without shuffling, only the first Limit items are needed
+	fetchLimit := queueOpts.Limit
+	if queueOpts.Shuffle {
+		fetchLimit = 0
+	}
+
+	var uris []string
+	switch {
+	case strings.HasPrefix(uri, "spotify:album:"):
+		uris, err = c.albumTrackURIs(ctx, uri, queueOpts.Market, fetchLimit)
+	case strings.HasPrefix(uri, "spotify:playlist:"):
+		uris, err = c.playlistItemURIs(ctx, uri, queueOpts.Market, fetchLimit)
+	default:
+		return 0, &InvalidOptionError{Field: "contextURI", Reason: "must be an album or playlist to queue, got " + uri}
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	if queueOpts.Shuffle {
+		rand.Shuffle(len(uris), func(i, j int) {
+			uris[i], uris[j] = uris[j], uris[i]
+		})
+	}
+	if queueOpts.Limit > 0 && len(uris) > queueOpts.Limit {
+		uris = uris[:queueOpts.Limit]
+	}
+
+	for i, itemURI := range uris {
+		var err error
+		if queueOpts.DeviceID != "" {
+			err = c.CurrentUserAddToQueue(ctx, itemURI, queueOpts.DeviceID)
+		} else {
+			err = c.CurrentUserAddToQueue(ctx, itemURI)
+		}
+		if err != nil {
+			return i, fmt.Errorf("failed to queue item %d of %d (%s): %w", i+1, len(uris), itemURI, err)
+		}
+	}
+
+	return len(uris), nil
+}
+
+// albumTrackURIs returns the track URIs of an album in order, stopping after
+// limit tracks if limit is positive
+func (c *Client) albumTrackURIs(ctx context.Context, albumURI, market string, limit int) ([]string, error) {
+	var uris []string
+
+	page, err := c.AlbumTracks(ctx, albumURI, &AlbumTracksOptions{Market: market, Limit: 50})
+	for err == nil && page != nil {
+		for _, track := range page.Items {
+			uris = append(uris, track.URI)
+			if limit > 0 && len(uris) >= limit {
+				return uris, nil
+			}
+		}
+		page, err = NextGeneric[SimplifiedTrack](c, ctx, page)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return uris, nil
+}
+
+// playlistItemURIs returns the track and episode URIs of a playlist in
+// order, skipping local files and unavailable items, and stopping after
+// limit items if limit is positive
+func (c *Client) playlistItemURIs(ctx context.Context, playlistURI, market string, limit int) ([]string, error) {
+	var uris []string
+
+	page, err := c.PlaylistTracks(ctx, playlistURI, &PlaylistTracksOptions{
+		Fields:          "items(is_local,track(uri)),next",
+		Limit:           100,
+		Market:          market,
+		AdditionalTypes: "track,episode",
+	})
+	for err == nil && page != nil {
+		for _, item := range page.Items {
+			uri := ""
+			if track, ok := item.AsTrack(); ok && !track.IsLocal {
+				uri = track.URI
+			} else if episode, ok := item.AsEpisode(); ok {
+				uri = episode.URI
+			}
+			if uri == "" {
+				continue
+			}
+
+			uris = append(uris, uri)
+			if limit > 0 && len(uris) >= limit {
+				return uris, nil
+			}
+		}
+		page, err = NextGeneric[PlaylistTrack](c, ctx, page)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return uris, nil
+}
+
+// validateStartPlaybackOptions checks that mutually exclusive playback fields
+// are not combined
+func validateStartPlaybackOptions(opts *StartPlaybackOptions) error {
+	if opts == nil {
+		return nil
+	}
+	if opts.ContextURI != "" && len(opts.URIs) > 0 {
+		return &InvalidOptionError{Field: "context URI", Reason: fmt.Sprintf("and URIs are mutually exclusive, got %s and %d URIs", opts.ContextURI, len(opts.URIs))}
+	}
+	if opts.Offset != nil {
+		if opts.ContextURI == "" && len(opts.URIs) == 0 {
+			return &InvalidOptionError{Field: "offset", Reason: fmt.Sprintf("requires a context URI or URIs, got %v", opts.Offset)}
+		}
+		_, hasPosition := opts.Offset["position"]
+		_, hasURI := opts.Offset["uri"]
+		if hasPosition && hasURI {
+			return &InvalidOptionError{Field: "offset", Reason: fmt.Sprintf("position and URI are mutually exclusive, got %v", opts.Offset)}
+		}
+	}
+	if opts.PositionMs != nil && *opts.PositionMs < 0 {
+		return &InvalidOptionError{Field: "position", Reason: fmt.Sprintf("must be non-negative, got %d", *opts.PositionMs)}
+	}
+	return nil
+}
+
+// toContextURI converts a context URI or URL to a Spotify URI.
+// Supported context types are album, artist, playlist, show, and audiobook.
+func toContextURI(contextURI string) (string, error) {
+	if contextURI == "" {
+		return "", &MissingOptionError{Field: "contextURI"}
+	}
+
+	kind, id, err := ParseAny(contextURI)
+	if err != nil {
+		return "", err
+	}
+	switch kind {
+	case "album", "artist", "playlist", "show", "audiobook":
+		return GetURI(id, kind)
+	}
+	return "", &InvalidOptionError{Field: "contextURI", Reason: fmt.Sprintf("must be an album, artist, playlist, show, or audiobook, got %s", contextURI)}
+}
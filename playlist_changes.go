@@ -0,0 +1,46 @@
+package spotigo
+
+import (
+	"context"
+)
+
+// ============================================================================
+// Conditional Playlist Reads
+// ============================================================================
+
+// PlaylistIfChanged fetches a playlist only if its snapshot differs from
+// knownSnapshotID. It first requests just the snapshot ID, a response of a
+// few bytes, and returns (nil, false, nil) if it matches; otherwise the full
+// playlist is fetched and returned with true. An empty knownSnapshotID
+// always fetches.
+//
+// The playlist may change between the two requests, so the returned
+// playlist's SnapshotID, not the probed one, is the one to remember.
+//
+// Example:
+//
+//	playlist, changed, err := client.PlaylistIfChanged(ctx, playlistID, lastSnapshot)
+//	if err != nil {
+//		return err
+//	}
+//	if changed {
+//		lastSnapshot = playlist.SnapshotID
+//		render(playlist)
+//	}
+func (c *Client) PlaylistIfChanged(ctx context.Context, playlistID, knownSnapshotID string) (*Playlist, bool, error) {
+	if knownSnapshotID != "" {
+		probe, err := c.Playlist(ctx, playlistID, &PlaylistOptions{Fields: "snapshot_id"})
+		if err != nil {
+			return nil, false, err
+		}
+		if probe.SnapshotID == knownSnapshotID {
+			return nil, false, nil
+		}
+	}
+
+	playlist, err := c.Playlist(ctx, playlistID, nil)
+	if err != nil {
+		return nil, false, err
+	}
+	return playlist, true, nil
+}
@@ -0,0 +1,125 @@
+package spotigo
+
+import (
+	"context"
+	"fmt"
+)
+
+// ============================================================================
+// Playlist Creation and Deletion
+// ============================================================================
+
+// playlistAddBatchSize is the maximum number of items per add request
+const playlistAddBatchSize = 100
+
+// CreatePlaylist creates a playlist for the current user and fills it in one
+// call: it creates the playlist with opts' name, description (cleaned up
+// with SanitizePlaylistDescription), and visibility, adds opts.InitialTracks
+// in batches of 100, and uploads opts.CoverImage.
+//
+// If adding items or the cover fails, the playlist is returned along with
+// the error so the caller can finish the job. With opts.RollbackOnFailure
+// set, the playlist is unfollowed instead (Spotify's way of deleting it) and
+// only the error is returned.
+//
+// Example:
+//
+//	playlist, err := client.CreatePlaylist(ctx, &spotigo.CreatePlaylistOptions{
+//		Name:              "Road Trip",
+//		Description:       "Songs for the drive",
+//		InitialTracks:     trackURIs,
+//		CoverImage:        coverPNG,
+//		RollbackOnFailure: true,
+//	})
+func (c *Client) CreatePlaylist(ctx context.Context, opts *CreatePlaylistOptions) (*Playlist, error) {
+	if opts == nil {
+		return nil, &MissingOptionError{Field: "opts"}
+	}
+
+	// Convert the cover before creating anything, so a bad image fails early
+	var cover []byte
+	if len(opts.CoverImage) > 0 {
+		var err error
+		if cover, err = PrepareCoverImage(opts.CoverImage); err != nil {
+			return nil, err
+		}
+	}
+
+	create := *opts
+	create.Description = SanitizePlaylistDescription(opts.Description)
+	playlist, err := c.UserPlaylistCreate(ctx, "", &create)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.fillNewPlaylist(ctx, playlist, opts.InitialTracks, cover); err != nil {
+		err = fmt.Errorf("playlist %s was created but not filled: %w", playlist.ID, err)
+		if !opts.RollbackOnFailure {
+			return playlist, err
+		}
+		if unfollowErr := c.CurrentUserUnfollowPlaylist(ctx, playlist.ID); unfollowErr != nil {
+			return playlist, fmt.Errorf("%w; rolling back failed: %w", err, unfollowErr)
+		}
+		return nil, err
+	}
+
+	return playlist, nil
+}
+
+// fillNewPlaylist adds items in batches and uploads the cover, updating
+// playlist's snapshot ID
+func (c *Client) fillNewPlaylist(ctx context.Context, playlist *Playlist, items []string, cover []byte) error {
+	for start := 0; start < len(items); start += playlistAddBatchSize {
+		batch := items[start:min(start+playlistAddBatchSize, len(items))]
+		snapshot, err := c.PlaylistAddItems(ctx, playlist.ID, batch)
+		if err != nil {
+			return fmt.Errorf("adding items %d-%d: %w", start, start+len(batch)-1, err)
+		}
+		playlist.SnapshotID = snapshot.SnapshotID
+	}
+
+	if cover != nil {
+		if err := c.PlaylistUploadCoverImage(ctx, playlist.ID, cover); err != nil {
+			return fmt.Errorf("uploading cover image: %w", err)
+		}
+	}
+	return nil
+}
+
+// DeleteOwnPlaylist deletes (unfollows) a playlist after checking that the
+// current user owns it. Unfollowing someone else's playlist only removes it
+// from the user's library, which sync tools rarely mean to do; that returns
+// a *NotPlaylistOwnerError (matching ErrNotPlaylistOwner) without changing
+// anything.
+//
+// Example:
+//
+//	err := client.DeleteOwnPlaylist(ctx, playlistID)
+//	if errors.Is(err, spotigo.ErrNotPlaylistOwner) {
+//		log.Printf("skipping %s: not ours", playlistID)
+//	}
+func (c *Client) DeleteOwnPlaylist(ctx context.Context, playlistID string) error {
+	id, err := GetID(playlistID, "playlist")
+	if err != nil {
+		return err
+	}
+
+	userID, err := c.MeID(ctx)
+	if err != nil {
+		return err
+	}
+	playlist, err := c.Playlist(ctx, id, &PlaylistOptions{Fields: "id,owner(id)"})
+	if err != nil {
+		return err
+	}
+
+	ownerID := ""
+	if playlist.Owner != nil {
+		ownerID = playlist.Owner.ID
+	}
+	if ownerID != userID {
+		return &NotPlaylistOwnerError{PlaylistID: id, OwnerID: ownerID, UserID: userID}
+	}
+
+	return c.CurrentUserUnfollowPlaylist(ctx, id)
+}
@@ -0,0 +1,118 @@
+package spotigo
+
+import (
+	"fmt"
+	"html"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// ============================================================================
+// Playlist Description Templates
+// ============================================================================
+
+const (
+	// MaxPlaylistDescriptionLength is the maximum playlist description length
+	// (in characters) accepted by Spotify
+	MaxPlaylistDescriptionLength = 300
+	// DefaultDescriptionDateFormat is the date layout used for {date} placeholders
+	DefaultDescriptionDateFormat = "2006-01-02"
+)
+
+// DescriptionVars holds the values substituted into a playlist description template.
+//
+// Supported placeholders:
+//   - {date}: Date formatted with DateFormat (default: 2006-01-02)
+//   - {track_count}: TrackCount
+//   - {generator}: Generator
+//   - {name}: any key in Extra, e.g. {mood} for Extra["mood"]
+type DescriptionVars struct {
+	Date       time.Time         // Date for {date} (default: now)
+	DateFormat string            // Go time layout for {date} (default: DefaultDescriptionDateFormat)
+	TrackCount int               // Value for {track_count}
+	Generator  string            // Value for {generator}
+	Extra      map[string]string // Additional placeholder values
+}
+
+// descriptionPlaceholderPattern matches {placeholder} tokens
+var descriptionPlaceholderPattern = regexp.MustCompile(`\{([a-z_][a-z0-9_]*)\}`)
+
+// descriptionTagPattern matches HTML tags such as <b> or </a>
+var descriptionTagPattern = regexp.MustCompile(`</?[A-Za-z][^<>]*>`)
+
+// RenderPlaylistDescription expands placeholders in a playlist description
+// template and sanitizes the result for Spotify.
+//
+// Sanitizing applies Spotify's description rules: line breaks are collapsed
+// to spaces, HTML tags and stray angle brackets are removed (Spotify rejects
+// HTML markup), and the result is truncated to MaxPlaylistDescriptionLength
+// characters.
+// Returns an error if the template references an unknown placeholder.
+//
+// Example:
+//
+//	desc, err := spotigo.RenderPlaylistDescription(
+//		"{track_count} tracks, updated {date} by {generator}",
+//		spotigo.DescriptionVars{TrackCount: 50, Generator: "weekly-mix"},
+//	)
+func RenderPlaylistDescription(template string, vars DescriptionVars) (string, error) {
+	date := vars.Date
+	if date.IsZero() {
+		date = time.Now()
+	}
+	dateFormat := vars.DateFormat
+	if dateFormat == "" {
+		dateFormat = DefaultDescriptionDateFormat
+	}
+
+	values := map[string]string{
+		"date":        date.Format(dateFormat),
+		"track_count": strconv.Itoa(vars.TrackCount),
+		"generator":   vars.Generator,
+	}
+	for key, value := range vars.Extra {
+		values[key] = value
+	}
+
+	var unknown []string
+	rendered := descriptionPlaceholderPattern.ReplaceAllStringFunc(template, func(token string) string {
+		key := token[1 : len(token)-1]
+		value, ok := values[key]
+		if !ok {
+			unknown = append(unknown, token)
+			return token
+		}
+		return value
+	})
+	if len(unknown) > 0 {
+		return "", fmt.Errorf("unknown description placeholders: %s", strings.Join(unknown, ", "))
+	}
+
+	return SanitizePlaylistDescription(rendered), nil
+}
+
+// SanitizePlaylistDescription applies Spotify's playlist description rules:
+// line breaks are collapsed to spaces, HTML tags and stray angle brackets
+// are removed, and the result is truncated to MaxPlaylistDescriptionLength
+// characters
+func SanitizePlaylistDescription(description string) string {
+	description = descriptionTagPattern.ReplaceAllString(description, "")
+	replacer := strings.NewReplacer("\r\n", " ", "\n", " ", "\r", " ", "<", "", ">", "")
+	description = strings.TrimSpace(replacer.Replace(description))
+
+	if utf8.RuneCountInString(description) <= MaxPlaylistDescriptionLength {
+		return description
+	}
+
+	runes := []rune(description)
+	return strings.TrimSpace(string(runes[:MaxPlaylistDescriptionLength-1])) + "…"
+}
+
+// UnescapePlaylistDescription decodes HTML entities in a playlist description
+// returned by Spotify (e.g. "&amp;" becomes "&")
+func UnescapePlaylistDescription(description string) string {
+	return html.UnescapeString(description)
+}
@@ -0,0 +1,184 @@
+package spotigo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+)
+
+// ============================================================================
+// Idempotent Playlist Writes
+// ============================================================================
+
+// noRetryKey is the context key that limits _internal_call retries to 429
+// responses, for writes whose retries are handled by the caller
+type noRetryKey struct{}
+
+// IdempotentAddOptions holds options for PlaylistAddItemsIdempotent
+type IdempotentAddOptions struct {
+	ExpectedSnapshotID string // If set, fail with ErrSnapshotMismatch unless the playlist is at this snapshot
+}
+
+// PlaylistAddItemsIdempotent appends items (tracks or episodes) to the end
+// of a playlist at most once, even when a request fails ambiguously.
+//
+// If opts.ExpectedSnapshotID is set, the playlist's snapshot is checked
+// before writing and a *SnapshotMismatchError is returned if it differs.
+// Spotify does not accept a snapshot on add requests, so the check narrows
+// but cannot close the window for concurrent edits.
+//
+// When the add request fails without a definite answer (a network error or
+// a 5xx response), the items may or may not have been added. Instead of
+// re-sending blindly, the playlist is re-read: if it grew by exactly the
+// items and its tail matches them, the write is treated as done; if it did
+// not change, the request is retried (up to RetryConfig.MaxRetries times).
+// If the playlist changed in any other way, an error is returned because the
+// outcome cannot be determined.
+//
+// Items are given as in PlaylistAddItems, but every item must be valid.
+//
+// Example:
+//
+//	playlist, err := client.Playlist(ctx, playlistID, &spotigo.PlaylistOptions{Fields: "snapshot_id"})
+//	if err != nil {
+//		return err
+//	}
+//	snapshot, err := client.PlaylistAddItemsIdempotent(ctx, playlistID, uris,
+//		&spotigo.IdempotentAddOptions{ExpectedSnapshotID: playlist.SnapshotID})
+//	if errors.Is(err, spotigo.ErrSnapshotMismatch) {
+//		// Someone else edited the playlist; re-read it and decide again
+//	}
+func (c *Client) PlaylistAddItemsIdempotent(ctx context.Context, playlistID string, items []string, opts *IdempotentAddOptions) (*PlaylistSnapshotID, error) {
+	id, err := GetID(playlistID, "playlist")
+	if err != nil {
+		return nil, err
+	}
+
+	if len(items) > 100 {
+		return nil, &TooManyIDsError{Kind: "items", Max: 100, Got: len(items)}
+	}
+
+	uris := make([]string, 0, len(items))
+	invalidItems := &MultiError{}
+	for i, item := range items {
+		uri, err := ItemURI(item)
+		if err != nil {
+			invalidItems.Add(i, item, err)
+			continue
+		}
+		uris = append(uris, uri)
+	}
+	if err := invalidItems.ErrorOrNil(); err != nil {
+		return nil, err
+	}
+
+	snapshotID, total, err := c.playlistSnapshot(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if opts != nil && opts.ExpectedSnapshotID != "" && opts.ExpectedSnapshotID != snapshotID {
+		return nil, &SnapshotMismatchError{PlaylistID: id, Expected: opts.ExpectedSnapshotID, Actual: snapshotID}
+	}
+	if len(uris) == 0 {
+		return &PlaylistSnapshotID{SnapshotID: snapshotID}, nil
+	}
+
+	writeCtx := context.WithValue(ctx, noRetryKey{}, true)
+	for attempt := 0; ; attempt++ {
+		var result PlaylistSnapshotID
+		err := c._post(writeCtx, fmt.Sprintf("playlists/%s/tracks", id), nil, PlaylistAddItemsRequest{URIs: uris}, &result)
+		if err == nil {
+			return &result, nil
+		}
+		if ctx.Err() != nil || !ambiguousWriteError(err) {
+			return nil, err
+		}
+
+		// The items may have landed; look before sending them again
+		currentSnapshot, currentTotal, readErr := c.playlistSnapshot(ctx, id)
+		if readErr != nil {
+			return nil, fmt.Errorf("add failed and the playlist could not be re-read: %w", errors.Join(err, readErr))
+		}
+		switch currentTotal {
+		case total + len(uris):
+			landed, readErr := c.playlistTailMatches(ctx, id, total, uris)
+			if readErr != nil {
+				return nil, fmt.Errorf("add failed and the playlist could not be re-read: %w", errors.Join(err, readErr))
+			}
+			if landed {
+				return &PlaylistSnapshotID{SnapshotID: currentSnapshot}, nil
+			}
+			return nil, fmt.Errorf("add failed and the playlist changed concurrently; items may have been added: %w", err)
+		case total:
+			// Not added; safe to send again
+		default:
+			return nil, fmt.Errorf("add failed and the playlist changed concurrently; items may have been added: %w", err)
+		}
+
+		if attempt >= c.RetryConfig.MaxRetries {
+			return nil, err
+		}
+		delay := c.calculateBackoffDelay(attempt)
+		c.logRetry(attempt, delay, err)
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("request cancelled after %d retry attempts: %w", attempt, ctx.Err())
+		case <-time.After(delay):
+		}
+	}
+}
+
+// ambiguousWriteError reports whether a failed write may still have been
+// applied: any failure other than a 4xx response
+func ambiguousWriteError(err error) bool {
+	var spotifyErr *SpotifyError
+	if errors.As(err, &spotifyErr) {
+		return spotifyErr.HTTPStatus >= http.StatusInternalServerError
+	}
+	return true
+}
+
+// playlistSnapshot returns a playlist's snapshot ID and item count
+func (c *Client) playlistSnapshot(ctx context.Context, id string) (string, int, error) {
+	playlist, err := c.Playlist(ctx, id, &PlaylistOptions{Fields: "snapshot_id,tracks(total)"})
+	if err != nil {
+		return "", 0, err
+	}
+	total := 0
+	if playlist.Tracks != nil {
+		total = playlist.Tracks.Total
+	}
+	return playlist.SnapshotID, total, nil
+}
+
+// playlistTailMatches reports whether the playlist items starting at offset
+// are exactly uris. Relinked tracks are compared by their original URI.
+func (c *Client) playlistTailMatches(ctx context.Context, id string, offset int, uris []string) (bool, error) {
+	page, err := c.PlaylistTracks(ctx, id, &PlaylistTracksOptions{
+		Fields:          "items(track(uri,linked_from(uri)))",
+		Limit:           len(uris),
+		Offset:          offset,
+		AdditionalTypes: "track,episode",
+	})
+	if err != nil {
+		return false, err
+	}
+
+	tail := make([]string, 0, len(page.Items))
+	for _, item := range page.Items {
+		uri := ""
+		if track, ok := item.AsTrack(); ok {
+			uri = track.URI
+			if track.LinkedFrom != nil && track.LinkedFrom.URI != "" {
+				uri = track.LinkedFrom.URI
+			}
+		} else if episode, ok := item.AsEpisode(); ok {
+			uri = episode.URI
+		}
+		tail = append(tail, uri)
+	}
+	return slices.Equal(tail, uris), nil
+}
@@ -0,0 +1,153 @@
+package spotigo
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// ============================================================================
+// Playlist Item Kinds
+// ============================================================================
+
+// PlaylistItemKind classifies playlist items. Kinds are bit flags, so a set
+// of kinds to keep can be passed to FilterPlaylistItems.
+type PlaylistItemKind int
+
+const (
+	PlaylistItemTrack       PlaylistItemKind = 1 << iota // Spotify catalog track
+	PlaylistItemEpisode                                  // Podcast episode
+	PlaylistItemLocal                                    // Local file, which has no Spotify ID
+	PlaylistItemUnavailable                              // Item removed from Spotify (null track)
+)
+
+// String returns the kind's name, or the names of a set of kinds joined
+// with "|"
+func (k PlaylistItemKind) String() string {
+	var names []string
+	for _, kind := range []struct {
+		kind PlaylistItemKind
+		name string
+	}{
+		{PlaylistItemTrack, "track"},
+		{PlaylistItemEpisode, "episode"},
+		{PlaylistItemLocal, "local"},
+		{PlaylistItemUnavailable, "unavailable"},
+	} {
+		if k&kind.kind != 0 {
+			names = append(names, kind.name)
+		}
+	}
+	if len(names) == 0 {
+		return "none"
+	}
+	return strings.Join(names, "|")
+}
+
+// playlistItemKind classifies an item from its is_local flag and track
+// object, which may be a decoded map, a Track, or an Episode
+func playlistItemKind(isLocal bool, item interface{}) PlaylistItemKind {
+	if isLocal {
+		return PlaylistItemLocal
+	}
+	switch item := item.(type) {
+	case nil:
+		return PlaylistItemUnavailable
+	case *Track:
+		if item == nil {
+			return PlaylistItemUnavailable
+		}
+	case *Episode:
+		if item == nil {
+			return PlaylistItemUnavailable
+		}
+		return PlaylistItemEpisode
+	case Episode:
+		return PlaylistItemEpisode
+	}
+
+	fields := playbackItemFieldsOf(item)
+	switch {
+	case strings.HasPrefix(fields.URI, "spotify:episode:"):
+		return PlaylistItemEpisode
+	case strings.HasPrefix(fields.URI, "spotify:local:"):
+		return PlaylistItemLocal
+	default:
+		return PlaylistItemTrack
+	}
+}
+
+// Kind classifies the item as a track, episode, local file, or item that
+// is no longer available
+func (p PlaylistTrack) Kind() PlaylistItemKind {
+	return playlistItemKind(p.IsLocal, p.Track)
+}
+
+// Kind classifies the item (see PlaylistTrack.Kind)
+func (p PlaylistItem) Kind() PlaylistItemKind {
+	switch {
+	case p.IsLocal || p.Local != nil:
+		return PlaylistItemLocal
+	case p.Episode != nil:
+		return PlaylistItemEpisode
+	case p.Track != nil:
+		return playlistItemKind(p.Track.IsLocal, p.Track)
+	default:
+		return PlaylistItemUnavailable
+	}
+}
+
+// AsTrack decodes the item as a track. It returns false for episodes and
+// unavailable items; local files decode with IsLocal set and no ID.
+func (p PlaylistTrack) AsTrack() (*Track, bool) {
+	if p.Kind()&(PlaylistItemTrack|PlaylistItemLocal) == 0 {
+		return nil, false
+	}
+	var track Track
+	if !decodePlaylistItem(p.Track, &track) {
+		return nil, false
+	}
+	track.IsLocal = track.IsLocal || p.IsLocal
+	return &track, true
+}
+
+// AsEpisode decodes the item as an episode, returning false for anything
+// else
+func (p PlaylistTrack) AsEpisode() (*Episode, bool) {
+	if p.Kind() != PlaylistItemEpisode {
+		return nil, false
+	}
+	var episode Episode
+	if !decodePlaylistItem(p.Track, &episode) {
+		return nil, false
+	}
+	return &episode, true
+}
+
+// decodePlaylistItem converts a playlist item's track object into v
+func decodePlaylistItem(item interface{}, v interface{}) bool {
+	data, err := json.Marshal(item)
+	if err != nil {
+		return false
+	}
+	return json.Unmarshal(data, v) == nil
+}
+
+// FilterPlaylistItems returns the items whose kind is in kinds, preserving
+// order. The input slice is not modified.
+//
+// Example:
+//
+//	// Keep Spotify tracks, dropping episodes, local files, and removed items
+//	tracks := spotigo.FilterPlaylistItems(page.Items, spotigo.PlaylistItemTrack)
+//
+//	// Everything that can be re-added to a playlist
+//	playable := spotigo.FilterPlaylistItems(page.Items, spotigo.PlaylistItemTrack|spotigo.PlaylistItemEpisode)
+func FilterPlaylistItems[T interface{ Kind() PlaylistItemKind }](items []T, kinds PlaylistItemKind) []T {
+	filtered := make([]T, 0, len(items))
+	for _, item := range items {
+		if item.Kind()&kinds != 0 {
+			filtered = append(filtered, item)
+		}
+	}
+	return filtered
+}
@@ -0,0 +1,338 @@
+package spotigo
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"strings"
+)
+
+// ============================================================================
+// Playlist Shuffle and Sort
+// ============================================================================
+
+// PlaylistSortField is a playlist item attribute to sort by
+type PlaylistSortField string
+
+const (
+	SortByAddedAt     PlaylistSortField = "added_at"     // When the item was added
+	SortByName        PlaylistSortField = "name"         // Track or episode name (case-insensitive)
+	SortByArtist      PlaylistSortField = "artist"       // First artist name, then track name
+	SortByDuration    PlaylistSortField = "duration"     // Duration in milliseconds
+	SortByPopularity  PlaylistSortField = "popularity"   // Track popularity (episodes have none)
+	SortByReleaseDate PlaylistSortField = "release_date" // Album or episode release date
+)
+
+// playlistOrderFields lists the item fields needed to sort a playlist
+const playlistOrderFields = "items(added_at,is_local,track(uri,name,duration_ms,popularity,release_date,artists(name),album(release_date))),next"
+
+// PlaylistSortOptions holds options for PlaylistSortBy
+type PlaylistSortOptions struct {
+	Descending bool // Sort in descending order
+}
+
+// playlistOrderItem holds the sortable attributes of a playlist item
+type playlistOrderItem struct {
+	AddedAt string
+	Track   *playlistOrderTrack // nil for unavailable items
+}
+
+// playlistOrderTrack holds the sortable attributes of a track or episode
+type playlistOrderTrack struct {
+	Name        string
+	DurationMs  int
+	Popularity  int
+	Artist      string      // First artist name; empty for episodes
+	ReleaseDate ReleaseDate // Album release date for tracks
+}
+
+// PlaylistSortBy sorts a playlist in place by field. Ties keep their current
+// order, and unavailable items (with a null track) are moved to the end.
+//
+// Items are moved with reorder requests chained on snapshot IDs, so added_at
+// and added_by are preserved, unlike PlaylistReplaceItems. Runs of items that
+// are already in order relative to each other are moved in a single request.
+// Returns the final snapshot ID.
+//
+// Example:
+//
+//	snapshot, err := client.PlaylistSortBy(ctx, playlistID, spotigo.SortByReleaseDate,
+//		&spotigo.PlaylistSortOptions{Descending: true})
+func (c *Client) PlaylistSortBy(ctx context.Context, playlistID string, field PlaylistSortField, opts *PlaylistSortOptions) (*PlaylistSnapshotID, error) {
+	compare, err := playlistItemComparator(field)
+	if err != nil {
+		return nil, err
+	}
+	descending := opts != nil && opts.Descending
+
+	return c.reorderPlaylist(ctx, playlistID, func(items []playlistOrderItem) []int {
+		order := make([]int, len(items))
+		for i := range order {
+			order[i] = i
+		}
+		slices.SortStableFunc(order, func(a, b int) int {
+			itemA, itemB := items[a], items[b]
+			// Unavailable items always go last
+			if (itemA.Track == nil) != (itemB.Track == nil) {
+				if itemA.Track == nil {
+					return 1
+				}
+				return -1
+			}
+			if itemA.Track == nil {
+				return 0
+			}
+			if descending {
+				return compare(itemB, itemA)
+			}
+			return compare(itemA, itemB)
+		})
+		return order
+	})
+}
+
+// PlaylistShuffle shuffles a playlist in place with a uniformly random
+// permutation. Like PlaylistSortBy, items are moved with reorder requests so
+// their metadata is preserved; a shuffle typically needs one request per item.
+// Returns the final snapshot ID.
+func (c *Client) PlaylistShuffle(ctx context.Context, playlistID string) (*PlaylistSnapshotID, error) {
+	return c.reorderPlaylist(ctx, playlistID, func(items []playlistOrderItem) []int {
+		return rand.Perm(len(items))
+	})
+}
+
+// playlistItemComparator returns the comparison function for a sort field
+func playlistItemComparator(field PlaylistSortField) (func(a, b playlistOrderItem) int, error) {
+	switch field {
+	case SortByAddedAt:
+		return func(a, b playlistOrderItem) int {
+			return strings.Compare(a.AddedAt, b.AddedAt)
+		}, nil
+	case SortByName:
+		return func(a, b playlistOrderItem) int {
+			return strings.Compare(strings.ToLower(a.Track.Name), strings.ToLower(b.Track.Name))
+		}, nil
+	case SortByArtist:
+		return func(a, b playlistOrderItem) int {
+			return cmp.Or(
+				strings.Compare(strings.ToLower(a.Track.Artist), strings.ToLower(b.Track.Artist)),
+				strings.Compare(strings.ToLower(a.Track.Name), strings.ToLower(b.Track.Name)),
+			)
+		}, nil
+	case SortByDuration:
+		return func(a, b playlistOrderItem) int {
+			return cmp.Compare(a.Track.DurationMs, b.Track.DurationMs)
+		}, nil
+	case SortByPopularity:
+		return func(a, b playlistOrderItem) int {
+			return cmp.Compare(a.Track.Popularity, b.Track.Popularity)
+		}, nil
+	case SortByReleaseDate:
+		return func(a, b playlistOrderItem) int {
+			return a.Track.ReleaseDate.Compare(b.Track.ReleaseDate)
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported playlist sort field: %q", field)
+	}
+}
+
+// reorderPlaylist rearranges a playlist so that the item currently at
+// position order[i] ends up at position i
+func (c *Client) reorderPlaylist(ctx context.Context, playlistID string, permutation func([]playlistOrderItem) []int) (*PlaylistSnapshotID, error) {
+	id, err := GetID(playlistID, "playlist")
+	if err != nil {
+		return nil, err
+	}
+
+	playlist, err := c.Playlist(ctx, id, &PlaylistOptions{Fields: "snapshot_id"})
+	if err != nil {
+		return nil, err
+	}
+	snapshot := &PlaylistSnapshotID{SnapshotID: playlist.SnapshotID}
+
+	items, err := c.playlistOrderItems(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	order := permutation(items)
+	for _, move := range playlistMoves(order) {
+		rangeLength := move.length
+		snapshotID := snapshot.SnapshotID
+		reorder := &ReorderItemsOptions{
+			RangeStart:   move.from,
+			InsertBefore: move.to,
+			RangeLength:  &rangeLength,
+		}
+		if snapshotID != "" {
+			reorder.SnapshotID = &snapshotID
+		}
+
+		snapshot, err = c.PlaylistReorderItems(ctx, id, reorder)
+		if err != nil {
+			return nil, fmt.Errorf("failed to move items %d-%d to %d: %w",
+				move.from, move.from+move.length-1, move.to, err)
+		}
+	}
+
+	return snapshot, nil
+}
+
+// playlistOrderItems fetches the sortable attributes of every playlist item
+func (c *Client) playlistOrderItems(ctx context.Context, playlistID string) ([]playlistOrderItem, error) {
+	var items []playlistOrderItem
+
+	page, err := c.PlaylistTracks(ctx, playlistID, &PlaylistTracksOptions{
+		Fields:          playlistOrderFields,
+		Limit:           100,
+		AdditionalTypes: "track,episode",
+	})
+	for err == nil && page != nil {
+		for _, entry := range page.Items {
+			items = append(items, playlistOrderItem{AddedAt: entry.AddedAt, Track: playlistOrderTrackOf(entry)})
+		}
+		page, err = NextGeneric[PlaylistTrack](c, ctx, page)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+// playlistOrderTrackOf returns the sortable attributes of a playlist item,
+// or nil if it is unavailable
+func playlistOrderTrackOf(entry PlaylistTrack) *playlistOrderTrack {
+	if episode, ok := entry.AsEpisode(); ok {
+		return &playlistOrderTrack{Name: episode.Name, DurationMs: episode.DurationMs, ReleaseDate: episode.ReleaseDate}
+	}
+	track, ok := entry.AsTrack()
+	if !ok {
+		return nil
+	}
+	item := &playlistOrderTrack{Name: track.Name, DurationMs: track.DurationMs, Popularity: track.Popularity}
+	if len(track.Artists) > 0 {
+		item.Artist = track.Artists[0].Name
+	}
+	if track.Album != nil {
+		item.ReleaseDate = track.Album.ReleaseDate
+	}
+	return item
+}
+
+// playlistMove is a single reorder request
+type playlistMove struct {
+	from   int // range_start
+	length int // range_length
+	to     int // insert_before
+}
+
+// playlistMoves computes reorder requests that turn the current order into
+// order, where order[i] is the current position of the item that belongs at
+// position i. Positions before i are settled after each step, so every move
+// takes the next needed run of items from later in the playlist.
+func playlistMoves(order []int) []playlistMove {
+	// current[p] is the original index of the item now at position p
+	current := make([]int, len(order))
+	for i := range current {
+		current[i] = i
+	}
+
+	var moves []playlistMove
+	for i := 0; i < len(order); {
+		from := slices.Index(current[i:], order[i]) + i
+		if from == i {
+			i++
+			continue
+		}
+
+		// Extend the run while the following items are also needed next
+		length := 1
+		for from+length < len(current) && i+length < len(order) && current[from+length] == order[i+length] {
+			length++
+		}
+
+		moves = append(moves, playlistMove{from: from, length: length, to: i})
+
+		run := slices.Clone(current[from : from+length])
+		current = slices.Delete(current, from, from+length)
+		current = slices.Insert(current, i, run...)
+		i += length
+	}
+
+	return moves
+}
+
+// ============================================================================
+// Moving Playlist Items
+// ============================================================================
+
+// PlaylistMoveItem moves the item at fromIndex so that it ends up at
+// toIndex, shifting the items in between. Both are positions in the
+// playlist as it is now, e.g. moving index 0 to index 2 of [a b c d] gives
+// [b c a d]. If snapshotID is given, the move applies to that version of
+// the playlist. Returns the new snapshot ID.
+//
+// Example:
+//
+//	// Move the last of 50 items to the top
+//	snapshot, err := client.PlaylistMoveItem(ctx, playlistID, 49, 0)
+func (c *Client) PlaylistMoveItem(ctx context.Context, playlistID string, fromIndex, toIndex int, snapshotID ...string) (*PlaylistSnapshotID, error) {
+	return c.PlaylistMoveRange(ctx, playlistID, fromIndex, 1, toIndex, snapshotID...)
+}
+
+// PlaylistMoveRange moves length items starting at start so that the first
+// of them ends up at toIndex, keeping their order. toIndex is a position in
+// the playlist after the move, so moving [a b] of [a b c d] to index 2 gives
+// [c d a b]. Moving a range to where it already is makes no change and
+// returns the current snapshot ID.
+//
+// Example:
+//
+//	// Move the first three items to the end of a 10-item playlist
+//	snapshot, err := client.PlaylistMoveRange(ctx, playlistID, 0, 3, 7)
+func (c *Client) PlaylistMoveRange(ctx context.Context, playlistID string, start, length, toIndex int, snapshotID ...string) (*PlaylistSnapshotID, error) {
+	var errs []error
+	if start < 0 {
+		errs = append(errs, &InvalidOptionError{Field: "start", Reason: fmt.Sprintf("must be non-negative, got %d", start)})
+	}
+	if length < 1 {
+		errs = append(errs, &InvalidOptionError{Field: "length", Reason: fmt.Sprintf("must be at least 1, got %d", length)})
+	}
+	if toIndex < 0 {
+		errs = append(errs, &InvalidOptionError{Field: "toIndex", Reason: fmt.Sprintf("must be non-negative, got %d", toIndex)})
+	}
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	if toIndex == start {
+		playlist, err := c.Playlist(ctx, playlistID, &PlaylistOptions{Fields: "snapshot_id"})
+		if err != nil {
+			return nil, err
+		}
+		return &PlaylistSnapshotID{SnapshotID: playlist.SnapshotID}, nil
+	}
+
+	return c.PlaylistReorderItems(ctx, playlistID, moveReorder(start, length, toIndex, snapshotID...))
+}
+
+// moveReorder translates a move into a reorder request. Spotify's
+// insert_before is a position before the move, so moving down past the
+// range must count the moved items themselves.
+func moveReorder(start, length, toIndex int, snapshotID ...string) *ReorderItemsOptions {
+	opts := &ReorderItemsOptions{RangeStart: start, InsertBefore: toIndex}
+	if toIndex > start {
+		opts.InsertBefore = toIndex + length
+	}
+	if length != 1 {
+		opts.RangeLength = &length
+	}
+	if len(snapshotID) > 0 && snapshotID[0] != "" {
+		opts.SnapshotID = &snapshotID[0]
+	}
+	return opts
+}
@@ -0,0 +1,284 @@
+package spotigo
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+)
+
+// ============================================================================
+// Playlist Change Watcher
+// ============================================================================
+
+// PlaylistItemChange describes one item that was added, removed, or moved
+type PlaylistItemChange struct {
+	URI              string        // Item URI; empty for items that are no longer available
+	Position         int           // Position after the change; -1 for removed items
+	PreviousPosition int           // Position before the change; -1 for added items
+	Item             PlaylistTrack // The item, as it was for removed items
+}
+
+// PlaylistDiff is the item-level difference between two versions of a
+// playlist. A playlist whose details changed but whose items did not has a
+// diff with no changes.
+type PlaylistDiff struct {
+	PlaylistID         string
+	PreviousSnapshotID string
+	SnapshotID         string
+	Added              []PlaylistItemChange // In new position order
+	Removed            []PlaylistItemChange // In previous position order
+	Moved              []PlaylistItemChange // In new position order
+}
+
+// Empty reports whether no items were added, removed, or moved
+func (d PlaylistDiff) Empty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Moved) == 0
+}
+
+// DiffPlaylistItems compares two versions of a playlist's items. Items are
+// matched by URI, the nth copy of an item before with the nth copy after.
+// Items that kept their relative order are not reported as moved even if
+// their positions shifted; the fewest items that explain the new order are.
+//
+// Example:
+//
+//	diff := spotigo.DiffPlaylistItems(before, after)
+//	for _, added := range diff.Added {
+//		fmt.Printf("%s added at %d\n", added.URI, added.Position)
+//	}
+func DiffPlaylistItems(previous, current []PlaylistTrack) PlaylistDiff {
+	var diff PlaylistDiff
+
+	previousURIs := make([]string, len(previous))
+	positions := make(map[string][]int) // Unmatched previous positions by URI
+	for i, item := range previous {
+		previousURIs[i] = playlistTrackURI(item)
+		positions[previousURIs[i]] = append(positions[previousURIs[i]], i)
+	}
+
+	// Pair each current item with the earliest unmatched copy before
+	matched := make([]bool, len(previous))
+	var pairs [][2]int // Previous and current positions
+	for i, item := range current {
+		uri := playlistTrackURI(item)
+		if queue := positions[uri]; len(queue) > 0 {
+			positions[uri] = queue[1:]
+			matched[queue[0]] = true
+			pairs = append(pairs, [2]int{queue[0], i})
+			continue
+		}
+		diff.Added = append(diff.Added, PlaylistItemChange{URI: uri, Position: i, PreviousPosition: -1, Item: item})
+	}
+	for i, item := range previous {
+		if !matched[i] {
+			diff.Removed = append(diff.Removed, PlaylistItemChange{URI: previousURIs[i], Position: -1, PreviousPosition: i, Item: item})
+		}
+	}
+
+	// Items outside the longest run that kept its order were moved
+	sort.Slice(pairs, func(i, j int) bool { return pairs[i][0] < pairs[j][0] })
+	stayed := longestIncreasing(pairs)
+	for i, pair := range pairs {
+		if !stayed[i] {
+			diff.Moved = append(diff.Moved, PlaylistItemChange{
+				URI:              previousURIs[pair[0]],
+				Position:         pair[1],
+				PreviousPosition: pair[0],
+				Item:             current[pair[1]],
+			})
+		}
+	}
+	sort.Slice(diff.Moved, func(i, j int) bool { return diff.Moved[i].Position < diff.Moved[j].Position })
+
+	return diff
+}
+
+// longestIncreasing marks the pairs in a longest subsequence whose current
+// positions increase
+func longestIncreasing(pairs [][2]int) []bool {
+	tails := []int{}                  // Index of the last pair of the best run of each length
+	parent := make([]int, len(pairs)) // Previous pair in the run ending at each pair
+	for i, pair := range pairs {
+		n := sort.Search(len(tails), func(k int) bool { return pairs[tails[k]][1] >= pair[1] })
+		parent[i] = -1
+		if n > 0 {
+			parent[i] = tails[n-1]
+		}
+		if n == len(tails) {
+			tails = append(tails, i)
+		} else {
+			tails[n] = i
+		}
+	}
+
+	in := make([]bool, len(pairs))
+	if len(tails) > 0 {
+		for i := tails[len(tails)-1]; i >= 0; i = parent[i] {
+			in[i] = true
+		}
+	}
+	return in
+}
+
+// playlistTrackURI returns the URI of a playlist item, or "" if it is no
+// longer available
+func playlistTrackURI(item PlaylistTrack) string {
+	return playbackItemFieldsOf(item.Track).URI
+}
+
+// watchedPlaylist is the last seen version of a watched playlist
+type watchedPlaylist struct {
+	snapshotID string
+	items      []PlaylistTrack
+}
+
+// DefaultPlaylistWatchInterval is the polling interval of
+// PlaylistWatcher.Run when interval is not positive
+const DefaultPlaylistWatchInterval = time.Minute
+
+// PlaylistWatcher polls playlists and reports item-level changes, e.g. for
+// bots moderating collaborative playlists. Each poll asks only for the
+// snapshot ID of each playlist and fetches the items of those whose snapshot
+// changed.
+//
+// The first poll records a baseline without reporting changes.
+type PlaylistWatcher struct {
+	OnChange func(PlaylistDiff) // Called for each changed playlist, in Poll's goroutine
+	OnError  func(err error)    // Called with polling errors in Run (optional)
+
+	client      *Client
+	playlistIDs []string
+
+	mu        sync.Mutex
+	playlists map[string]*watchedPlaylist // Keyed by playlist ID
+}
+
+// NewPlaylistWatcher returns a watcher for the given playlists, which may be
+// IDs, URIs, or URLs. IDs that cannot be parsed are ignored.
+//
+// Example:
+//
+//	watcher := client.NewPlaylistWatcher(playlistIDs, func(diff spotigo.PlaylistDiff) {
+//		for _, added := range diff.Added {
+//			if added.Item.AddedBy != nil && banned[added.Item.AddedBy.ID] {
+//				remove(diff.PlaylistID, added.URI)
+//			}
+//		}
+//	})
+//	err := watcher.Run(ctx, time.Minute)
+func (c *Client) NewPlaylistWatcher(playlistIDs []string, onChange func(PlaylistDiff)) *PlaylistWatcher {
+	return &PlaylistWatcher{
+		OnChange:    onChange,
+		client:      c,
+		playlistIDs: parseWatchedIDs(playlistIDs, "playlist"),
+		playlists:   make(map[string]*watchedPlaylist),
+	}
+}
+
+// Items returns the items of a watched playlist as of the last poll
+func (w *PlaylistWatcher) Items(playlistID string) ([]PlaylistTrack, bool) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	watched, ok := w.playlists[playlistID]
+	if !ok {
+		return nil, false
+	}
+	return watched.items, true
+}
+
+// Poll checks each watched playlist once, calls OnChange for each changed
+// one, and returns the diffs. A playlist that fails to load keeps its last
+// version and is reported in a *MultiError; the others are still polled.
+func (w *PlaylistWatcher) Poll(ctx context.Context) ([]PlaylistDiff, error) {
+	w.mu.Lock()
+	var diffs []PlaylistDiff
+	errs := &MultiError{}
+	for i, id := range w.playlistIDs {
+		if ctx.Err() != nil {
+			w.mu.Unlock()
+			return diffs, ctx.Err()
+		}
+		diff, changed, err := w.poll(ctx, id)
+		errs.Add(i, id, err)
+		if changed {
+			diffs = append(diffs, diff)
+		}
+	}
+	w.mu.Unlock()
+
+	if w.OnChange != nil {
+		for _, diff := range diffs {
+			w.OnChange(diff)
+		}
+	}
+	return diffs, errs.ErrorOrNil()
+}
+
+// poll checks one playlist, returning its diff if it changed since a
+// previous poll
+func (w *PlaylistWatcher) poll(ctx context.Context, id string) (PlaylistDiff, bool, error) {
+	probe, err := w.client.Playlist(ctx, id, &PlaylistOptions{Fields: "snapshot_id"})
+	if err != nil {
+		return PlaylistDiff{}, false, err
+	}
+	previous, known := w.playlists[id]
+	if known && previous.snapshotID == probe.SnapshotID {
+		return PlaylistDiff{}, false, nil
+	}
+
+	items, err := w.fetchItems(ctx, id)
+	if err != nil {
+		return PlaylistDiff{}, false, err
+	}
+	w.playlists[id] = &watchedPlaylist{snapshotID: probe.SnapshotID, items: items}
+	if !known {
+		return PlaylistDiff{}, false, nil
+	}
+
+	diff := DiffPlaylistItems(previous.items, items)
+	diff.PlaylistID = id
+	diff.PreviousSnapshotID = previous.snapshotID
+	diff.SnapshotID = probe.SnapshotID
+	return diff, true, nil
+}
+
+// fetchItems reads all items of a playlist
+func (w *PlaylistWatcher) fetchItems(ctx context.Context, id string) ([]PlaylistTrack, error) {
+	page, err := w.client.PlaylistTracks(ctx, id, &PlaylistTracksOptions{AdditionalTypes: "track,episode"})
+	if err != nil {
+		return nil, err
+	}
+	var items []PlaylistTrack
+	for item, err := range IteratePages(w.client, ctx, page) {
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+// Run polls immediately and then every interval (default:
+// DefaultPlaylistWatchInterval) until ctx is cancelled. Polling errors are
+// passed to OnError and do not stop the watcher. Returns ctx.Err() when the
+// context is done.
+func (w *PlaylistWatcher) Run(ctx context.Context, interval time.Duration) error {
+	if interval <= 0 {
+		interval = DefaultPlaylistWatchInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := w.Poll(ctx); err != nil && w.OnError != nil && ctx.Err() == nil {
+			w.OnError(err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,515 @@
+// Package playlistspec keeps Spotify playlists in line with declarative
+// definitions, so a playlist can be kept in version control and rebuilt on
+// every run.
+//
+// A Spec names a playlist and lists the sources its items come from: search
+// queries, artists' top tracks, recommendation seeds, other playlists, and
+// fixed tracks. Apply resolves the sources, finds the playlist (by ID, or by
+// name among the current user's own playlists), creates it if it does not
+// exist, and changes it only where it differs from the spec. Running Apply
+// again with the same results changes nothing.
+//
+//	spec, err := playlistspec.Load("focus.json")
+//	if err != nil {
+//		return err
+//	}
+//	plan, err := playlistspec.Apply(ctx, client, spec)
+package playlistspec
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+
+	"github.com/sv4u/spotigo"
+)
+
+// ============================================================================
+// Playlist Specs
+// ============================================================================
+
+// Default number of items taken from each kind of source when Source.Limit
+// is zero. Playlist and Tracks sources are taken whole.
+const (
+	DefaultSearchLimit          = 20
+	DefaultRecommendationsLimit = 20
+)
+
+// searchPageSize is the most results one search request returns
+const searchPageSize = 50
+
+// batchSize is the most items one playlist write request accepts
+const batchSize = 100
+
+// Spec declares the desired state of a playlist
+type Spec struct {
+	Name        string   `json:"name"`                  // Playlist name; also how an existing playlist is found
+	Description string   `json:"description,omitempty"` // Playlist description
+	Public      *bool    `json:"public,omitempty"`      // Visibility; left as is when nil
+	PlaylistID  string   `json:"playlist_id,omitempty"` // Manage this playlist instead of finding one by name
+	Market      string   `json:"market,omitempty"`      // Market for search, top tracks, and recommendations
+	Limit       int      `json:"limit,omitempty"`       // Most items in the playlist (0 for no limit)
+	Sources     []Source `json:"sources"`               // Where items come from, in playlist order
+}
+
+// Source is one place a spec's items come from. Exactly one of Search,
+// ArtistTopTracks, Recommendations, Playlist, and Tracks is set.
+type Source struct {
+	Search          string   `json:"search,omitempty"`            // Track search query, filters included (e.g. "genre:jazz year:1959")
+	ArtistTopTracks []string `json:"artist_top_tracks,omitempty"` // Artist IDs, URIs, or URLs
+	Recommendations *Seeds   `json:"recommendations,omitempty"`   // Recommendation seeds
+	Playlist        string   `json:"playlist,omitempty"`          // Playlist ID, URI, or URL to copy tracks and episodes from
+	Tracks          []string `json:"tracks,omitempty"`            // Track or episode IDs, URIs, or URLs
+	Limit           int      `json:"limit,omitempty"`             // Most items taken from this source (per artist for top tracks)
+}
+
+// Seeds are recommendation seeds; at most 5 in total
+type Seeds struct {
+	Artists []string `json:"artists,omitempty"` // Artist IDs, URIs, or URLs
+	Tracks  []string `json:"tracks,omitempty"`  // Track IDs, URIs, or URLs
+	Genres  []string `json:"genres,omitempty"`  // Genre seeds
+}
+
+// SourceError reports a source that does not set exactly one kind
+type SourceError struct {
+	Index int // Position of the source in Spec.Sources
+	Set   int // Number of kinds the source sets
+}
+
+// Error implements the error interface
+func (e *SourceError) Error() string {
+	return fmt.Sprintf("sources[%d] must set exactly one of search, artist_top_tracks, recommendations, playlist, tracks; %d are set", e.Index, e.Set)
+}
+
+// Is reports whether target is spotigo.ErrValidation
+func (e *SourceError) Is(target error) bool {
+	return target == spotigo.ErrValidation
+}
+
+// Parse decodes and validates a JSON spec
+func Parse(data []byte) (*Spec, error) {
+	var spec Spec
+	if err := json.Unmarshal(data, &spec); err != nil {
+		return nil, fmt.Errorf("parsing playlist spec: %w", err)
+	}
+	if err := spec.Validate(); err != nil {
+		return nil, err
+	}
+	return &spec, nil
+}
+
+// Load reads and validates a JSON spec file
+func Load(path string) (*Spec, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	spec, err := Parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return spec, nil
+}
+
+// Validate checks that the spec has a name and that each source sets
+// exactly one kind
+func (s *Spec) Validate() error {
+	if s.Name == "" {
+		return &spotigo.MissingOptionError{Field: "name"}
+	}
+	for i, source := range s.Sources {
+		set := 0
+		for _, ok := range []bool{
+			source.Search != "",
+			len(source.ArtistTopTracks) > 0,
+			source.Recommendations != nil,
+			source.Playlist != "",
+			len(source.Tracks) > 0,
+		} {
+			if ok {
+				set++
+			}
+		}
+		if set != 1 {
+			return &SourceError{Index: i, Set: set}
+		}
+	}
+	return nil
+}
+
+// Plan describes what Apply changes to bring a playlist in line with its
+// spec
+type Plan struct {
+	PlaylistID string                                // Playlist being managed; empty until created
+	Create     bool                                  // The playlist does not exist yet
+	Details    *spotigo.ChangePlaylistDetailsOptions // Name, description, or visibility changes; nil if none
+	Items      []string                              // Desired item URIs, in order
+	Current    []string                              // Item URIs in the playlist now, in order
+	Added      []string                              // Desired items not in the playlist
+	Removed    []string                              // Playlist items not desired
+}
+
+// Changed reports whether applying the plan modifies anything
+func (p *Plan) Changed() bool {
+	return p.Create || p.Details != nil || p.ItemsChanged()
+}
+
+// ItemsChanged reports whether the playlist's items or their order differ
+// from the spec
+func (p *Plan) ItemsChanged() bool {
+	return !slices.Equal(p.Items, p.Current)
+}
+
+// NewPlan resolves a spec's sources and compares the result with the
+// playlist, without changing anything. Items from all sources are
+// concatenated in order, duplicates dropped, and the list cut to
+// spec.Limit. Local files can not be added through the API and are not
+// taken from playlist sources.
+//
+// Example:
+//
+//	plan, err := playlistspec.NewPlan(ctx, client, spec)
+//	if err != nil {
+//		return err
+//	}
+//	fmt.Printf("+%d -%d\n", len(plan.Added), len(plan.Removed))
+func NewPlan(ctx context.Context, client *spotigo.Client, spec *Spec) (*Plan, error) {
+	if err := spec.Validate(); err != nil {
+		return nil, err
+	}
+
+	items, err := resolve(ctx, client, spec)
+	if err != nil {
+		return nil, err
+	}
+	plan := &Plan{Items: items}
+
+	playlist, err := findPlaylist(ctx, client, spec)
+	if err != nil {
+		return nil, err
+	}
+	if playlist == nil {
+		plan.Create = true
+		plan.Added = slices.Clone(items)
+		return plan, nil
+	}
+
+	plan.PlaylistID = playlist.ID
+	plan.Details = detailChanges(spec, playlist)
+	if plan.Current, err = playlistURIs(ctx, client, playlist.ID); err != nil {
+		return nil, err
+	}
+	plan.Added = difference(plan.Items, plan.Current)
+	plan.Removed = difference(plan.Current, plan.Items)
+	return plan, nil
+}
+
+// Apply brings a playlist in line with its spec: it creates the playlist if
+// needed, updates its details, and replaces its items if they or their order
+// differ. The returned plan describes what was changed, with PlaylistID set.
+//
+// Example:
+//
+//	plan, err := playlistspec.Apply(ctx, client, spec)
+//	if err != nil {
+//		return err
+//	}
+//	if !plan.Changed() {
+//		fmt.Println("playlist is up to date")
+//	}
+func Apply(ctx context.Context, client *spotigo.Client, spec *Spec) (*Plan, error) {
+	plan, err := NewPlan(ctx, client, spec)
+	if err != nil {
+		return nil, err
+	}
+	return plan, Execute(ctx, client, spec, plan)
+}
+
+// Execute makes the changes in a plan from NewPlan. Items beyond the first
+// 100 are appended in batches, so a failure part way leaves the playlist
+// incomplete until the next run.
+func Execute(ctx context.Context, client *spotigo.Client, spec *Spec, plan *Plan) error {
+	if plan.Create {
+		playlist, err := client.CreatePlaylist(ctx, &spotigo.CreatePlaylistOptions{
+			Name:          spec.Name,
+			Public:        spec.Public,
+			Description:   spotigo.SanitizePlaylistDescription(spec.Description),
+			InitialTracks: plan.Items,
+		})
+		if playlist != nil {
+			plan.PlaylistID = playlist.ID
+		}
+		return err
+	}
+
+	if plan.Details != nil {
+		if err := client.PlaylistChangeDetails(ctx, plan.PlaylistID, plan.Details); err != nil {
+			return fmt.Errorf("updating playlist details: %w", err)
+		}
+	}
+
+	if !plan.ItemsChanged() {
+		return nil
+	}
+	first := append([]string{}, plan.Items[:min(batchSize, len(plan.Items))]...) // Never nil, so clearing sends []
+	if _, err := client.PlaylistReplaceItems(ctx, plan.PlaylistID, first); err != nil {
+		return fmt.Errorf("replacing playlist items: %w", err)
+	}
+	for start := batchSize; start < len(plan.Items); start += batchSize {
+		batch := plan.Items[start:min(start+batchSize, len(plan.Items))]
+		if _, err := client.PlaylistAddItems(ctx, plan.PlaylistID, batch); err != nil {
+			return fmt.Errorf("adding items %d-%d: %w", start, start+len(batch)-1, err)
+		}
+	}
+	return nil
+}
+
+// resolve collects the item URIs of all sources, deduplicated and cut to
+// the spec's limit
+func resolve(ctx context.Context, client *spotigo.Client, spec *Spec) ([]string, error) {
+	var items []string
+	seen := make(map[string]bool)
+	for i, source := range spec.Sources {
+		uris, err := resolveSource(ctx, client, spec.Market, source)
+		if err != nil {
+			return nil, fmt.Errorf("sources[%d]: %w", i, err)
+		}
+		for _, uri := range uris {
+			if seen[uri] {
+				continue
+			}
+			seen[uri] = true
+			items = append(items, uri)
+			if spec.Limit > 0 && len(items) == spec.Limit {
+				return items, nil
+			}
+		}
+	}
+	return items, nil
+}
+
+// resolveSource returns the item URIs of one source
+func resolveSource(ctx context.Context, client *spotigo.Client, market string, source Source) ([]string, error) {
+	switch {
+	case source.Search != "":
+		return searchURIs(ctx, client, market, source.Search, limitOr(source.Limit, DefaultSearchLimit))
+
+	case len(source.ArtistTopTracks) > 0:
+		var uris []string
+		for _, artist := range source.ArtistTopTracks {
+			top, err := client.ArtistTopTracks(ctx, artist, market)
+			if err != nil {
+				return nil, err
+			}
+			tracks := top.Tracks
+			if source.Limit > 0 && len(tracks) > source.Limit {
+				tracks = tracks[:source.Limit]
+			}
+			for _, track := range tracks {
+				uris = append(uris, track.URI)
+			}
+		}
+		return uris, nil
+
+	case source.Recommendations != nil:
+		var seeds spotigo.SeedSet
+		if err := seeds.AddArtists(source.Recommendations.Artists...); err != nil {
+			return nil, err
+		}
+		if err := seeds.AddTracks(source.Recommendations.Tracks...); err != nil {
+			return nil, err
+		}
+		if err := seeds.AddGenres(source.Recommendations.Genres...); err != nil {
+			return nil, err
+		}
+		opts := seeds.Options()
+		opts.Limit = limitOr(source.Limit, DefaultRecommendationsLimit)
+		opts.Market = market
+		recs, err := client.Recommendations(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		uris := make([]string, 0, len(recs.Tracks))
+		for _, track := range recs.Tracks {
+			uris = append(uris, track.URI)
+		}
+		return uris, nil
+
+	case source.Playlist != "":
+		uris, err := playlistURIs(ctx, client, source.Playlist)
+		if err != nil {
+			return nil, err
+		}
+		uris = slices.DeleteFunc(uris, spotigo.IsLocalURI)
+		if source.Limit > 0 && len(uris) > source.Limit {
+			uris = uris[:source.Limit]
+		}
+		return uris, nil
+
+	default:
+		uris := make([]string, 0, len(source.Tracks))
+		for _, item := range source.Tracks {
+			uri, err := spotigo.ItemURI(item)
+			if err != nil {
+				return nil, err
+			}
+			uris = append(uris, uri)
+		}
+		if source.Limit > 0 && len(uris) > source.Limit {
+			uris = uris[:source.Limit]
+		}
+		return uris, nil
+	}
+}
+
+// searchURIs returns the URIs of up to limit tracks matching query
+func searchURIs(ctx context.Context, client *spotigo.Client, market, query string, limit int) ([]string, error) {
+	var uris []string
+	for offset := 0; len(uris) < limit; offset += searchPageSize {
+		results, err := client.Search(ctx, query, "track", &spotigo.SearchOptions{
+			Market: market,
+			Limit:  min(searchPageSize, limit-len(uris)),
+			Offset: offset,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if results.Tracks == nil {
+			break
+		}
+		for _, track := range results.Tracks.Items {
+			uris = append(uris, track.URI)
+		}
+		if results.Tracks.Next == nil || len(results.Tracks.Items) == 0 {
+			break
+		}
+	}
+	return uris, nil
+}
+
+// playlistURIs returns the URIs of a playlist's items, in order. Items
+// that are no longer available have no URI and are skipped.
+func playlistURIs(ctx context.Context, client *spotigo.Client, playlistID string) ([]string, error) {
+	page, err := client.PlaylistTracks(ctx, playlistID, &spotigo.PlaylistTracksOptions{
+		Fields:          "items(is_local,track(type,uri)),limit,offset,total,next",
+		AdditionalTypes: "track,episode",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var uris []string
+	for item, err := range spotigo.IteratePages(client, ctx, page) {
+		if err != nil {
+			return nil, err
+		}
+		if uri := itemURIOf(item); uri != "" {
+			uris = append(uris, uri)
+		}
+	}
+	return uris, nil
+}
+
+// itemURIOf returns the URI of a playlist item, or "" if it has none
+func itemURIOf(item spotigo.PlaylistTrack) string {
+	if local, ok := item.AsLocal(); ok {
+		return local.URI
+	}
+	if track, ok := item.AsTrack(); ok {
+		return track.URI
+	}
+	if episode, ok := item.AsEpisode(); ok {
+		return episode.URI
+	}
+	return ""
+}
+
+// findPlaylist returns the playlist a spec manages, or nil if it does not
+// exist yet. Without a PlaylistID, the first playlist owned by the current
+// user with the spec's name is used.
+func findPlaylist(ctx context.Context, client *spotigo.Client, spec *Spec) (*spotigo.SimplifiedPlaylist, error) {
+	if spec.PlaylistID != "" {
+		playlist, err := client.Playlist(ctx, spec.PlaylistID, &spotigo.PlaylistOptions{
+			Fields: "id,name,description,public",
+		})
+		if err != nil {
+			return nil, err
+		}
+		found := playlist.SimplifiedPlaylist
+		found.Description = playlist.Description
+		return &found, nil
+	}
+
+	userID, err := client.MeID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	page, err := client.CurrentUserPlaylists(ctx, &spotigo.CurrentUserPlaylistsOptions{Limit: 50})
+	if err != nil {
+		return nil, err
+	}
+	for playlist, err := range spotigo.IteratePages(client, ctx, page) {
+		if err != nil {
+			return nil, err
+		}
+		if playlist.Name == spec.Name && playlist.Owner != nil && playlist.Owner.ID == userID {
+			return &playlist, nil
+		}
+	}
+	return nil, nil
+}
+
+// detailChanges returns the detail changes needed for playlist to match
+// spec, or nil if there are none
+func detailChanges(spec *Spec, playlist *spotigo.SimplifiedPlaylist) *spotigo.ChangePlaylistDetailsOptions {
+	var changes spotigo.ChangePlaylistDetailsOptions
+	changed := false
+	if playlist.Name != spec.Name {
+		changes.Name = &spec.Name
+		changed = true
+	}
+	description := ""
+	if playlist.Description != nil {
+		description = spotigo.UnescapePlaylistDescription(*playlist.Description)
+	}
+	// Compare against what Spotify would store, so descriptions with line
+	// breaks or markup don't produce a change on every run
+	want := spotigo.SanitizePlaylistDescription(spec.Description)
+	if description != want {
+		changes.Description = &want
+		changed = true
+	}
+	if spec.Public != nil && (playlist.Public == nil || *playlist.Public != *spec.Public) {
+		changes.Public = spec.Public
+		changed = true
+	}
+	if !changed {
+		return nil
+	}
+	return &changes
+}
+
+// difference returns the items of a not in b, in order
+func difference(a, b []string) []string {
+	in := make(map[string]bool, len(b))
+	for _, item := range b {
+		in[item] = true
+	}
+	var out []string
+	for _, item := range a {
+		if !in[item] {
+			out = append(out, item)
+		}
+	}
+	return out
+}
+
+// limitOr returns limit, or fallback if limit is not positive
+func limitOr(limit, fallback int) int {
+	if limit > 0 {
+		return limit
+	}
+	return fallback
+}
@@ -0,0 +1,95 @@
+package spotigo
+
+import (
+	"sync"
+	"time"
+)
+
+// ============================================================================
+// Playback Position Tracking
+// ============================================================================
+
+// PositionTracker extrapolates the playback position between polls, so
+// progress bars move smoothly instead of jumping with each PlaybackState.
+// The position advances with wall time while the state says playing, and
+// stops at the end of the track or episode. The zero value is ready to use
+// and safe for concurrent use.
+//
+// Example:
+//
+//	var tracker spotigo.PositionTracker
+//	go func() {
+//		for event := range client.WatchPlayback(ctx, 5*time.Second) {
+//			if event.Err == nil {
+//				tracker.Update(event.State, time.Now())
+//			}
+//		}
+//	}()
+//	for range time.Tick(100 * time.Millisecond) {
+//		drawProgress(tracker.Position(), tracker.Duration())
+//	}
+type PositionTracker struct {
+	mu        sync.Mutex
+	progress  time.Duration // Position reported by the last state
+	duration  time.Duration // Length of the playing item (0 if unknown)
+	playing   bool
+	fetchedAt time.Time
+}
+
+// Update records a playback state and the time it was fetched. A nil state
+// (nothing playing) resets the position to zero. A zero fetchedAt means now.
+func (t *PositionTracker) Update(state *PlaybackState, fetchedAt time.Time) {
+	if fetchedAt.IsZero() {
+		fetchedAt = time.Now()
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.fetchedAt = fetchedAt
+	if state == nil {
+		t.progress, t.duration, t.playing = 0, 0, false
+		return
+	}
+	t.progress = time.Duration(state.ProgressMs) * time.Millisecond
+	t.duration = time.Duration(playbackItemFieldsOf(state.Item).DurationMs) * time.Millisecond
+	t.playing = state.IsPlaying
+}
+
+// Position returns the extrapolated position now
+func (t *PositionTracker) Position() time.Duration {
+	return t.PositionAt(time.Now())
+}
+
+// PositionAt returns the position extrapolated to now, clamped to the item's
+// duration when it is known
+func (t *PositionTracker) PositionAt(now time.Time) time.Duration {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	position := t.progress
+	if t.playing && now.After(t.fetchedAt) {
+		position += now.Sub(t.fetchedAt)
+	}
+	if t.duration > 0 {
+		position = min(position, t.duration)
+	}
+	return max(position, 0)
+}
+
+// Duration returns the length of the playing item, or 0 if unknown
+func (t *PositionTracker) Duration() time.Duration {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.duration
+}
+
+// Fraction returns the extrapolated share of the item played, from 0 to 1,
+// or 0 if the duration is unknown
+func (t *PositionTracker) Fraction() float64 {
+	duration := t.Duration()
+	if duration <= 0 {
+		return 0
+	}
+	return float64(t.Position()) / float64(duration)
+}
@@ -0,0 +1,132 @@
+// Package promspotigo exports a spotigo client's request statistics as
+// Prometheus metrics.
+//
+// The Collector implements prometheus.Collector, so it can be registered
+// with any registry and served with promhttp. The package is a separate
+// module, so only programs that import it depend on the Prometheus client
+// library:
+//
+//	prometheus.MustRegister(promspotigo.New(client))
+//	http.Handle("/metrics", promhttp.Handler())
+package promspotigo
+
+import (
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sv4u/spotigo"
+)
+
+// ============================================================================
+// Prometheus Collector
+// ============================================================================
+
+// DefaultNamespace prefixes metric names unless WithNamespace changes it
+const DefaultNamespace = "spotigo"
+
+// counterMetric is a counter read from one field of Stats
+type counterMetric struct {
+	desc  *prometheus.Desc
+	value func(spotigo.ClientStats) int64
+}
+
+// Collector collects a client's Stats as Prometheus metrics. Each scrape
+// takes a fresh snapshot; counters restart from zero after ResetStats, which
+// Prometheus treats as a counter reset.
+type Collector struct {
+	client      *spotigo.Client
+	namespace   string
+	constLabels prometheus.Labels
+
+	counters  []counterMetric
+	responses *prometheus.Desc
+	latency   *prometheus.Desc
+	startTime *prometheus.Desc
+}
+
+// Option configures a Collector
+type Option func(*Collector)
+
+// WithNamespace sets the metric name prefix (default: DefaultNamespace)
+func WithNamespace(namespace string) Option {
+	return func(c *Collector) {
+		c.namespace = namespace
+	}
+}
+
+// WithConstLabels adds labels to every metric, e.g. to tell apart clients
+// registered with the same registry
+func WithConstLabels(labels map[string]string) Option {
+	return func(c *Collector) {
+		c.constLabels = labels
+	}
+}
+
+// New creates a collector for client's statistics
+func New(client *spotigo.Client, opts ...Option) *Collector {
+	c := &Collector{client: client, namespace: DefaultNamespace}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	counter := func(name, help string, value func(spotigo.ClientStats) int64) {
+		c.counters = append(c.counters, counterMetric{desc: c.desc(name, help), value: value})
+	}
+	counter("requests_total", "HTTP requests sent, including retries.", func(s spotigo.ClientStats) int64 { return s.Requests })
+	counter("retries_total", "Attempts made after a failed first attempt.", func(s spotigo.ClientStats) int64 { return s.Retries })
+	counter("rate_limited_total", "429 Too Many Requests responses.", func(s spotigo.ClientStats) int64 { return s.RateLimited })
+	counter("network_errors_total", "Requests that failed without a response.", func(s spotigo.ClientStats) int64 { return s.NetworkErrors })
+	counter("token_refreshes_total", "Times the auth manager returned a new access token.", func(s spotigo.ClientStats) int64 { return s.TokenRefreshes })
+	counter("sent_bytes_total", "Request body bytes sent.", func(s spotigo.ClientStats) int64 { return s.BytesSent })
+	counter("received_bytes_total", "Response body bytes received.", func(s spotigo.ClientStats) int64 { return s.BytesReceived })
+	counter("device_cache_hits_total", "CurrentUserDevices calls served from the device cache.", func(s spotigo.ClientStats) int64 { return s.CacheHits })
+	counter("device_cache_misses_total", "CurrentUserDevices calls that fetched devices.", func(s spotigo.ClientStats) int64 { return s.CacheMisses })
+
+	c.responses = c.desc("responses_total", "Responses by HTTP status code.", "code")
+	c.latency = c.desc("request_duration_seconds", "Time from sending a request to reading its response.")
+	c.startTime = c.desc("stats_start_time_seconds", "When counting started, in seconds since the epoch.")
+	return c
+}
+
+// desc creates the descriptor of a metric in the collector's namespace
+func (c *Collector) desc(name, help string, labels ...string) *prometheus.Desc {
+	return prometheus.NewDesc(prometheus.BuildFQName(c.namespace, "", name), help, labels, c.constLabels)
+}
+
+// Describe sends the descriptors of every metric the collector produces
+func (c *Collector) Describe(ch chan<- *prometheus.Desc) {
+	for _, counter := range c.counters {
+		ch <- counter.desc
+	}
+	ch <- c.responses
+	ch <- c.latency
+	ch <- c.startTime
+}
+
+// Collect sends the metrics of a fresh Stats snapshot
+func (c *Collector) Collect(ch chan<- prometheus.Metric) {
+	stats := c.client.Stats()
+
+	for _, counter := range c.counters {
+		ch <- prometheus.MustNewConstMetric(counter.desc, prometheus.CounterValue, float64(counter.value(stats)))
+	}
+
+	codes := make([]int, 0, len(stats.StatusCodes))
+	for code := range stats.StatusCodes {
+		codes = append(codes, code)
+	}
+	slices.Sort(codes)
+	for _, code := range codes {
+		ch <- prometheus.MustNewConstMetric(c.responses, prometheus.CounterValue, float64(stats.StatusCodes[code]), strconv.Itoa(code))
+	}
+
+	buckets := make(map[float64]uint64, len(stats.Latency.Bounds))
+	for i, bound := range stats.Latency.Bounds {
+		buckets[bound.Seconds()] = uint64(stats.Latency.Counts[i])
+	}
+	ch <- prometheus.MustNewConstHistogram(c.latency, uint64(stats.Latency.Count), stats.Latency.Sum.Seconds(), buckets)
+
+	ch <- prometheus.MustNewConstMetric(c.startTime, prometheus.GaugeValue, float64(stats.Since.UnixNano())/float64(time.Second))
+}
@@ -0,0 +1,97 @@
+package promspotigo_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/sv4u/spotigo"
+	"github.com/sv4u/spotigo/promspotigo"
+	"github.com/sv4u/spotigo/tests"
+)
+
+// scrape registers collector with a new registry and returns the metrics
+// served in the text exposition format
+func scrape(t *testing.T, collector prometheus.Collector) string {
+	t.Helper()
+
+	registry := prometheus.NewPedanticRegistry()
+	if err := registry.Register(collector); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	recorder := httptest.NewRecorder()
+	promhttp.HandlerFor(registry, promhttp.HandlerOpts{}).ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	body, _ := io.ReadAll(recorder.Body)
+	return string(body)
+}
+
+// TestPromspotigoCollector tests the exported metrics after a few requests
+func TestPromspotigoCollector(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.Header().Set("Retry-After", "0")
+			tests.WriteJSONResponse(w, http.StatusTooManyRequests, tests.CreateErrorResponse(http.StatusTooManyRequests, "Slow down", ""))
+			return
+		}
+		tests.WriteJSONResponse(w, http.StatusOK, map[string]interface{}{"id": "4iV5W9uYEdYUVa79Axb7Rh"})
+	}))
+	defer server.Close()
+
+	auth := &tests.MockAuthManager{Token: &spotigo.TokenInfo{AccessToken: "first", TokenType: "Bearer"}}
+	client, err := spotigo.NewClient(auth)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	client.APIPrefix = server.URL + "/"
+
+	if _, err := client.Track(context.Background(), "4iV5W9uYEdYUVa79Axb7Rh"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	auth.Token = &spotigo.TokenInfo{AccessToken: "second", TokenType: "Bearer"}
+	if _, err := client.Track(context.Background(), "4iV5W9uYEdYUVa79Axb7Rh"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	metrics := scrape(t, promspotigo.New(client, promspotigo.WithConstLabels(map[string]string{"client": `web "eu"`})))
+
+	for _, want := range []string{
+		"# TYPE spotigo_requests_total counter\n",
+		`spotigo_requests_total{client="web \"eu\""} 3` + "\n",
+		`spotigo_retries_total{client="web \"eu\""} 1` + "\n",
+		`spotigo_rate_limited_total{client="web \"eu\""} 1` + "\n",
+		`spotigo_token_refreshes_total{client="web \"eu\""} 1` + "\n",
+		`spotigo_responses_total{client="web \"eu\"",code="200"} 2` + "\n",
+		`spotigo_responses_total{client="web \"eu\"",code="429"} 1` + "\n",
+		"# TYPE spotigo_request_duration_seconds histogram\n",
+		`spotigo_request_duration_seconds_bucket{client="web \"eu\"",le="+Inf"} 3` + "\n",
+		`spotigo_request_duration_seconds_count{client="web \"eu\""} 3` + "\n",
+	} {
+		if !strings.Contains(metrics, want) {
+			t.Errorf("missing %q in:\n%s", want, metrics)
+		}
+	}
+}
+
+// TestPromspotigoNamespace tests a custom metric name prefix
+func TestPromspotigoNamespace(t *testing.T) {
+	auth := &tests.MockAuthManager{Token: &spotigo.TokenInfo{AccessToken: "token", TokenType: "Bearer"}}
+	client, err := spotigo.NewClient(auth)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	metrics := scrape(t, promspotigo.New(client, promspotigo.WithNamespace("music")))
+	if !strings.Contains(metrics, "music_requests_total 0\n") || strings.Contains(metrics, "spotigo_") {
+		t.Errorf("unexpected metrics:\n%s", metrics)
+	}
+	if !strings.Contains(metrics, `music_request_duration_seconds_bucket{le="0.005"} 0`) {
+		t.Errorf("expected empty buckets:\n%s", metrics)
+	}
+}
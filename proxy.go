@@ -0,0 +1,145 @@
+package spotigo
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// ============================================================================
+// Proxy and TLS Transport Configuration
+// ============================================================================
+
+// WithProxies sets per-scheme HTTP proxies, keyed by request scheme
+// ("http" or "https"). Requests whose scheme has no entry fall back to the
+// HTTP_PROXY, HTTPS_PROXY, and NO_PROXY environment variables.
+//
+// Proxies only apply to the HTTP client built by NewClient; they are
+// ignored when WithHTTPClient is used.
+//
+// Example:
+//
+//	client, err := spotigo.NewClient(auth, spotigo.WithProxies(map[string]string{
+//		"https": "http://proxy.internal:3128",
+//	}))
+func WithProxies(proxies map[string]string) ClientOption {
+	return func(c *Client) {
+		c.Proxies = proxies
+	}
+}
+
+// WithProxyURL routes both HTTP and HTTPS requests through proxyURL.
+// An invalid URL makes NewClient return an error.
+func WithProxyURL(proxyURL string) ClientOption {
+	return func(c *Client) {
+		c.Proxies = map[string]string{
+			"http":  proxyURL,
+			"https": proxyURL,
+		}
+	}
+}
+
+// WithTLSConfig sets the TLS configuration used by the HTTP client built by
+// NewClient, e.g. to trust a corporate root CA or present a client
+// certificate. It is ignored when WithHTTPClient is used.
+func WithTLSConfig(config *tls.Config) ClientOption {
+	return func(c *Client) {
+		c.TLSConfig = config
+	}
+}
+
+// WithTransport sets the http.RoundTripper of the HTTP client built by
+// NewClient, keeping the library's timeout, so a transport can be swapped
+// without giving up the rest of the client configuration: a caching proxy,
+// HAR recording, or a wrapper around http.DefaultTransport. The transport
+// replaces the one WithProxies and WithTLSConfig would configure. With
+// WithHTTPClient, a copy of that client uses the transport instead.
+//
+// Example:
+//
+//	client, err := spotigo.NewClient(auth, spotigo.WithTransport(
+//		&recordingTransport{next: http.DefaultTransport},
+//	))
+func WithTransport(rt http.RoundTripper) ClientOption {
+	return func(c *Client) {
+		c.Transport = rt
+	}
+}
+
+// ConfigureTransport rebuilds the auth manager's HTTP client with per-scheme
+// proxies and an optional TLS configuration, so token requests take the same
+// route as API requests
+func (b *SpotifyAuthBase) ConfigureTransport(proxies map[string]string, tlsConfig *tls.Config) error {
+	timeout := b.RequestsTimeout
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+
+	httpClient, err := newTransportHTTPClient(timeout, proxies, tlsConfig)
+	if err != nil {
+		return err
+	}
+
+	b.Proxies = proxies
+	b.HTTPClient = httpClient
+	return nil
+}
+
+// newTransportHTTPClient creates an HTTP client whose transport uses the
+// given proxies and TLS configuration
+func newTransportHTTPClient(timeout time.Duration, proxies map[string]string, tlsConfig *tls.Config) (*http.Client, error) {
+	proxy, err := proxyFunc(proxies)
+	if err != nil {
+		return nil, err
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = proxy
+	if tlsConfig != nil {
+		transport.TLSClientConfig = tlsConfig.Clone()
+	}
+
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: transport,
+	}, nil
+}
+
+// proxyFunc builds an http.Transport Proxy function from per-scheme proxies,
+// falling back to the environment for schemes without an entry
+func proxyFunc(proxies map[string]string) (func(*http.Request) (*url.URL, error), error) {
+	parsed := make(map[string]*url.URL, len(proxies))
+	for scheme, rawURL := range proxies {
+		if rawURL == "" {
+			continue
+		}
+		// Accept "host:port" shorthand, as HTTP_PROXY does
+		if !strings.Contains(rawURL, "://") {
+			rawURL = "http://" + rawURL
+		}
+		proxyURL, err := url.Parse(rawURL)
+		if err != nil {
+			// url.Error repeats the URL, which may hold proxy credentials
+			var urlErr *url.Error
+			if errors.As(err, &urlErr) {
+				err = urlErr.Err
+			}
+			return nil, fmt.Errorf("invalid %s proxy URL %q: %w", scheme, RedactString(rawURL), err)
+		}
+		if proxyURL.Host == "" {
+			return nil, fmt.Errorf("invalid %s proxy URL %q: missing host", scheme, RedactString(rawURL))
+		}
+		parsed[strings.ToLower(scheme)] = proxyURL
+	}
+
+	return func(req *http.Request) (*url.URL, error) {
+		if proxyURL, ok := parsed[req.URL.Scheme]; ok {
+			return proxyURL, nil
+		}
+		return http.ProxyFromEnvironment(req)
+	}, nil
+}
@@ -0,0 +1,139 @@
+package spotigo
+
+import (
+	"context"
+	"time"
+)
+
+// ============================================================================
+// Playback Queue Positions
+// ============================================================================
+
+// Poll intervals for WaitUntilPlaying: it polls more often as the playing
+// item nears its end
+const (
+	waitPlayingMaxInterval = 2 * time.Second
+	waitPlayingMinInterval = 250 * time.Millisecond
+)
+
+// Position returns the 0-based position of a track or episode (URI, URL, or
+// ID) in the queue, or -1 if it is not queued. The currently playing item is
+// not part of the queue.
+func (q *QueueResponse) Position(item string) int {
+	uri, err := ItemURI(item)
+	if err != nil {
+		return -1
+	}
+	for i, queued := range q.Queue {
+		if playbackItemFieldsOf(queued).is(uri) {
+			return i
+		}
+	}
+	return -1
+}
+
+// TimeUntil estimates how long until a queued track or episode starts: the
+// rest of the playing item, from state's progress, plus the durations of the
+// items queued before it. It is 0 if the item is playing, and false if it is
+// not queued. The estimate assumes nothing is skipped and holds from when
+// state was fetched.
+func (q *QueueResponse) TimeUntil(item string, state *PlaybackState) (time.Duration, bool) {
+	uri, err := ItemURI(item)
+	if err != nil {
+		return 0, false
+	}
+	if state != nil && playbackItemFieldsOf(state.Item).is(uri) {
+		return 0, true
+	}
+
+	position := q.Position(uri)
+	if position < 0 {
+		return 0, false
+	}
+
+	var wait time.Duration
+	if state != nil && state.Item != nil {
+		current := playbackItemFieldsOf(state.Item)
+		wait = max(time.Duration(current.DurationMs-state.ProgressMs)*time.Millisecond, 0)
+	}
+	for _, queued := range q.Queue[:position] {
+		wait += time.Duration(playbackItemFieldsOf(queued).DurationMs) * time.Millisecond
+	}
+	return wait, true
+}
+
+// TimeUntilPlaying fetches the queue and playback state and estimates how
+// long until a track or episode starts (see QueueResponse.TimeUntil).
+// Returns ErrNotInQueue if it is neither playing nor queued.
+//
+// Example:
+//
+//	wait, err := client.TimeUntilPlaying(ctx, "spotify:track:4iV5W9uYEdYUVa79Axb7Rh")
+//	if err == nil {
+//		fmt.Printf("Your request plays in about %s\n", wait.Round(time.Minute))
+//	}
+func (c *Client) TimeUntilPlaying(ctx context.Context, item string) (time.Duration, error) {
+	if _, err := ItemURI(item); err != nil {
+		return 0, err
+	}
+
+	state, err := c.CurrentUserPlaybackState(ctx, &CurrentlyPlayingOptions{AdditionalTypes: "track,episode"})
+	if err != nil {
+		return 0, err
+	}
+	queue, err := c.CurrentUserQueue(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	wait, ok := queue.TimeUntil(item, state)
+	if !ok {
+		return 0, ErrNotInQueue
+	}
+	return wait, nil
+}
+
+// WaitUntilPlaying polls playback until a track or episode (URI, URL, or ID)
+// is playing, and returns the playback state at that point. Polls are spaced
+// up to 2 seconds apart, closer as the playing item nears its end. It waits
+// until ctx is done if the item never plays, e.g. because it was removed from
+// the queue, so pass a context with a deadline.
+//
+// Example:
+//
+//	ctx, cancel := context.WithTimeout(ctx, 30*time.Minute)
+//	defer cancel()
+//	if _, err := client.WaitUntilPlaying(ctx, requestedURI); err == nil {
+//		announce("Now playing your request")
+//	}
+func (c *Client) WaitUntilPlaying(ctx context.Context, item string) (*PlaybackState, error) {
+	uri, err := ItemURI(item)
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		state, err := c.CurrentUserPlaybackState(ctx, &CurrentlyPlayingOptions{AdditionalTypes: "track,episode"})
+		if err != nil {
+			return nil, err
+		}
+
+		interval := waitPlayingMaxInterval
+		if state != nil && state.Item != nil {
+			current := playbackItemFieldsOf(state.Item)
+			if current.is(uri) {
+				return state, nil
+			}
+			if state.IsPlaying && current.DurationMs > 0 {
+				remaining := time.Duration(current.DurationMs-state.ProgressMs) * time.Millisecond
+				interval = min(max(remaining, waitPlayingMinInterval), waitPlayingMaxInterval)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(interval):
+		}
+	}
+}
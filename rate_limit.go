@@ -0,0 +1,156 @@
+package spotigo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// ============================================================================
+// Rate Limit State
+// ============================================================================
+
+// RateLimitState is the client's view of the API rate limit, built from the
+// X-RateLimit-* and Retry-After headers of recent responses
+type RateLimitState struct {
+	Known      bool          // Whether rate limit budget headers have been seen
+	Limit      int           // Requests allowed per window (X-RateLimit-Limit)
+	Remaining  int           // Requests left in the window (X-RateLimit-Remaining)
+	Reset      time.Time     // When the window resets (X-RateLimit-Reset)
+	RetryAfter time.Duration // Retry-After of the last 429 response
+	LimitedAt  time.Time     // When the last 429 response was received
+	UpdatedAt  time.Time     // When the state was last updated
+}
+
+// rateLimitTracker holds the most recent rate limit state
+type rateLimitTracker struct {
+	mu    sync.Mutex
+	state RateLimitState
+}
+
+// update records rate limit headers from a response
+func (t *rateLimitTracker) update(statusCode int, headers http.Header, now time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if limit, err := strconv.Atoi(headers.Get("X-RateLimit-Limit")); err == nil {
+		t.state.Limit = limit
+		t.state.Known = true
+	}
+	if remaining, err := strconv.Atoi(headers.Get("X-RateLimit-Remaining")); err == nil {
+		t.state.Remaining = remaining
+		t.state.Known = true
+	}
+	if reset, ok := parseRateLimitReset(headers.Get("X-RateLimit-Reset"), now); ok {
+		t.state.Reset = reset
+	}
+	if statusCode == http.StatusTooManyRequests {
+		t.state.LimitedAt = now
+		t.state.RetryAfter = 0
+		if retryAfter, ok := parseRetryAfter(headers.Get("Retry-After"), now); ok {
+			t.state.RetryAfter = retryAfter
+		}
+	}
+	t.state.UpdatedAt = now
+}
+
+// get returns a copy of the current state
+func (t *rateLimitTracker) get() RateLimitState {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.state
+}
+
+// parseRateLimitReset parses X-RateLimit-Reset as either a Unix timestamp or
+// a number of seconds from now
+func parseRateLimitReset(value string, now time.Time) (time.Time, bool) {
+	if value == "" {
+		return time.Time{}, false
+	}
+	seconds, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || seconds < 0 {
+		return time.Time{}, false
+	}
+	// Values this large are timestamps rather than durations
+	if seconds > 1_000_000_000 {
+		return time.Unix(seconds, 0), true
+	}
+	return now.Add(time.Duration(seconds) * time.Second), true
+}
+
+// parseRetryAfter parses a Retry-After header as seconds or an HTTP date
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if t, err := http.ParseTime(value); err == nil {
+		if delay := t.Sub(now); delay > 0 {
+			return delay, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
+
+// RateLimitState returns the rate limit state observed from recent responses
+//
+// Example:
+//
+//	state := client.RateLimitState()
+//	if state.Known {
+//		fmt.Printf("%d/%d requests left until %s\n", state.Remaining, state.Limit, state.Reset)
+//	}
+func (c *Client) RateLimitState() RateLimitState {
+	return c.rateLimit.get()
+}
+
+// WithRateLimitReserve delays requests while fewer than reserve requests
+// remain in the current rate limit window, until the window resets.
+// 0 disables pre-emptive delays (default).
+func WithRateLimitReserve(reserve int) ClientOption {
+	return func(c *Client) {
+		c.RateLimitReserve = reserve
+	}
+}
+
+// waitForRateLimit blocks until the rate limit budget allows a request
+// when RateLimitReserve is set
+func (c *Client) waitForRateLimit(ctx context.Context) error {
+	if c.RateLimitReserve <= 0 {
+		return nil
+	}
+
+	state := c.rateLimit.get()
+	now := time.Now()
+
+	var until time.Time
+	if state.Known && state.Remaining < c.RateLimitReserve && state.Reset.After(now) {
+		until = state.Reset
+	}
+	if limitedUntil := state.LimitedAt.Add(state.RetryAfter); limitedUntil.After(now) && limitedUntil.After(until) {
+		until = limitedUntil
+	}
+	if until.IsZero() {
+		return nil
+	}
+
+	delay := until.Sub(now)
+	if c.Logger != nil {
+		c.Logger.Debug("Rate limit budget low (%d remaining), delaying request for %v", state.Remaining, delay)
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("request cancelled while waiting for rate limit reset: %w", ctx.Err())
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,53 @@
+package spotigo
+
+import (
+	"context"
+	"net/http"
+)
+
+// ============================================================================
+// Raw Responses
+// ============================================================================
+
+// RawResponse is the undecoded HTTP response of an API call
+type RawResponse struct {
+	Method     string      // Request method
+	URL        string      // Request URL, including query parameters
+	StatusCode int         // HTTP status code
+	Header     http.Header // Response headers
+	Body       []byte      // Response body as received, after decompression
+}
+
+// rawResponseKey is the context key for the RawResponse to fill
+type rawResponseKey struct{}
+
+// ContextWithRawResponse returns a context that makes calls made with it
+// store their HTTP response in raw alongside the typed result, to debug
+// decoding issues or read fields the models do not have yet. raw holds the
+// last response received, including error responses and the final attempt
+// of retried requests; it is left unchanged if no response arrives.
+//
+// Example:
+//
+//	var raw spotigo.RawResponse
+//	track, err := client.Track(spotigo.ContextWithRawResponse(ctx, &raw), trackID)
+//	fmt.Println(raw.StatusCode, raw.Header.Get("X-RateLimit-Remaining"))
+//	fmt.Println(string(raw.Body))
+func ContextWithRawResponse(ctx context.Context, raw *RawResponse) context.Context {
+	return context.WithValue(ctx, rawResponseKey{}, raw)
+}
+
+// recordRawResponse stores a response in the context's RawResponse, if any
+func recordRawResponse(ctx context.Context, method, url string, resp *http.Response, body []byte) {
+	raw, ok := ctx.Value(rawResponseKey{}).(*RawResponse)
+	if !ok || raw == nil {
+		return
+	}
+	*raw = RawResponse{
+		Method:     method,
+		URL:        url,
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header.Clone(),
+		Body:       body,
+	}
+}
@@ -0,0 +1,88 @@
+package spotigo
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// ============================================================================
+// Recently Played Windows
+// ============================================================================
+
+// RecentlyPlayedBetween returns the current user's plays from from
+// (inclusive) to to (exclusive), oldest first. A zero from or to leaves
+// that side of the window open.
+//
+// The recently played endpoint takes only one cursor at a time and returns
+// newest first; this walks back from to with before cursors until it passes
+// from, and drops the repeated plays Spotify sometimes returns across pages,
+// keyed by track and played_at. Spotify keeps roughly the last 50 plays, so
+// older windows come back empty.
+//
+// Example:
+//
+//	today := time.Now().Truncate(24 * time.Hour)
+//	plays, err := client.RecentlyPlayedBetween(ctx, today, time.Time{})
+//	if err != nil {
+//		return err
+//	}
+//	for _, play := range plays {
+//		fmt.Println(play.PlayedAt, play.Track.Name)
+//	}
+func (c *Client) RecentlyPlayedBetween(ctx context.Context, from, to time.Time) ([]PlayHistoryItem, error) {
+	if !from.IsZero() && !to.IsZero() && !from.Before(to) {
+		return nil, fmt.Errorf("recently played window is empty: from %s is not before to %s", from.Format(time.RFC3339), to.Format(time.RFC3339))
+	}
+
+	opts := &RecentlyPlayedOptions{Limit: 50}
+	if !to.IsZero() {
+		before := to.UnixMilli()
+		opts.Before = &before
+	}
+
+	type playKey struct {
+		trackID  string
+		playedAt int64
+	}
+	type timedItem struct {
+		item     PlayHistoryItem
+		playedAt time.Time
+	}
+
+	seen := make(map[playKey]bool)
+	var plays []timedItem
+	for item, err := range c.CurrentUserRecentlyPlayedIter(ctx, opts) {
+		if err != nil {
+			return nil, err
+		}
+		playedAt, err := time.Parse(time.RFC3339, item.PlayedAt)
+		if err != nil {
+			return nil, fmt.Errorf("invalid played_at %q: %w", item.PlayedAt, err)
+		}
+		if !from.IsZero() && playedAt.Before(from) {
+			break // Everything after this is older still
+		}
+		if !to.IsZero() && !playedAt.Before(to) {
+			continue
+		}
+
+		key := playKey{trackID: item.Track.ID, playedAt: playedAt.UnixNano()}
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		plays = append(plays, timedItem{item: item, playedAt: playedAt})
+	}
+
+	slices.SortStableFunc(plays, func(a, b timedItem) int {
+		return a.playedAt.Compare(b.playedAt)
+	})
+	items := make([]PlayHistoryItem, len(plays))
+	for i, play := range plays {
+		items[i] = play.item
+	}
+
+	return items, nil
+}
@@ -0,0 +1,87 @@
+package spotigo
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+)
+
+// ============================================================================
+// Sensitive Data Redaction
+// ============================================================================
+
+// Redacted replaces sensitive values in logs and error messages
+const Redacted = "[REDACTED]"
+
+// sensitiveParams are the query, form, and JSON fields whose values are redacted
+const sensitiveParams = `access_token|refresh_token|id_token|client_secret|code_verifier|code`
+
+var (
+	// authSchemePattern matches credentials after an HTTP auth scheme
+	authSchemePattern = regexp.MustCompile(`\b(Bearer|Basic)\s+[A-Za-z0-9\-._~+/]+=*`)
+	// jsonSecretPattern matches sensitive string fields in JSON
+	jsonSecretPattern = regexp.MustCompile(`"(` + sensitiveParams + `)"(\s*:\s*)"[^"]*"`)
+	// paramSecretPattern matches sensitive query or form parameters
+	paramSecretPattern = regexp.MustCompile(`\b(` + sensitiveParams + `)=[^&\s"'#]+`)
+	// userinfoSecretPattern matches the password in a URL's user info
+	userinfoSecretPattern = regexp.MustCompile(`(\b[A-Za-z][A-Za-z0-9+.\-]*://[^/\s:@"']*):[^/?#\s"']*@`)
+)
+
+// sensitiveHeaders are the headers whose values are redacted
+var sensitiveHeaders = []string{"Authorization", "Proxy-Authorization", "Cookie", "Set-Cookie"}
+
+// RedactString masks credentials in s: Bearer and Basic authorization
+// values, passwords in URLs, and access tokens, refresh tokens,
+// authorization codes, code verifiers, and client secrets in URLs, form
+// bodies, and JSON.
+//
+// The client applies it to everything it logs and to URLs and messages
+// embedded in errors.
+func RedactString(s string) string {
+	s = authSchemePattern.ReplaceAllString(s, "$1 "+Redacted)
+	s = jsonSecretPattern.ReplaceAllString(s, `"$1"$2"`+Redacted+`"`)
+	s = paramSecretPattern.ReplaceAllString(s, "$1="+Redacted)
+	s = userinfoSecretPattern.ReplaceAllString(s, "$1:"+Redacted+"@")
+	return s
+}
+
+// RedactHeaders returns a copy of headers with authorization and cookie
+// values masked
+func RedactHeaders(headers http.Header) http.Header {
+	if headers == nil {
+		return nil
+	}
+	redacted := headers.Clone()
+	for _, name := range sensitiveHeaders {
+		if values := redacted.Values(name); len(values) > 0 {
+			masked := make([]string, len(values))
+			for i := range masked {
+				masked[i] = Redacted
+			}
+			redacted[http.CanonicalHeaderKey(name)] = masked
+		}
+	}
+	return redacted
+}
+
+// redactBody formats a request body for logging with secrets masked.
+// Bodies are rendered in their wire form where possible so field names
+// are visible to RedactString.
+func redactBody(body interface{}) string {
+	var text string
+	switch b := body.(type) {
+	case url.Values:
+		text = b.Encode()
+	case fmt.Stringer:
+		text = b.String()
+	default:
+		if data, err := json.Marshal(body); err == nil {
+			text = string(data)
+		} else {
+			text = fmt.Sprintf("%v", body)
+		}
+	}
+	return RedactString(text)
+}
@@ -0,0 +1,136 @@
+package spotigo
+
+import (
+	"cmp"
+	"fmt"
+	"time"
+)
+
+// ============================================================================
+// Release Dates
+// ============================================================================
+
+// DatePrecision is how precise a release date is
+type DatePrecision string
+
+const (
+	PrecisionYear  DatePrecision = "year"  // Only the year is known, e.g. "1981"
+	PrecisionMonth DatePrecision = "month" // The year and month are known, e.g. "1981-12"
+	PrecisionDay   DatePrecision = "day"   // The full date is known, e.g. "1981-12-05"
+)
+
+// releaseDateLayouts are the formats of each precision
+var releaseDateLayouts = map[DatePrecision]string{
+	PrecisionYear:  "2006",
+	PrecisionMonth: "2006-01",
+	PrecisionDay:   "2006-01-02",
+}
+
+// ReleaseDate is an album or episode release date, which Spotify reports to
+// the year, month, or day. It decodes from and encodes to the JSON string;
+// the precision is inferred from the string's format.
+type ReleaseDate struct {
+	Year      int
+	Month     time.Month    // 0 with year precision
+	Day       int           // 0 unless the precision is PrecisionDay
+	Precision DatePrecision // "" for the zero value and unparsable dates
+
+	raw string // As received, kept for dates that do not parse
+}
+
+// ParseReleaseDate parses a date such as "1981", "1981-12", or "1981-12-05"
+func ParseReleaseDate(s string) (ReleaseDate, error) {
+	for _, precision := range []DatePrecision{PrecisionDay, PrecisionMonth, PrecisionYear} {
+		if len(s) != len(releaseDateLayouts[precision]) {
+			continue
+		}
+		t, err := time.Parse(releaseDateLayouts[precision], s)
+		if err != nil {
+			break
+		}
+		date := ReleaseDate{Year: t.Year(), Precision: precision, raw: s}
+		if precision != PrecisionYear {
+			date.Month = t.Month()
+		}
+		if precision == PrecisionDay {
+			date.Day = t.Day()
+		}
+		return date, nil
+	}
+	return ReleaseDate{}, fmt.Errorf("invalid release date: %q", s)
+}
+
+// IsZero reports whether the date is unset
+func (d ReleaseDate) IsZero() bool {
+	return d.Precision == "" && d.raw == ""
+}
+
+// String returns the date in Spotify's format, e.g. "1981-12"
+func (d ReleaseDate) String() string {
+	if d.Precision == "" {
+		return d.raw
+	}
+	return d.Time().Format(releaseDateLayouts[d.Precision])
+}
+
+// Time returns the start of the date's period in UTC: January 1 for a year,
+// the 1st for a month. Returns the zero time for unparsable dates.
+func (d ReleaseDate) Time() time.Time {
+	if d.Precision == "" {
+		return time.Time{}
+	}
+	return time.Date(d.Year, max(d.Month, 1), max(d.Day, 1), 0, 0, 0, 0, time.UTC)
+}
+
+// End returns the start of the period after the date's, so that a release
+// happened in [Time(), End())
+func (d ReleaseDate) End() time.Time {
+	start := d.Time()
+	switch d.Precision {
+	case PrecisionYear:
+		return start.AddDate(1, 0, 0)
+	case PrecisionMonth:
+		return start.AddDate(0, 1, 0)
+	case PrecisionDay:
+		return start.AddDate(0, 0, 1)
+	}
+	return start
+}
+
+// Compare returns -1, 0, or +1 as d is before, equal to, or after other.
+// Dates starting at the same time are ordered less precise first, as their
+// strings sort ("1981" < "1981-01" < "1981-01-01"); unset and unparsable
+// dates sort first.
+func (d ReleaseDate) Compare(other ReleaseDate) int {
+	return cmp.Or(
+		d.Time().Compare(other.Time()),
+		cmp.Compare(len(d.String()), len(other.String())),
+	)
+}
+
+// Before reports whether d is before other (see Compare)
+func (d ReleaseDate) Before(other ReleaseDate) bool {
+	return d.Compare(other) < 0
+}
+
+// After reports whether d is after other (see Compare)
+func (d ReleaseDate) After(other ReleaseDate) bool {
+	return d.Compare(other) > 0
+}
+
+// MarshalText implements encoding.TextMarshaler
+func (d ReleaseDate) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Dates in an unexpected
+// format are kept as received (see String) with no precision, rather than
+// failing the whole response.
+func (d *ReleaseDate) UnmarshalText(text []byte) error {
+	date, err := ParseReleaseDate(string(text))
+	if err != nil {
+		date = ReleaseDate{raw: string(text)}
+	}
+	*d = date
+	return nil
+}
@@ -0,0 +1,70 @@
+package spotigo
+
+import (
+	"context"
+)
+
+// ============================================================================
+// Track Relinking
+// ============================================================================
+
+// CanonicalID returns the ID the track was requested by. When Spotify
+// relinks a track that is unavailable in the requested market, ID is the
+// substituted playable track and LinkedFrom holds the original; otherwise
+// the two are the same.
+func (t *Track) CanonicalID() string {
+	if t.LinkedFrom != nil && t.LinkedFrom.ID != "" {
+		return t.LinkedFrom.ID
+	}
+	return t.ID
+}
+
+// CanonicalID returns the ID the track was requested by (see Track.CanonicalID)
+func (t *SimplifiedTrack) CanonicalID() string {
+	if t.LinkedFrom != nil && t.LinkedFrom.ID != "" {
+		return t.LinkedFrom.ID
+	}
+	return t.ID
+}
+
+// ResolveCanonicalTrackIDs looks up tracks in market and maps IDs to their
+// canonical IDs, so tracks can be de-duplicated and matched against saved
+// items across markets where Spotify swaps track IDs.
+//
+// The result is keyed by the IDs as passed in, plus the playable IDs Spotify
+// substituted for relinked tracks, so IDs later seen in playback or playlists
+// fetched with the same market resolve too. Tracks are looked up in batches
+// of 50. IDs Spotify does not know are left out of the map; IDs that cannot
+// be parsed are reported in a *MultiError, returned alongside the results
+// for valid IDs.
+//
+// Example:
+//
+//	canonical, err := client.ResolveCanonicalTrackIDs(ctx, ids, "DE")
+//	if err != nil {
+//		return err
+//	}
+//	seen := make(map[string]bool)
+//	for _, id := range ids {
+//		if seen[canonical[id]] {
+//			continue // Same song under another ID
+//		}
+//		seen[canonical[id]] = true
+//	}
+func (c *Client) ResolveCanonicalTrackIDs(ctx context.Context, ids []string, market string) (map[string]string, error) {
+	trackIDs, inputs, invalidIDs := uniqueIDs(ids, "track")
+	tracks, err := c.tracksByID(ctx, trackIDs, market)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]string, len(ids))
+	for id, track := range tracks {
+		canonical := track.CanonicalID()
+		for _, input := range inputs[id] {
+			result[input] = canonical
+		}
+		result[track.ID] = canonical
+	}
+	return result, invalidIDs.ErrorOrNil()
+}
@@ -0,0 +1,200 @@
+package spotigo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// ============================================================================
+// Remote Control Helpers
+// ============================================================================
+
+const (
+	// DefaultVolumeStep is the volume change used when VolumeUp or VolumeDown
+	// is called with a step of 0
+	DefaultVolumeStep = 10
+
+	// playerStateLag is how long the player state may still report values
+	// from before a command. Within this window the helpers trust the value
+	// they last sent over the one they read.
+	playerStateLag = 3 * time.Second
+)
+
+// playerRemote serializes read-modify-write helpers and remembers the last
+// commands they sent
+type playerRemote struct {
+	mu sync.Mutex
+
+	volumeDevice string
+	volume       int
+	volumeAt     time.Time
+
+	playingDevice string
+	playing       bool
+	playingAt     time.Time
+}
+
+// activePlaybackState reads the playback state and returns it along with
+// the active device ID
+func (c *Client) activePlaybackState(ctx context.Context) (*PlaybackState, string, error) {
+	state, err := c.CurrentUserPlaybackState(ctx, &CurrentlyPlayingOptions{AdditionalTypes: "track,episode"})
+	if err != nil {
+		return nil, "", err
+	}
+	if state.Device == nil || state.Device.ID == nil {
+		return nil, "", fmt.Errorf("no active playback device: %w", ErrDeviceNotFound)
+	}
+	if state.Device.IsRestricted {
+		return nil, "", fmt.Errorf("device %q does not accept remote commands", state.Device.Name)
+	}
+	return state, *state.Device.ID, nil
+}
+
+// TogglePlayback pauses playback if it is playing and resumes it otherwise,
+// on the active device. Returns whether playback is now playing.
+//
+// Calls are serialized, and a toggle shortly after another one builds on
+// the previous command rather than on player state that may not reflect it
+// yet, so rapid repeated toggles alternate as expected.
+//
+// Example:
+//
+//	playing, err := client.TogglePlayback(ctx)
+func (c *Client) TogglePlayback(ctx context.Context) (bool, error) {
+	c.remote.mu.Lock()
+	defer c.remote.mu.Unlock()
+
+	state, deviceID, err := c.activePlaybackState(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	playing := state.IsPlaying
+	if deviceID == c.remote.playingDevice && time.Since(c.remote.playingAt) < playerStateLag {
+		playing = c.remote.playing
+	}
+
+	if playing {
+		err = c.CurrentUserPausePlayback(ctx, &PausePlaybackOptions{DeviceID: deviceID})
+	} else {
+		err = c.CurrentUserStartPlayback(ctx, &StartPlaybackOptions{DeviceID: deviceID})
+	}
+	if err != nil {
+		return playing, err
+	}
+
+	c.remote.playingDevice = deviceID
+	c.remote.playing = !playing
+	c.remote.playingAt = time.Now()
+	return !playing, nil
+}
+
+// VolumeUp raises the active device's volume by step percent (0 uses
+// DefaultVolumeStep), capped at 100. Returns the new volume.
+//
+// Like TogglePlayback, calls are serialized and repeated nudges accumulate
+// even if the player state lags behind.
+func (c *Client) VolumeUp(ctx context.Context, step int) (int, error) {
+	if step < 0 {
+		return 0, fmt.Errorf("volume step must be non-negative, got %d", step)
+	}
+	if step == 0 {
+		step = DefaultVolumeStep
+	}
+	return c.nudgeVolume(ctx, step)
+}
+
+// VolumeDown lowers the active device's volume by step percent (0 uses
+// DefaultVolumeStep), stopping at 0. Returns the new volume.
+func (c *Client) VolumeDown(ctx context.Context, step int) (int, error) {
+	if step < 0 {
+		return 0, fmt.Errorf("volume step must be non-negative, got %d", step)
+	}
+	if step == 0 {
+		step = DefaultVolumeStep
+	}
+	return c.nudgeVolume(ctx, -step)
+}
+
+// nudgeVolume changes the active device's volume by delta percent
+func (c *Client) nudgeVolume(ctx context.Context, delta int) (int, error) {
+	c.remote.mu.Lock()
+	defer c.remote.mu.Unlock()
+
+	state, deviceID, err := c.activePlaybackState(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if state.Device.VolumePercent == nil {
+		return 0, fmt.Errorf("device %q does not support volume control", state.Device.Name)
+	}
+
+	current := *state.Device.VolumePercent
+	if deviceID == c.remote.volumeDevice && time.Since(c.remote.volumeAt) < playerStateLag {
+		current = c.remote.volume
+	}
+
+	volume := min(max(current+delta, 0), 100)
+	if volume == current {
+		return volume, nil
+	}
+
+	if err := c.CurrentUserSetVolume(ctx, &SetVolumeOptions{VolumePercent: volume, DeviceID: deviceID}); err != nil {
+		return current, err
+	}
+
+	c.remote.volumeDevice = deviceID
+	c.remote.volume = volume
+	c.remote.volumeAt = time.Now()
+	return volume, nil
+}
+
+// SeekRelative moves the playback position of the current item by delta,
+// which may be negative. The target is clamped to the start and end of the
+// item. Returns the new position.
+//
+// Example:
+//
+//	// Skip back 15 seconds
+//	pos, err := client.SeekRelative(ctx, -15*time.Second)
+func (c *Client) SeekRelative(ctx context.Context, delta time.Duration) (time.Duration, error) {
+	c.remote.mu.Lock()
+	defer c.remote.mu.Unlock()
+
+	state, deviceID, err := c.activePlaybackState(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if state.Item == nil {
+		return 0, fmt.Errorf("nothing is playing")
+	}
+
+	position := max(state.ProgressMs+int(delta.Milliseconds()), 0)
+	if duration := playbackItemDuration(state.Item); duration > 0 {
+		position = min(position, duration)
+	}
+
+	if err := c.CurrentUserSeekToPosition(ctx, &SeekToPositionOptions{PositionMs: position, DeviceID: deviceID}); err != nil {
+		return 0, err
+	}
+	return time.Duration(position) * time.Millisecond, nil
+}
+
+// playbackItemDuration returns the duration in milliseconds of a playback
+// state item (track or episode), or 0 if unknown
+func playbackItemDuration(item interface{}) int {
+	data, err := json.Marshal(item)
+	if err != nil {
+		return 0
+	}
+	var fields struct {
+		DurationMs int `json:"duration_ms"`
+	}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return 0
+	}
+	return fields.DurationMs
+}
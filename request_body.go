@@ -0,0 +1,211 @@
+package spotigo
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/textproto"
+	"net/url"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// ============================================================================
+// Request Body Encoding
+// ============================================================================
+
+// BodyEncoder encodes a request body, returning the encoded data and the
+// Content-Type header to send with it
+type BodyEncoder func(body interface{}) (io.Reader, string, error)
+
+// RawBody is a request body sent as-is with the given content type
+type RawBody struct {
+	ContentType string // Content-Type header (default: application/octet-stream)
+	Data        []byte // Body data
+}
+
+// String summarizes the body for request logging
+func (b RawBody) String() string {
+	return fmt.Sprintf("RawBody{%s, %d bytes}", b.ContentType, len(b.Data))
+}
+
+// Base64ImageBody is an image sent base64 encoded, as required by the
+// playlist cover image endpoint
+type Base64ImageBody struct {
+	ContentType string // Content-Type header (default: image/jpeg)
+	Data        []byte // Raw (unencoded) image data
+}
+
+// String summarizes the body for request logging
+func (b Base64ImageBody) String() string {
+	return fmt.Sprintf("Base64ImageBody{%s, %d bytes}", b.ContentType, len(b.Data))
+}
+
+// MultipartBody is a multipart/form-data request body
+type MultipartBody struct {
+	Fields map[string]string // Plain form fields
+	Files  []MultipartFile   // File parts
+}
+
+// MultipartFile is a file part of a MultipartBody
+type MultipartFile struct {
+	FieldName   string // Form field name
+	FileName    string // File name reported to the server
+	ContentType string // Content-Type of the part (default: application/octet-stream)
+	Data        []byte // File contents
+}
+
+// defaultBodyEncoders maps body types to their encoders. Types without an
+// encoder are JSON encoded.
+var defaultBodyEncoders = map[reflect.Type]BodyEncoder{
+	reflect.TypeOf(url.Values{}):       encodeFormBody,
+	reflect.TypeOf(RawBody{}):          encodeRawBody,
+	reflect.TypeOf(&RawBody{}):         encodeRawBody,
+	reflect.TypeOf(Base64ImageBody{}):  encodeBase64ImageBody,
+	reflect.TypeOf(&Base64ImageBody{}): encodeBase64ImageBody,
+	reflect.TypeOf(MultipartBody{}):    encodeMultipartBody,
+	reflect.TypeOf(&MultipartBody{}):   encodeMultipartBody,
+}
+
+// WithBodyEncoder registers an encoder for request bodies of the given type,
+// overriding the default encoder for that type.
+//
+// Example:
+//
+//	client, err := spotigo.NewClient(auth, spotigo.WithBodyEncoder(
+//		reflect.TypeOf(MyUpload{}),
+//		func(body interface{}) (io.Reader, string, error) {
+//			upload := body.(MyUpload)
+//			return bytes.NewReader(upload.Data), "audio/mpeg", nil
+//		},
+//	))
+func WithBodyEncoder(bodyType reflect.Type, encoder BodyEncoder) ClientOption {
+	return func(c *Client) {
+		if c.bodyEncoders == nil {
+			c.bodyEncoders = make(map[reflect.Type]BodyEncoder)
+		}
+		c.bodyEncoders[bodyType] = encoder
+	}
+}
+
+// encodeBody encodes a request body using the encoder registered for its
+// type, falling back to JSON
+func (c *Client) encodeBody(body interface{}) (io.Reader, string, error) {
+	bodyType := reflect.TypeOf(body)
+	if encoder, ok := c.bodyEncoders[bodyType]; ok {
+		return encoder(body)
+	}
+	if encoder, ok := defaultBodyEncoders[bodyType]; ok {
+		return encoder(body)
+	}
+	return c.encodeJSONBody(body)
+}
+
+// encodeJSONBody encodes body as JSON with the client's codec
+func (c *Client) encodeJSONBody(body interface{}) (io.Reader, string, error) {
+	jsonData, err := c.codec().Marshal(body)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to marshal request body: %w", err)
+	}
+	return bytes.NewReader(jsonData), "application/json", nil
+}
+
+// encodeFormBody encodes url.Values as application/x-www-form-urlencoded
+func encodeFormBody(body interface{}) (io.Reader, string, error) {
+	formData, ok := body.(url.Values)
+	if !ok {
+		return nil, "", fmt.Errorf("unsupported form body type %T", body)
+	}
+	return strings.NewReader(formData.Encode()), "application/x-www-form-urlencoded", nil
+}
+
+// encodeRawBody sends a RawBody unchanged
+func encodeRawBody(body interface{}) (io.Reader, string, error) {
+	var raw RawBody
+	switch b := body.(type) {
+	case RawBody:
+		raw = b
+	case *RawBody:
+		raw = *b
+	default:
+		return nil, "", fmt.Errorf("unsupported raw body type %T", body)
+	}
+	contentType := raw.ContentType
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	return bytes.NewReader(raw.Data), contentType, nil
+}
+
+// encodeBase64ImageBody base64 encodes a Base64ImageBody
+func encodeBase64ImageBody(body interface{}) (io.Reader, string, error) {
+	var image Base64ImageBody
+	switch b := body.(type) {
+	case Base64ImageBody:
+		image = b
+	case *Base64ImageBody:
+		image = *b
+	default:
+		return nil, "", fmt.Errorf("unsupported image body type %T", body)
+	}
+	contentType := image.ContentType
+	if contentType == "" {
+		contentType = "image/jpeg"
+	}
+	encoded := base64.StdEncoding.EncodeToString(image.Data)
+	return strings.NewReader(encoded), contentType, nil
+}
+
+// encodeMultipartBody encodes a MultipartBody as multipart/form-data
+func encodeMultipartBody(body interface{}) (io.Reader, string, error) {
+	var form MultipartBody
+	switch b := body.(type) {
+	case MultipartBody:
+		form = b
+	case *MultipartBody:
+		form = *b
+	default:
+		return nil, "", fmt.Errorf("unsupported multipart body type %T", body)
+	}
+
+	var buf bytes.Buffer
+	writer := multipart.NewWriter(&buf)
+
+	names := make([]string, 0, len(form.Fields))
+	for name := range form.Fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if err := writer.WriteField(name, form.Fields[name]); err != nil {
+			return nil, "", fmt.Errorf("failed to write multipart field %s: %w", name, err)
+		}
+	}
+
+	for _, file := range form.Files {
+		contentType := file.ContentType
+		if contentType == "" {
+			contentType = "application/octet-stream"
+		}
+		header := make(textproto.MIMEHeader)
+		header.Set("Content-Disposition", fmt.Sprintf(`form-data; name=%q; filename=%q`, file.FieldName, file.FileName))
+		header.Set("Content-Type", contentType)
+
+		part, err := writer.CreatePart(header)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to create multipart file %s: %w", file.FileName, err)
+		}
+		if _, err := part.Write(file.Data); err != nil {
+			return nil, "", fmt.Errorf("failed to write multipart file %s: %w", file.FileName, err)
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, "", fmt.Errorf("failed to finish multipart body: %w", err)
+	}
+
+	return &buf, writer.FormDataContentType(), nil
+}
@@ -0,0 +1,56 @@
+package spotigo
+
+import (
+	"io"
+)
+
+// ============================================================================
+// Response Size Limit
+// ============================================================================
+
+// WithMaxResponseBytes caps how much of a response body the client reads,
+// both as received and after decompression, so a misconfigured APIPrefix
+// pointing at something that streams forever, or a decompression bomb,
+// cannot exhaust memory. Larger responses fail with a
+// *ResponseTooLargeError and are not retried. 0 (the default) means no limit.
+//
+// Example:
+//
+//	client, err := spotigo.NewClient(auth, spotigo.WithMaxResponseBytes(32<<20))
+func WithMaxResponseBytes(limit int64) ClientOption {
+	return func(c *Client) {
+		c.MaxResponseBytes = limit
+	}
+}
+
+// limitResponse wraps a response body reader with the client's size limit
+func (c *Client) limitResponse(r io.Reader) io.Reader {
+	if c.MaxResponseBytes <= 0 {
+		return r
+	}
+	return &limitedReader{r: r, limit: c.MaxResponseBytes}
+}
+
+// limitedReader fails with a *ResponseTooLargeError once more than limit
+// bytes have been read
+type limitedReader struct {
+	r     io.Reader
+	limit int64
+	n     int64
+}
+
+func (l *limitedReader) Read(p []byte) (int, error) {
+	if l.n > l.limit {
+		return 0, &ResponseTooLargeError{Limit: l.limit, Read: l.n}
+	}
+	// Read at most one byte past the limit to tell an exact fit from an overrun
+	if remaining := l.limit - l.n + 1; int64(len(p)) > remaining {
+		p = p[:remaining]
+	}
+	n, err := l.r.Read(p)
+	l.n += int64(n)
+	if l.n > l.limit {
+		return n, &ResponseTooLargeError{Limit: l.limit, Read: l.n}
+	}
+	return n, err
+}
@@ -0,0 +1,65 @@
+package spotigo
+
+// ============================================================================
+// Per-Status Retry Policies
+// ============================================================================
+
+// Status families for RetryConfig.StatusPolicies, matching any status in
+// the family that has no policy of its own
+const (
+	StatusFamily4xx = 4
+	StatusFamily5xx = 5
+)
+
+// StatusRetryPolicy controls retries of responses with one status code or
+// status family, in place of StatusForcelist and StatusRetries
+type StatusRetryPolicy struct {
+	MaxRetries int             // Retries of this status per request; 0 never retries it
+	Backoff    BackoffStrategy // Delay between retries (default: RetryConfig's backoff)
+}
+
+// statusPolicy returns the policy for statusCode, preferring one for the
+// exact status over one for its family
+func (r *RetryConfig) statusPolicy(statusCode int) (StatusRetryPolicy, bool) {
+	if policy, ok := r.StatusPolicies[statusCode]; ok {
+		return policy, true
+	}
+	policy, ok := r.StatusPolicies[statusCode/100]
+	return policy, ok
+}
+
+// maxAttempts returns the number of retries the request loop allows, which
+// policies may raise above MaxRetries
+func (r *RetryConfig) maxAttempts() int {
+	attempts := r.MaxRetries
+	for _, policy := range r.StatusPolicies {
+		attempts = max(attempts, policy.MaxRetries)
+	}
+	return attempts
+}
+
+// WithStatusRetryPolicy sets the retry policy for a status code, or for a
+// status family with StatusFamily4xx or StatusFamily5xx.
+//
+// Example:
+//
+//	// Retry rate limits patiently and gateway errors briefly, never 500
+//	client, err := spotigo.NewClient(auth,
+//		spotigo.WithStatusRetryPolicy(429, spotigo.StatusRetryPolicy{MaxRetries: 10}),
+//		spotigo.WithStatusRetryPolicy(503, spotigo.StatusRetryPolicy{
+//			MaxRetries: 2,
+//			Backoff:    spotigo.NewExponentialBackoff(time.Second, 5*time.Second),
+//		}),
+//		spotigo.WithStatusRetryPolicy(500, spotigo.StatusRetryPolicy{}),
+//	)
+func WithStatusRetryPolicy(status int, policy StatusRetryPolicy) ClientOption {
+	return func(c *Client) {
+		if c.RetryConfig == nil {
+			c.RetryConfig = DefaultRetryConfig()
+		}
+		if c.RetryConfig.StatusPolicies == nil {
+			c.RetryConfig.StatusPolicies = make(map[int]StatusRetryPolicy)
+		}
+		c.RetryConfig.StatusPolicies[status] = policy
+	}
+}
@@ -0,0 +1,137 @@
+package spotigo
+
+import (
+	"context"
+	"iter"
+)
+
+// ============================================================================
+// Saved Library Pagination
+// ============================================================================
+
+// LibraryIterator iterates over one of the current user's saved collections,
+// fetching pages lazily as the loop advances. Iteration stops after the
+// first error, which is yielded with a zero T.
+//
+// Example:
+//
+//	for saved, err := range client.CurrentUserSavedShowsIter(ctx, nil) {
+//		if err != nil {
+//			return err
+//		}
+//		fmt.Println(saved.Show.Name)
+//	}
+type LibraryIterator[T any] iter.Seq2[T, error]
+
+// Collect reads every remaining item into a slice
+func (it LibraryIterator[T]) Collect() ([]T, error) {
+	var items []T
+	for item, err := range it {
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+// libraryIter returns an iterator that fetches the first page with first
+// and follows Next links from there
+func libraryIter[T any](c *Client, ctx context.Context, first func() (*Paging[T], error)) LibraryIterator[T] {
+	return func(yield func(T, error) bool) {
+		page, err := first()
+		if err != nil {
+			var zero T
+			yield(zero, err)
+			return
+		}
+		for item, err := range IteratePages(c, ctx, page) {
+			if !yield(item, err) {
+				return
+			}
+		}
+	}
+}
+
+// libraryPageOptions copies opts, defaulting the page size to 50
+func libraryPageOptions[O any](opts *O, limit func(*O) *int) O {
+	var pageOpts O
+	if opts != nil {
+		pageOpts = *opts
+	}
+	if l := limit(&pageOpts); *l == 0 {
+		*l = 50
+	}
+	return pageOpts
+}
+
+// CurrentUserSavedTracksIter returns an iterator over the current user's
+// saved tracks. opts.Limit controls the page size (default: 50);
+// opts.Offset sets where to start.
+func (c *Client) CurrentUserSavedTracksIter(ctx context.Context, opts *SavedTracksOptions) LibraryIterator[SavedTrack] {
+	pageOpts := libraryPageOptions(opts, func(o *SavedTracksOptions) *int { return &o.Limit })
+	return libraryIter(c, ctx, func() (*Paging[SavedTrack], error) { return c.CurrentUserSavedTracks(ctx, &pageOpts) })
+}
+
+// CurrentUserSavedAlbumsIter returns an iterator over the current user's
+// saved albums (see CurrentUserSavedTracksIter)
+func (c *Client) CurrentUserSavedAlbumsIter(ctx context.Context, opts *SavedAlbumsOptions) LibraryIterator[SavedAlbum] {
+	pageOpts := libraryPageOptions(opts, func(o *SavedAlbumsOptions) *int { return &o.Limit })
+	return libraryIter(c, ctx, func() (*Paging[SavedAlbum], error) { return c.CurrentUserSavedAlbums(ctx, &pageOpts) })
+}
+
+// CurrentUserSavedEpisodesIter returns an iterator over the current user's
+// saved episodes (see CurrentUserSavedTracksIter)
+func (c *Client) CurrentUserSavedEpisodesIter(ctx context.Context, opts *SavedEpisodesOptions) LibraryIterator[SavedEpisode] {
+	pageOpts := libraryPageOptions(opts, func(o *SavedEpisodesOptions) *int { return &o.Limit })
+	return libraryIter(c, ctx, func() (*Paging[SavedEpisode], error) { return c.CurrentUserSavedEpisodes(ctx, &pageOpts) })
+}
+
+// CurrentUserSavedShowsIter returns an iterator over the current user's
+// saved shows (see CurrentUserSavedTracksIter)
+func (c *Client) CurrentUserSavedShowsIter(ctx context.Context, opts *SavedShowsOptions) LibraryIterator[SavedShow] {
+	pageOpts := libraryPageOptions(opts, func(o *SavedShowsOptions) *int { return &o.Limit })
+	return libraryIter(c, ctx, func() (*Paging[SavedShow], error) { return c.CurrentUserSavedShows(ctx, &pageOpts) })
+}
+
+// CurrentUserSavedAudiobooksIter returns an iterator over the current
+// user's saved audiobooks (see CurrentUserSavedTracksIter)
+func (c *Client) CurrentUserSavedAudiobooksIter(ctx context.Context, opts *SavedAudiobooksOptions) LibraryIterator[SimplifiedAudiobook] {
+	pageOpts := libraryPageOptions(opts, func(o *SavedAudiobooksOptions) *int { return &o.Limit })
+	return libraryIter(c, ctx, func() (*Paging[SimplifiedAudiobook], error) { return c.CurrentUserSavedAudiobooks(ctx, &pageOpts) })
+}
+
+// CurrentUserSavedTracksAll retrieves every track in the current user's
+// library. For large libraries, use CurrentUserSavedTracksIter to avoid
+// holding every item in memory.
+//
+// Example:
+//
+//	tracks, err := client.CurrentUserSavedTracksAll(ctx, nil)
+func (c *Client) CurrentUserSavedTracksAll(ctx context.Context, opts *SavedTracksOptions) ([]SavedTrack, error) {
+	return c.CurrentUserSavedTracksIter(ctx, opts).Collect()
+}
+
+// CurrentUserSavedAlbumsAll retrieves every album in the current user's
+// library
+func (c *Client) CurrentUserSavedAlbumsAll(ctx context.Context, opts *SavedAlbumsOptions) ([]SavedAlbum, error) {
+	return c.CurrentUserSavedAlbumsIter(ctx, opts).Collect()
+}
+
+// CurrentUserSavedEpisodesAll retrieves every episode in the current user's
+// library
+func (c *Client) CurrentUserSavedEpisodesAll(ctx context.Context, opts *SavedEpisodesOptions) ([]SavedEpisode, error) {
+	return c.CurrentUserSavedEpisodesIter(ctx, opts).Collect()
+}
+
+// CurrentUserSavedShowsAll retrieves every show in the current user's
+// library
+func (c *Client) CurrentUserSavedShowsAll(ctx context.Context, opts *SavedShowsOptions) ([]SavedShow, error) {
+	return c.CurrentUserSavedShowsIter(ctx, opts).Collect()
+}
+
+// CurrentUserSavedAudiobooksAll retrieves every audiobook in the current
+// user's library
+func (c *Client) CurrentUserSavedAudiobooksAll(ctx context.Context, opts *SavedAudiobooksOptions) ([]SimplifiedAudiobook, error) {
+	return c.CurrentUserSavedAudiobooksIter(ctx, opts).Collect()
+}
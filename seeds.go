@@ -0,0 +1,156 @@
+package spotigo
+
+import (
+	"context"
+	"slices"
+)
+
+// ============================================================================
+// Recommendation Seeds
+// ============================================================================
+
+// maxSeeds is the most seeds, across artists, tracks, and genres, that
+// Recommendations accepts
+const maxSeeds = 5
+
+// SeedSet collects recommendation seeds within Spotify's combined limit of
+// 5. Artist and track seeds are normalized from URIs and URLs to IDs, genres
+// to lowercase with hyphens, and duplicates are dropped. The zero value is an
+// empty set.
+//
+// Example:
+//
+//	var seeds spotigo.SeedSet
+//	if err := seeds.AddTracks(trackURL); err != nil {
+//		return err
+//	}
+//	if err := seeds.AddGenres("Hip Hop", "jazz"); err != nil {
+//		return err
+//	}
+//	if err := client.ValidateSeeds(ctx, &seeds); err != nil {
+//		return err
+//	}
+//	recs, err := client.Recommendations(ctx, seeds.Options())
+type SeedSet struct {
+	artists []string
+	tracks  []string
+	genres  []string
+}
+
+// AddArtists adds artist seeds (IDs, URIs, or URLs). Nothing is added if
+// any is invalid or the set would exceed 5 seeds.
+func (s *SeedSet) AddArtists(artists ...string) error {
+	ids, err := normalizeSeedIDs(artists, "artist")
+	if err != nil {
+		return err
+	}
+	return s.add(&s.artists, ids)
+}
+
+// AddTracks adds track seeds (IDs, URIs, or URLs). Nothing is added if any
+// is invalid or the set would exceed 5 seeds.
+func (s *SeedSet) AddTracks(tracks ...string) error {
+	ids, err := normalizeSeedIDs(tracks, "track")
+	if err != nil {
+		return err
+	}
+	return s.add(&s.tracks, ids)
+}
+
+// AddGenres adds genre seeds, normalized so that "Hip Hop" becomes
+// "hip-hop". Whether Spotify offers them is checked by ValidateSeeds.
+// Nothing is added if the set would exceed 5 seeds.
+func (s *SeedSet) AddGenres(genres ...string) error {
+	normalized := make([]string, 0, len(genres))
+	for _, genre := range genres {
+		genre = normalizeGenreSeed(genre)
+		if genre == "" {
+			return &MissingOptionError{Field: "genre"}
+		}
+		normalized = append(normalized, genre)
+	}
+	return s.add(&s.genres, normalized)
+}
+
+// add appends the values not already in *dst, if they fit within maxSeeds
+func (s *SeedSet) add(dst *[]string, values []string) error {
+	added := slices.Clone(*dst)
+	for _, value := range values {
+		if !slices.Contains(added, value) {
+			added = append(added, value)
+		}
+	}
+
+	total := s.Len() - len(*dst) + len(added)
+	if total > maxSeeds {
+		return &TooManyIDsError{Kind: "seeds", Max: maxSeeds, Got: total}
+	}
+	*dst = added
+	return nil
+}
+
+// normalizeSeedIDs extracts the IDs of entityType items
+func normalizeSeedIDs(items []string, entityType string) ([]string, error) {
+	ids := make([]string, len(items))
+	for i, item := range items {
+		id, err := GetID(item, entityType)
+		if err != nil {
+			return nil, err
+		}
+		ids[i] = id
+	}
+	return ids, nil
+}
+
+// Len returns the number of seeds in the set
+func (s *SeedSet) Len() int {
+	return len(s.artists) + len(s.tracks) + len(s.genres)
+}
+
+// Artists returns the artist seed IDs
+func (s *SeedSet) Artists() []string {
+	return slices.Clone(s.artists)
+}
+
+// Tracks returns the track seed IDs
+func (s *SeedSet) Tracks() []string {
+	return slices.Clone(s.tracks)
+}
+
+// Genres returns the normalized genre seeds
+func (s *SeedSet) Genres() []string {
+	return slices.Clone(s.genres)
+}
+
+// Options returns RecommendationsOptions with the set's seeds, for adding
+// tunable attributes before calling Recommendations
+func (s *SeedSet) Options() *RecommendationsOptions {
+	return &RecommendationsOptions{
+		SeedArtists: s.Artists(),
+		SeedTracks:  s.Tracks(),
+		SeedGenres:  s.Genres(),
+	}
+}
+
+// ValidateSeeds checks a seed set's genres against GenreSeeds, returning an
+// *InvalidSeedGenreError for the first unknown one. No request is made for
+// sets without genres.
+func (c *Client) ValidateSeeds(ctx context.Context, seeds *SeedSet) error {
+	if seeds == nil || seeds.Len() == 0 {
+		return &MissingOptionError{Field: "seeds"}
+	}
+	if len(seeds.genres) == 0 {
+		return nil
+	}
+
+	available, err := c.GenreSeeds(ctx)
+	if err != nil {
+		return err
+	}
+	for _, genre := range seeds.genres {
+		if !slices.Contains(available, genre) {
+			return &InvalidSeedGenreError{Genre: genre}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,152 @@
+package spotigo
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// ============================================================================
+// Share Links
+// ============================================================================
+
+// maxShareLinkRedirects is how many redirects ResolveShareLink follows
+const maxShareLinkRedirects = 5
+
+// maxShareLinkBody is how much of an interstitial page ResolveShareLink reads
+const maxShareLinkBody = 64 << 10
+
+// shareLinkHosts are the hosts a share link may pass through on its way to
+// open.spotify.com. Requests never go anywhere else.
+var shareLinkHosts = map[string]bool{
+	"spotify.link":     true,
+	"spotify.app.link": true,
+}
+
+// embeddedSpotifyURL finds an open.spotify.com URL in an interstitial page
+var embeddedSpotifyURL = regexp.MustCompile(`https://open\.spotify\.com/[^"'<>\s\\]+`)
+
+// IsShareLink reports whether link is a short share link (spotify.link) that
+// must be resolved with ResolveShareLink before GetID can parse it
+func IsShareLink(link string) bool {
+	u, err := url.Parse(strings.TrimSpace(link))
+	return err == nil && shareLinkHosts[strings.ToLower(u.Hostname())]
+}
+
+// ResolveShareLink follows a short share link, like the spotify.link URLs the
+// mobile apps share, and returns the canonical open.spotify.com URL it points
+// to, e.g. "https://open.spotify.com/track/4iV5W9uYEdYUVa79Axb7Rh". Other
+// Spotify URLs and URIs are returned unchanged.
+//
+// Only HTTPS requests to Spotify's link hosts are made: redirects anywhere
+// else are refused rather than followed, so untrusted input cannot make the
+// client fetch internal addresses.
+//
+// Example:
+//
+//	link, err := client.ResolveShareLink(ctx, "https://spotify.link/ABCdef123")
+//	if err != nil {
+//		return err
+//	}
+//	kind, id, err := spotigo.ParseAny(link)
+func (c *Client) ResolveShareLink(ctx context.Context, link string) (string, error) {
+	link = strings.TrimSpace(link)
+	if !IsShareLink(link) {
+		if _, _, err := ParseAny(link); err != nil {
+			return "", err
+		}
+		return link, nil
+	}
+
+	// Follow redirects one at a time to check each hop
+	client := &http.Client{}
+	if c.HTTPClient != nil {
+		*client = *c.HTTPClient
+	}
+	client.CheckRedirect = func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	}
+
+	current := link
+	for range maxShareLinkRedirects + 1 {
+		target, err := url.Parse(current)
+		if err != nil {
+			return "", fmt.Errorf("invalid share link redirect %q: %w", current, err)
+		}
+		if canonical, ok := canonicalSpotifyURL(target); ok {
+			return canonical, nil
+		}
+		if target.Scheme != "https" || target.User != nil || target.Port() != "" || !shareLinkHosts[strings.ToLower(target.Hostname())] {
+			return "", fmt.Errorf("share link %s redirects to disallowed URL %s", link, target.Redacted())
+		}
+
+		next, err := c.followShareLink(ctx, client, target)
+		if err != nil {
+			return "", fmt.Errorf("resolving share link %s: %w", link, err)
+		}
+		current = next
+	}
+
+	return "", fmt.Errorf("share link %s: too many redirects", link)
+}
+
+// followShareLink requests one hop of a share link and returns where it
+// leads: the redirect location, or the Spotify URL an interstitial page
+// links to
+func (c *Client) followShareLink(ctx context.Context, client *http.Client, target *url.URL) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target.String(), nil)
+	if err != nil {
+		return "", err
+	}
+	if c.UserAgent != "" {
+		req.Header.Set("User-Agent", c.UserAgent)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 && resp.StatusCode < 400 {
+		location, err := resp.Location()
+		if err != nil {
+			return "", fmt.Errorf("redirect without a location (status %d)", resp.StatusCode)
+		}
+		return location.String(), nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+
+	// Browsers get a page that redirects with JavaScript
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxShareLinkBody))
+	if err != nil {
+		return "", err
+	}
+	for _, match := range embeddedSpotifyURL.FindAllString(string(body), -1) {
+		if u, err := url.Parse(match); err == nil {
+			if canonical, ok := canonicalSpotifyURL(u); ok {
+				return canonical, nil
+			}
+		}
+	}
+	return "", fmt.Errorf("no Spotify URL found at %s", target.Redacted())
+}
+
+// canonicalSpotifyURL returns u as "https://open.spotify.com/<type>/<id>" if
+// it is an open.spotify.com item URL
+func canonicalSpotifyURL(u *url.URL) (string, bool) {
+	if u.Scheme != "https" || !strings.EqualFold(u.Hostname(), "open.spotify.com") {
+		return "", false
+	}
+	kind, id, err := ParseAny("https://open.spotify.com" + u.EscapedPath())
+	if err != nil {
+		return "", false
+	}
+	return fmt.Sprintf("https://open.spotify.com/%s/%s", kind, id), true
+}
@@ -0,0 +1,94 @@
+package spotigo
+
+import (
+	"context"
+	"time"
+)
+
+// ============================================================================
+// New Show Episodes
+// ============================================================================
+
+// ShowNewEpisodesSince returns a show's episodes released after since,
+// newest first, for feed builders polling for new episodes. Spotify lists
+// episodes newest first, so pages are fetched (50 at a time) only until an
+// older episode appears. Episodes unavailable in the market are left out.
+//
+// Release dates are often only precise to the day, so an episode counts as
+// new if its release day (or month, or year) ends after since. An episode
+// released on the day of since may be returned again by the next poll;
+// de-duplicate by ID.
+//
+// Example:
+//
+//	episodes, err := client.ShowNewEpisodesSince(ctx, showID, lastPoll, "US")
+//	if err != nil {
+//		return err
+//	}
+//	for _, episode := range episodes {
+//		feed.Add(episode.ID, episode.Name, episode.ReleaseDate)
+//	}
+func (c *Client) ShowNewEpisodesSince(ctx context.Context, showID string, since time.Time, market ...string) ([]SimplifiedEpisode, error) {
+	opts := &ShowEpisodesOptions{Limit: 50}
+	if len(market) > 0 {
+		opts.Market = market[0]
+	}
+	first, err := c.ShowEpisodes(ctx, showID, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	episodes := []SimplifiedEpisode{}
+	for episode, err := range IteratePages(c, ctx, first) {
+		if err != nil {
+			return nil, err
+		}
+		if episode.ID == "" {
+			continue // Unavailable in the market
+		}
+		if !releasedAfter(episode.ReleaseDate, since) {
+			break
+		}
+		episodes = append(episodes, episode)
+	}
+
+	return episodes, nil
+}
+
+// ShowsNewEpisodesSince runs ShowNewEpisodesSince for several shows. The
+// result is keyed by the shows as passed in. Shows that fail are left out of
+// the map and reported in a *MultiError, returned alongside the others.
+//
+// Example:
+//
+//	byShow, err := client.ShowsNewEpisodesSince(ctx, subscribedShows, lastPoll, "US")
+//	var failed *spotigo.MultiError
+//	if err != nil && !errors.As(err, &failed) {
+//		return err
+//	}
+func (c *Client) ShowsNewEpisodesSince(ctx context.Context, showIDs []string, since time.Time, market ...string) (map[string][]SimplifiedEpisode, error) {
+	result := make(map[string][]SimplifiedEpisode, len(showIDs))
+	failed := &MultiError{}
+	for i, showID := range showIDs {
+		if _, done := result[showID]; done {
+			continue
+		}
+		episodes, err := c.ShowNewEpisodesSince(ctx, showID, since, market...)
+		if err != nil {
+			failed.Add(i, showID, err)
+			continue
+		}
+		result[showID] = episodes
+	}
+
+	return result, failed.ErrorOrNil()
+}
+
+// releasedAfter reports whether a release date ends after since. Dates that
+// cannot be parsed count as new, so they are not silently dropped.
+func releasedAfter(date ReleaseDate, since time.Time) bool {
+	if date.Precision == "" {
+		return true
+	}
+	return date.End().After(since)
+}
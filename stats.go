@@ -0,0 +1,204 @@
+package spotigo
+
+import (
+	"hash/fnv"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+)
+
+// ============================================================================
+// Client Statistics
+// ============================================================================
+
+// ClientStats is a snapshot of the client's request counters, suitable for
+// health endpoints and periodic logging
+type ClientStats struct {
+	Requests       int64            // HTTP requests sent, including retries
+	StatusCodes    map[int]int64    // Responses by HTTP status code
+	NetworkErrors  int64            // Requests that failed without a response
+	Retries        int64            // Attempts made after a failed first attempt
+	RateLimited    int64            // 429 Too Many Requests responses
+	BytesSent      int64            // Request body bytes sent
+	BytesReceived  int64            // Response body bytes received
+	AverageLatency time.Duration    // Mean time from sending a request to reading its response
+	CacheHits      int64            // CurrentUserDevices calls served from the device cache
+	CacheMisses    int64            // CurrentUserDevices calls that fetched devices
+	TokenRefreshes int64            // Times the auth manager returned a new access token
+	Latency        LatencyHistogram // Response latencies by bucket
+	Since          time.Time        // When counting started (client creation or ResetStats)
+}
+
+// latencyBuckets are the upper bounds of the LatencyHistogram buckets
+var latencyBuckets = []time.Duration{
+	5 * time.Millisecond, 10 * time.Millisecond, 25 * time.Millisecond,
+	50 * time.Millisecond, 100 * time.Millisecond, 250 * time.Millisecond,
+	500 * time.Millisecond, time.Second, 2500 * time.Millisecond,
+	5 * time.Second, 10 * time.Second,
+}
+
+// LatencyHistogram counts response latencies by bucket, for exporting as a
+// Prometheus-style histogram
+type LatencyHistogram struct {
+	Bounds []time.Duration // Upper bounds of the buckets, ascending
+	Counts []int64         // Cumulative: Counts[i] responses took at most Bounds[i]
+	Count  int64           // All responses, including those above the last bucket
+	Sum    time.Duration   // Total latency of all responses
+}
+
+// CacheHitRate returns the fraction of cacheable calls served from the
+// cache, or 0 if none were made
+func (s ClientStats) CacheHitRate() float64 {
+	total := s.CacheHits + s.CacheMisses
+	if total == 0 {
+		return 0
+	}
+	return float64(s.CacheHits) / float64(total)
+}
+
+// statsTracker accumulates request counters
+type statsTracker struct {
+	mu           sync.Mutex
+	stats        ClientStats
+	totalLatency time.Duration
+	latencyCount int64
+	buckets      []int64 // Responses per latency bucket, not cumulative
+	tokenHash    uint64  // Hash of the last access token, to detect refreshes
+}
+
+// reset clears all counters
+func (t *statsTracker) reset(now time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.stats = ClientStats{Since: now}
+	t.totalLatency = 0
+	t.latencyCount = 0
+	t.buckets = nil
+}
+
+// recordRequest counts a request about to be sent
+func (t *statsTracker) recordRequest(bodyBytes int64, retry bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.stats.Requests++
+	if bodyBytes > 0 {
+		t.stats.BytesSent += bodyBytes
+	}
+	if retry {
+		t.stats.Retries++
+	}
+}
+
+// recordNetworkError counts a request that failed without a response
+func (t *statsTracker) recordNetworkError() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.stats.NetworkErrors++
+}
+
+// recordResponse counts a response and its latency
+func (t *statsTracker) recordResponse(statusCode int, bodyBytes int, latency time.Duration) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.stats.StatusCodes == nil {
+		t.stats.StatusCodes = make(map[int]int64)
+	}
+	t.stats.StatusCodes[statusCode]++
+	if statusCode == http.StatusTooManyRequests {
+		t.stats.RateLimited++
+	}
+	t.stats.BytesReceived += int64(bodyBytes)
+	t.totalLatency += latency
+	t.latencyCount++
+
+	if t.buckets == nil {
+		t.buckets = make([]int64, len(latencyBuckets))
+	}
+	for i, bound := range latencyBuckets {
+		if latency <= bound {
+			t.buckets[i]++
+			break
+		}
+	}
+}
+
+// recordToken counts a refresh when the access token differs from the last
+// one seen. Only a hash of the token is kept.
+func (t *statsTracker) recordToken(token string) {
+	hash := fnv.New64a()
+	hash.Write([]byte(token))
+	sum := hash.Sum64()
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.tokenHash != 0 && t.tokenHash != sum {
+		t.stats.TokenRefreshes++
+	}
+	t.tokenHash = sum
+}
+
+// recordCache counts a cache lookup
+func (t *statsTracker) recordCache(hit bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if hit {
+		t.stats.CacheHits++
+	} else {
+		t.stats.CacheMisses++
+	}
+}
+
+// get returns a copy of the current counters
+func (t *statsTracker) get() ClientStats {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	stats := t.stats
+	stats.StatusCodes = make(map[int]int64, len(t.stats.StatusCodes))
+	for code, count := range t.stats.StatusCodes {
+		stats.StatusCodes[code] = count
+	}
+	if t.latencyCount > 0 {
+		stats.AverageLatency = t.totalLatency / time.Duration(t.latencyCount)
+	}
+
+	stats.Latency = LatencyHistogram{
+		Bounds: slices.Clone(latencyBuckets),
+		Counts: make([]int64, len(latencyBuckets)),
+		Count:  t.latencyCount,
+		Sum:    t.totalLatency,
+	}
+	var cumulative int64
+	for i := range latencyBuckets {
+		if t.buckets != nil {
+			cumulative += t.buckets[i]
+		}
+		stats.Latency.Counts[i] = cumulative
+	}
+	return stats
+}
+
+// Stats returns a snapshot of the client's request counters
+//
+// Example:
+//
+//	http.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
+//		stats := client.Stats()
+//		fmt.Fprintf(w, "requests=%d rate_limited=%d avg_latency=%s\n",
+//			stats.Requests, stats.RateLimited, stats.AverageLatency)
+//	})
+func (c *Client) Stats() ClientStats {
+	return c.stats.get()
+}
+
+// ResetStats clears the client's request counters
+func (c *Client) ResetStats() {
+	c.stats.reset(time.Now())
+}
@@ -0,0 +1,221 @@
+package spotigo
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// ============================================================================
+// Streaming Response Decoding
+// ============================================================================
+
+// WithStreamingDecode makes successful responses decode straight off the
+// connection instead of being read into memory first, lowering peak memory
+// for large payloads such as audio analyses and playlist pages. Error
+// responses, and calls made with ContextWithRawResponse, are still buffered.
+// A connection failure mid-response is returned rather than retried.
+//
+// Example:
+//
+//	client, err := spotigo.NewClient(auth, spotigo.WithStreamingDecode(true))
+func WithStreamingDecode(enabled bool) ClientOption {
+	return func(c *Client) {
+		c.StreamingDecode = enabled
+	}
+}
+
+// itemStreamKey is the context key for the item callback
+type itemStreamKey struct{}
+
+// ContextWithItemStream returns a context that makes calls returning a
+// *Paging[T] pass each item to fn as it is decoded, instead of collecting
+// them in Items. The other Paging fields are filled as usual, so Next still
+// works. Responses are decoded as with WithStreamingDecode. An error from fn
+// stops decoding and is returned by the call. Calls returning other types
+// are unaffected.
+//
+// Example:
+//
+//	ctx = spotigo.ContextWithItemStream(ctx, func(item spotigo.PlaylistTrack) error {
+//		return encoder.Encode(item) // Write each item out as it arrives
+//	})
+//	page, err := client.PlaylistTracks(ctx, playlistID, nil)
+//	for err == nil && page != nil {
+//		page, err = spotigo.NextGeneric[spotigo.PlaylistTrack](client, ctx, page)
+//	}
+func ContextWithItemStream[T any](ctx context.Context, fn func(T) error) context.Context {
+	return context.WithValue(ctx, itemStreamKey{}, fn)
+}
+
+// itemStreamer is implemented by results that can pass their items to a
+// callback while decoding
+type itemStreamer interface {
+	decodeStreaming(decoder *json.Decoder, callback interface{}) (bool, error)
+}
+
+// decodeStreaming decodes a page from decoder, passing items to callback if
+// it is a func(T) error. Returns false without reading if it is not.
+func (p *Paging[T]) decodeStreaming(decoder *json.Decoder, callback interface{}) (bool, error) {
+	fn, ok := callback.(func(T) error)
+	if !ok {
+		return false, nil
+	}
+
+	if err := expectDelim(decoder, '{'); err != nil {
+		return true, err
+	}
+	// Fields other than items are small; collect and decode them together
+	fields := make(map[string]json.RawMessage)
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return true, err
+		}
+		key, _ := token.(string)
+		if key != "items" {
+			var value json.RawMessage
+			if err := decoder.Decode(&value); err != nil {
+				return true, err
+			}
+			fields[key] = value
+			continue
+		}
+
+		if token, err = decoder.Token(); err != nil || token == nil {
+			continue // null items, or the error resurfaces on the next read
+		}
+		if delim, ok := token.(json.Delim); !ok || delim != '[' {
+			return true, fmt.Errorf("expected items array, got %v", token)
+		}
+		for decoder.More() {
+			var item T
+			if err := decoder.Decode(&item); err != nil {
+				return true, err
+			}
+			if err := fn(item); err != nil {
+				return true, itemCallbackError{err}
+			}
+		}
+		if err := expectDelim(decoder, ']'); err != nil {
+			return true, err
+		}
+	}
+	if err := expectDelim(decoder, '}'); err != nil {
+		return true, err
+	}
+
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return true, err
+	}
+	return true, json.Unmarshal(data, p)
+}
+
+// itemCallbackError carries an error returned by an item callback, so it is
+// not reported as a JSON error
+type itemCallbackError struct{ err error }
+
+func (e itemCallbackError) Error() string { return e.err.Error() }
+
+// expectDelim reads the next token and checks that it is delim
+func expectDelim(decoder *json.Decoder, delim json.Delim) error {
+	token, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+	if got, ok := token.(json.Delim); !ok || got != delim {
+		return fmt.Errorf("expected %v, got %v", delim, token)
+	}
+	return nil
+}
+
+// shouldStreamResponse reports whether a successful response for result is
+// decoded straight off the connection
+func (c *Client) shouldStreamResponse(ctx context.Context, resp *http.Response, result interface{}) bool {
+	if result == nil || resp.StatusCode >= 300 || resp.StatusCode == http.StatusNoContent {
+		return false
+	}
+	switch result.(type) {
+	case *AcceptedResult, *capturedBody:
+		return false
+	}
+	if raw, ok := ctx.Value(rawResponseKey{}).(*RawResponse); ok && raw != nil {
+		return false // The body is kept for the caller
+	}
+	return c.StreamingDecode || ctx.Value(itemStreamKey{}) != nil
+}
+
+// countingReader counts the bytes read through it
+type countingReader struct {
+	r io.Reader
+	n int
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	r.n += n
+	return n, err
+}
+
+// decodeStreamingResponse decodes a response body into result as it is read.
+// Returns the number of bytes transferred.
+func (c *Client) decodeStreamingResponse(ctx context.Context, resp *http.Response, result interface{}) (int, error) {
+	counted := &countingReader{r: resp.Body}
+	var body io.Reader = c.limitResponse(counted)
+	decompressed, err := decompressReader(resp, body)
+	if err != nil {
+		return counted.n, err
+	}
+	if decompressed != nil {
+		defer decompressed.Close()
+		body = c.limitResponse(decompressed)
+	}
+
+	decoder := json.NewDecoder(body)
+	if c.StrictDecoding {
+		decoder.DisallowUnknownFields()
+	}
+
+	streamed := false
+	if streamer, ok := result.(itemStreamer); ok {
+		if callback := ctx.Value(itemStreamKey{}); callback != nil {
+			streamed, err = streamer.decodeStreaming(decoder, callback)
+		}
+	}
+	if !streamed {
+		err = decoder.Decode(result)
+	}
+	if err != nil {
+		var callbackErr itemCallbackError
+		if errors.As(err, &callbackErr) {
+			return counted.n, callbackErr.err
+		}
+		if errors.Is(err, ErrResponseTooLarge) {
+			return counted.n, err
+		}
+		if field, ok := strings.CutPrefix(err.Error(), "json: unknown field "); ok {
+			return counted.n, &UnknownFieldError{Fields: []string{strings.Trim(field, `"`)}}
+		}
+		return counted.n, WrapJSONError(err)
+	}
+
+	// Drain the rest so the connection can be reused
+	io.Copy(io.Discard, body)
+
+	if c.StrictDecoding {
+		var fields []string
+		collectExtras(reflect.ValueOf(result), "", &fields)
+		if len(fields) > 0 {
+			sort.Strings(fields)
+			return counted.n, &UnknownFieldError{Fields: fields}
+		}
+	}
+	return counted.n, nil
+}
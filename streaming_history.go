@@ -0,0 +1,172 @@
+package spotigo
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+)
+
+// ============================================================================
+// Extended Streaming History
+// ============================================================================
+
+// StreamingHistoryTimeFormat is the timestamp layout used by the "ts" field
+// of Spotify's extended streaming history
+const StreamingHistoryTimeFormat = "2006-01-02T15:04:05Z"
+
+// StreamingHistoryEntry is one play in the format of Spotify's "extended
+// streaming history" data export (Streaming_History_Audio_*.json).
+//
+// Fields that Spotify exports as null are pointers. Entries converted from
+// API play history only carry the fields the Web API exposes.
+type StreamingHistoryEntry struct {
+	Timestamp                     string  `json:"ts"` // When playback ended (UTC)
+	Username                      *string `json:"username,omitempty"`
+	Platform                      string  `json:"platform"`
+	MsPlayed                      int     `json:"ms_played"`
+	ConnCountry                   string  `json:"conn_country"`
+	IPAddrDecrypted               *string `json:"ip_addr_decrypted"`
+	UserAgentDecrypted            *string `json:"user_agent_decrypted"`
+	MasterMetadataTrackName       *string `json:"master_metadata_track_name"`
+	MasterMetadataAlbumArtistName *string `json:"master_metadata_album_artist_name"`
+	MasterMetadataAlbumAlbumName  *string `json:"master_metadata_album_album_name"`
+	SpotifyTrackURI               *string `json:"spotify_track_uri"`
+	EpisodeName                   *string `json:"episode_name"`
+	EpisodeShowName               *string `json:"episode_show_name"`
+	SpotifyEpisodeURI             *string `json:"spotify_episode_uri"`
+	ReasonStart                   string  `json:"reason_start"`
+	ReasonEnd                     string  `json:"reason_end"`
+	Shuffle                       *bool   `json:"shuffle"`
+	Skipped                       *bool   `json:"skipped"`
+	Offline                       *bool   `json:"offline"`
+	OfflineTimestamp              *int64  `json:"offline_timestamp"`
+	IncognitoMode                 *bool   `json:"incognito_mode"`
+}
+
+// Time parses the entry's timestamp
+func (e *StreamingHistoryEntry) Time() (time.Time, error) {
+	return time.Parse(time.RFC3339, e.Timestamp)
+}
+
+// URI returns the track or episode URI of the entry, or "" if neither is set
+func (e *StreamingHistoryEntry) URI() string {
+	if e.SpotifyTrackURI != nil && *e.SpotifyTrackURI != "" {
+		return *e.SpotifyTrackURI
+	}
+	if e.SpotifyEpisodeURI != nil && *e.SpotifyEpisodeURI != "" {
+		return *e.SpotifyEpisodeURI
+	}
+	return ""
+}
+
+// ReadStreamingHistory decodes a Spotify extended streaming history file
+// (a JSON array of plays)
+func ReadStreamingHistory(r io.Reader) ([]StreamingHistoryEntry, error) {
+	var entries []StreamingHistoryEntry
+	if err := json.NewDecoder(r).Decode(&entries); err != nil {
+		return nil, fmt.Errorf("failed to decode streaming history: %w", err)
+	}
+	return entries, nil
+}
+
+// WriteStreamingHistory encodes entries as a JSON array in the extended
+// streaming history format
+func WriteStreamingHistory(w io.Writer, entries []StreamingHistoryEntry) error {
+	if entries == nil {
+		entries = []StreamingHistoryEntry{}
+	}
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(entries); err != nil {
+		return fmt.Errorf("failed to encode streaming history: %w", err)
+	}
+	return nil
+}
+
+// PlayHistoryToStreamingHistory converts recently played items from the Web
+// API into extended streaming history entries.
+//
+// The Web API does not report how long a track was played, so MsPlayed is
+// set to the track duration and ReasonEnd to "trackdone".
+func PlayHistoryToStreamingHistory(items []PlayHistoryItem) []StreamingHistoryEntry {
+	entries := make([]StreamingHistoryEntry, 0, len(items))
+	for _, item := range items {
+		ts := item.PlayedAt
+		if playedAt, err := time.Parse(time.RFC3339, item.PlayedAt); err == nil {
+			ts = playedAt.UTC().Format(StreamingHistoryTimeFormat)
+		}
+
+		entry := StreamingHistoryEntry{
+			Timestamp: ts,
+			MsPlayed:  item.Track.DurationMs,
+			ReasonEnd: "trackdone",
+		}
+		if item.Track.Name != "" {
+			entry.MasterMetadataTrackName = stringPtr(item.Track.Name)
+		}
+		if len(item.Track.Artists) > 0 {
+			entry.MasterMetadataAlbumArtistName = stringPtr(item.Track.Artists[0].Name)
+		}
+		if item.Track.Album != nil {
+			entry.MasterMetadataAlbumAlbumName = stringPtr(item.Track.Album.Name)
+		}
+		if item.Track.URI != "" {
+			entry.SpotifyTrackURI = stringPtr(item.Track.URI)
+		} else if item.Track.ID != "" {
+			entry.SpotifyTrackURI = stringPtr("spotify:track:" + item.Track.ID)
+		}
+
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+// MergeStreamingHistory combines several streaming history datasets (for
+// example official exports and history collected through the API) into one,
+// removing duplicate plays and sorting by timestamp.
+//
+// Two entries are duplicates if they have the same timestamp (to the second)
+// and URI. When duplicates are found, the first occurrence wins, so pass
+// official exports first to prefer their richer data.
+func MergeStreamingHistory(datasets ...[]StreamingHistoryEntry) []StreamingHistoryEntry {
+	seen := make(map[string]bool)
+	var merged []StreamingHistoryEntry
+
+	for _, dataset := range datasets {
+		for _, entry := range dataset {
+			key := streamingHistoryKey(entry)
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			merged = append(merged, entry)
+		}
+	}
+
+	sort.SliceStable(merged, func(i, j int) bool {
+		ti, erri := merged[i].Time()
+		tj, errj := merged[j].Time()
+		if erri != nil || errj != nil {
+			return merged[i].Timestamp < merged[j].Timestamp
+		}
+		return ti.Before(tj)
+	})
+
+	return merged
+}
+
+// streamingHistoryKey identifies a play by second-precision timestamp and URI
+func streamingHistoryKey(entry StreamingHistoryEntry) string {
+	ts := entry.Timestamp
+	if t, err := entry.Time(); err == nil {
+		ts = t.UTC().Truncate(time.Second).Format(StreamingHistoryTimeFormat)
+	}
+	return ts + "|" + entry.URI()
+}
+
+// stringPtr returns a pointer to s
+func stringPtr(s string) *string {
+	return &s
+}
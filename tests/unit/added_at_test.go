@@ -0,0 +1,56 @@
+package unit
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/sv4u/spotigo"
+)
+
+// TestSortSavedByAddedAt tests chronological sorting of saved items
+func TestSortSavedByAddedAt(t *testing.T) {
+	saved := []spotigo.SavedTrack{
+		{AddedAt: "2024-05-01T10:00:00Z", Track: spotigo.Track{ID: "may"}},
+		{AddedAt: "", Track: spotigo.Track{ID: "unknown"}},
+		{AddedAt: "2023-01-15T08:00:00Z", Track: spotigo.Track{ID: "jan-a"}},
+		{AddedAt: "2023-01-15T08:00:00Z", Track: spotigo.Track{ID: "jan-b"}},
+		{AddedAt: "2024-05-01T12:00:00+02:00", Track: spotigo.Track{ID: "may-offset"}}, // Same instant as "may"
+	}
+
+	ids := func() []string {
+		var ids []string
+		for _, item := range saved {
+			ids = append(ids, item.Track.ID)
+		}
+		return ids
+	}
+
+	spotigo.SortSavedByAddedAt(saved, false)
+	want := []string{"jan-a", "jan-b", "may", "may-offset", "unknown"}
+	if got := ids(); !slices.Equal(got, want) {
+		t.Errorf("oldest first = %v, want %v", got, want)
+	}
+
+	spotigo.SortSavedByAddedAt(saved, true)
+	want = []string{"may", "may-offset", "jan-a", "jan-b", "unknown"}
+	if got := ids(); !slices.Equal(got, want) {
+		t.Errorf("newest first = %v, want %v", got, want)
+	}
+}
+
+// TestSortSavedByAddedAtPlaylistItems tests sorting playlist items
+func TestSortSavedByAddedAtPlaylistItems(t *testing.T) {
+	items := []spotigo.PlaylistTrack{
+		{AddedAt: "2022-06-01T00:00:00Z"},
+		{AddedAt: "2021-06-01T00:00:00Z"},
+	}
+	spotigo.SortSavedByAddedAt(items, false)
+	if items[0].AddedAt != "2021-06-01T00:00:00Z" {
+		t.Errorf("unexpected order: %+v", items)
+	}
+
+	album := spotigo.SavedAlbum{AddedAt: "2020-02-29T23:59:59Z"}
+	if album.AddedTime().Year() != 2020 {
+		t.Errorf("unexpected added time: %v", album.AddedTime())
+	}
+}
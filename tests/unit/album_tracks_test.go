@@ -0,0 +1,91 @@
+package unit
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/sv4u/spotigo/tests"
+)
+
+func TestAlbumTracksFull(t *testing.T) {
+	albumID := base62ID("album", 1)
+
+	// 30 tracks on each of two discs, listed disc 2 first
+	var listed []map[string]interface{}
+	for disc := 2; disc >= 1; disc-- {
+		for n := 1; n <= 30; n++ {
+			listed = append(listed, map[string]interface{}{
+				"id": base62ID(fmt.Sprintf("d%dt", disc), n), "disc_number": disc, "track_number": n,
+			})
+		}
+	}
+
+	var batches []int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/albums/" + albumID + "/tracks":
+			if market := r.URL.Query().Get("market"); market != "US" {
+				t.Errorf("expected market US, got %q", market)
+			}
+			offset := 0
+			fmt.Sscanf(r.URL.Query().Get("offset"), "%d", &offset)
+			end := min(offset+25, len(listed))
+			var next interface{}
+			if end < len(listed) {
+				next = fmt.Sprintf("http://%s/albums/%s/tracks?market=US&offset=%d&limit=25", r.Host, albumID, end)
+			}
+			tests.WriteJSONResponse(w, http.StatusOK, map[string]interface{}{
+				"items": listed[offset:end], "next": next, "offset": offset, "limit": 25, "total": len(listed),
+			})
+		case "/tracks":
+			if market := r.URL.Query().Get("market"); market != "US" {
+				t.Errorf("expected market US, got %q", market)
+			}
+			ids := strings.Split(r.URL.Query().Get("ids"), ",")
+			batches = append(batches, len(ids))
+
+			// Track 5 on disc 2 cannot be looked up
+			tracks := make([]interface{}, len(ids))
+			for i, id := range ids {
+				if id == base62ID("d2t", 5) {
+					continue
+				}
+				var disc, n int
+				fmt.Sscanf(id, "d%dt%d", &disc, &n)
+				tracks[i] = map[string]interface{}{"id": id, "disc_number": disc, "track_number": n, "popularity": 50 + n}
+			}
+			tests.WriteJSONResponse(w, http.StatusOK, map[string]interface{}{"tracks": tracks})
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+
+	tracks, err := client.AlbumTracksFull(context.Background(), "spotify:album:"+albumID, "US")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(batches) != 2 || batches[0] != 50 || batches[1] != 10 {
+		t.Errorf("expected lookups in batches of 50 and 10, got %v", batches)
+	}
+	if len(tracks) != 59 {
+		t.Fatalf("expected 59 tracks, got %d", len(tracks))
+	}
+	for i := 1; i < len(tracks); i++ {
+		prev, cur := tracks[i-1], tracks[i]
+		if cur.DiscNumber < prev.DiscNumber || (cur.DiscNumber == prev.DiscNumber && cur.TrackNumber <= prev.TrackNumber) {
+			t.Fatalf("tracks out of order at %d: disc %d track %d after disc %d track %d",
+				i, cur.DiscNumber, cur.TrackNumber, prev.DiscNumber, prev.TrackNumber)
+		}
+	}
+	if tracks[0].ID != base62ID("d1t", 1) || tracks[0].Popularity != 51 {
+		t.Errorf("unexpected first track: %+v", tracks[0])
+	}
+}
@@ -0,0 +1,132 @@
+package unit
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/sv4u/spotigo"
+)
+
+// recentlyPlayedJSON builds a recently played response with the given played_at times
+func recentlyPlayedJSON(playedAt ...string) string {
+	items := ""
+	for i, ts := range playedAt {
+		if i > 0 {
+			items += ","
+		}
+		items += fmt.Sprintf(`{"track": {"id": "track%d", "name": "Track %d"}, "played_at": %q}`, i, i, ts)
+	}
+	return fmt.Sprintf(`{"items": [%s], "cursors": {}, "limit": 50}`, items)
+}
+
+func TestRecentlyPlayedArchiverDeduplicates(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch atomic.AddInt32(&calls, 1) {
+		case 1:
+			if r.URL.Query().Get("after") != "" {
+				t.Errorf("expected no after cursor on first poll, got %q", r.URL.Query().Get("after"))
+			}
+			// Newest first, as returned by Spotify
+			w.Write([]byte(recentlyPlayedJSON("2024-01-01T10:05:00.000Z", "2024-01-01T10:00:00.000Z")))
+		default:
+			want := fmt.Sprintf("%d", time.Date(2024, 1, 1, 10, 5, 0, 0, time.UTC).UnixMilli())
+			if got := r.URL.Query().Get("after"); got != want {
+				t.Errorf("expected after=%s, got %q", want, got)
+			}
+			// Overlaps with the first poll
+			w.Write([]byte(recentlyPlayedJSON("2024-01-01T10:10:00.000Z", "2024-01-01T10:05:00.000Z")))
+		}
+	}))
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+	store := spotigo.NewJSONLHistoryStore(filepath.Join(t.TempDir(), "history.jsonl"))
+	archiver := spotigo.NewRecentlyPlayedArchiver(client, store, 0)
+	ctx := context.Background()
+
+	if n, err := archiver.Poll(ctx); err != nil || n != 2 {
+		t.Fatalf("first poll: expected 2 items, got %d (err: %v)", n, err)
+	}
+	if n, err := archiver.Poll(ctx); err != nil || n != 1 {
+		t.Fatalf("second poll: expected 1 item, got %d (err: %v)", n, err)
+	}
+
+	items, err := store.Items(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []string{"2024-01-01T10:00:00.000Z", "2024-01-01T10:05:00.000Z", "2024-01-01T10:10:00.000Z"}
+	if len(items) != len(want) {
+		t.Fatalf("expected %d stored items, got %d", len(want), len(items))
+	}
+	for i, item := range items {
+		if item.PlayedAt != want[i] {
+			t.Errorf("item %d: expected played_at %s, got %s", i, want[i], item.PlayedAt)
+		}
+	}
+}
+
+func TestRecentlyPlayedArchiverResumesFromStore(t *testing.T) {
+	ctx := context.Background()
+	store := spotigo.NewJSONLHistoryStore(filepath.Join(t.TempDir(), "history.jsonl"))
+	if err := store.Append(ctx, []spotigo.PlayHistoryItem{{PlayedAt: "2024-01-01T10:05:00Z"}}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	last, err := store.LastPlayedAt(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !last.Equal(time.Date(2024, 1, 1, 10, 5, 0, 0, time.UTC)) {
+		t.Errorf("unexpected last played time: %v", last)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("after") == "" {
+			t.Error("expected after cursor from stored history")
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(recentlyPlayedJSON("2024-01-01T10:05:00Z")))
+	}))
+	defer server.Close()
+
+	archiver := spotigo.NewRecentlyPlayedArchiver(newPlayerTestClient(t, server), store, 0)
+	if n, err := archiver.Poll(ctx); err != nil || n != 0 {
+		t.Errorf("expected no new items, got %d (err: %v)", n, err)
+	}
+}
+
+func TestRecentlyPlayedArchiverRunReportsErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte(`{"error": {"status": 403, "message": "Insufficient client scope"}}`))
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	archiver := spotigo.NewRecentlyPlayedArchiver(
+		newPlayerTestClient(t, server),
+		spotigo.NewJSONLHistoryStore(filepath.Join(t.TempDir(), "history.jsonl")),
+		time.Hour,
+	)
+	errs := make(chan error, 1)
+	archiver.OnError = func(err error) {
+		errs <- err
+		cancel()
+	}
+
+	if err := archiver.Run(ctx); err != context.Canceled {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+	if err := <-errs; err == nil {
+		t.Error("expected polling error")
+	}
+}
@@ -0,0 +1,77 @@
+package unit
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sv4u/spotigo"
+	"github.com/sv4u/spotigo/tests"
+)
+
+func TestGetTrackAudioFeaturesFallback(t *testing.T) {
+	const trackID = "6b2oQwSGFkzsMtQruIWm2p"
+	featuresStatus, analysisStatus := http.StatusOK, http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/audio-features/" + trackID:
+			if featuresStatus != http.StatusOK {
+				tests.WriteJSONResponse(w, featuresStatus, tests.CreateErrorResponse(featuresStatus, "Forbidden", ""))
+				return
+			}
+			tests.WriteJSONResponse(w, http.StatusOK, map[string]interface{}{"id": trackID, "tempo": 120.5, "energy": 0.8})
+		case "/audio-analysis/" + trackID:
+			if analysisStatus != http.StatusOK {
+				tests.WriteJSONResponse(w, analysisStatus, tests.CreateErrorResponse(analysisStatus, "Forbidden", ""))
+				return
+			}
+			tests.WriteJSONResponse(w, http.StatusOK, map[string]interface{}{
+				"track": map[string]interface{}{
+					"duration": 207.95985, "tempo": 118.211, "key": 5, "mode": 1, "loudness": -5.883, "time_signature": 4,
+				},
+			})
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+	ctx := context.Background()
+
+	// Features available: returned as is
+	features, derived, err := client.GetTrackAudioFeaturesFallback(ctx, "spotify:track:"+trackID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if derived || features.Tempo != 120.5 || features.Energy != 0.8 {
+		t.Errorf("expected the endpoint's features, got %+v (derived %v)", features, derived)
+	}
+
+	// Features refused: derived from the analysis
+	featuresStatus = http.StatusForbidden
+	features, derived, err = client.GetTrackAudioFeaturesFallback(ctx, trackID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !derived {
+		t.Error("expected derived features")
+	}
+	if features.ID != trackID || features.Tempo != 118.211 || features.Key != 5 || features.Mode != 1 ||
+		features.Loudness != -5.883 || features.TimeSignature != 4 || features.DurationMs != 207960 {
+		t.Errorf("unexpected derived features: %+v", features)
+	}
+	if features.Energy != 0 {
+		t.Errorf("expected perceptual fields to be left zero, got energy %v", features.Energy)
+	}
+
+	// Both refused: the audio features error is reported
+	analysisStatus = http.StatusForbidden
+	_, _, err = client.GetTrackAudioFeaturesFallback(ctx, trackID)
+	var deprecated *spotigo.EndpointDeprecatedError
+	if !errors.As(err, &deprecated) || deprecated.Endpoint != spotigo.EndpointAudioFeatures {
+		t.Errorf("expected the audio features EndpointDeprecatedError, got %v", err)
+	}
+}
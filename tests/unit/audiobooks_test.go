@@ -0,0 +1,156 @@
+package unit
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/sv4u/spotigo"
+)
+
+func TestSavedAudiobooks(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path+"?"+r.URL.RawQuery)
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/me/audiobooks":
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"items": []map[string]interface{}{{"id": "7iHfbu1YPACw6oZPAFJtqe", "name": "Dune"}},
+				"total": 1,
+			})
+		case r.URL.Path == "/me/audiobooks/contains":
+			json.NewEncoder(w).Encode([]bool{true, false})
+		default:
+			w.WriteHeader(http.StatusOK)
+		}
+	}))
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+	ctx := context.Background()
+
+	page, err := client.CurrentUserSavedAudiobooks(ctx, &spotigo.SavedAudiobooksOptions{Limit: 5})
+	if err != nil {
+		t.Fatalf("CurrentUserSavedAudiobooks failed: %v", err)
+	}
+	if len(page.Items) != 1 || page.Items[0].Name != "Dune" {
+		t.Errorf("unexpected items: %+v", page.Items)
+	}
+
+	ids := []string{"spotify:audiobook:7iHfbu1YPACw6oZPAFJtqe", "18yVqkdbdRvS24c0Ilj2ci"}
+	if err := client.CurrentUserSavedAudiobooksAdd(ctx, ids); err != nil {
+		t.Fatalf("CurrentUserSavedAudiobooksAdd failed: %v", err)
+	}
+	if err := client.CurrentUserSavedAudiobooksDelete(ctx, ids); err != nil {
+		t.Fatalf("CurrentUserSavedAudiobooksDelete failed: %v", err)
+	}
+	saved, err := client.CurrentUserSavedAudiobooksContains(ctx, ids)
+	if err != nil || len(saved) != 2 || !saved[0] || saved[1] {
+		t.Fatalf("CurrentUserSavedAudiobooksContains = %v, %v", saved, err)
+	}
+
+	want := []string{
+		"GET /me/audiobooks?limit=5",
+		"PUT /me/audiobooks?ids=7iHfbu1YPACw6oZPAFJtqe%2C18yVqkdbdRvS24c0Ilj2ci",
+		"DELETE /me/audiobooks?ids=7iHfbu1YPACw6oZPAFJtqe%2C18yVqkdbdRvS24c0Ilj2ci",
+		"GET /me/audiobooks/contains?ids=7iHfbu1YPACw6oZPAFJtqe%2C18yVqkdbdRvS24c0Ilj2ci",
+	}
+	if len(requests) != len(want) {
+		t.Fatalf("requests = %v", requests)
+	}
+	for i := range want {
+		if requests[i] != want[i] {
+			t.Errorf("request %d = %q, want %q", i, requests[i], want[i])
+		}
+	}
+
+	if err := client.CurrentUserSavedAudiobooksAdd(ctx, []string{"spotify:show:5CfCWKI5pZ28U0uOzXkDHe"}); err == nil {
+		t.Error("expected error for non-audiobook URI")
+	}
+}
+
+func TestChapters(t *testing.T) {
+	var queries []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.Path+"?"+r.URL.RawQuery)
+		w.Header().Set("Content-Type", "application/json")
+		chapter := map[string]interface{}{"id": "0D5wENdkdwbqlrHoaJ9g29", "chapter_number": 3}
+		if r.URL.Path == "/chapters" {
+			json.NewEncoder(w).Encode(map[string]interface{}{"chapters": []interface{}{chapter}})
+			return
+		}
+		json.NewEncoder(w).Encode(chapter)
+	}))
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+	ctx := context.Background()
+
+	chapter, err := client.GetChapter(ctx, "https://open.spotify.com/chapter/0D5wENdkdwbqlrHoaJ9g29", "GB")
+	if err != nil || chapter.ChapterNumber != 3 {
+		t.Fatalf("GetChapter = %+v, %v", chapter, err)
+	}
+	chapters, err := client.GetChapters(ctx, []string{"spotify:chapter:0D5wENdkdwbqlrHoaJ9g29"})
+	if err != nil || len(chapters.Chapters) != 1 {
+		t.Fatalf("GetChapters = %+v, %v", chapters, err)
+	}
+
+	if queries[0] != "/chapters/0D5wENdkdwbqlrHoaJ9g29?market=GB" || queries[1] != "/chapters?ids=0D5wENdkdwbqlrHoaJ9g29" {
+		t.Errorf("queries = %v", queries)
+	}
+
+	if _, err := client.GetChapter(ctx, "0D5wENdkdwbqlrHoaJ9g29", "GBR"); err == nil {
+		t.Error("expected error for invalid market")
+	}
+}
+
+func TestAudiobooksMarket(t *testing.T) {
+	var queries []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.Path+"?"+r.URL.RawQuery)
+		w.Header().Set("Content-Type", "application/json")
+		audiobook := map[string]interface{}{"id": "7iHfbu1YPACw6oZPAFJtqe", "name": "Dune"}
+		switch r.URL.Path {
+		case "/audiobooks":
+			json.NewEncoder(w).Encode(map[string]interface{}{"audiobooks": []interface{}{audiobook}})
+		case "/audiobooks/7iHfbu1YPACw6oZPAFJtqe/chapters":
+			json.NewEncoder(w).Encode(map[string]interface{}{"items": []interface{}{}, "total": 0})
+		default:
+			json.NewEncoder(w).Encode(audiobook)
+		}
+	}))
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+	ctx := context.Background()
+
+	if _, err := client.GetAudiobook(ctx, "spotify:audiobook:7iHfbu1YPACw6oZPAFJtqe", "GB"); err != nil {
+		t.Fatalf("GetAudiobook failed: %v", err)
+	}
+	if _, err := client.GetAudiobooks(ctx, []string{"7iHfbu1YPACw6oZPAFJtqe"}, "GB"); err != nil {
+		t.Fatalf("GetAudiobooks failed: %v", err)
+	}
+	if _, err := client.GetAudiobookChapters(ctx, "7iHfbu1YPACw6oZPAFJtqe", &spotigo.AudiobookChaptersOptions{Market: "GB"}); err != nil {
+		t.Fatalf("GetAudiobookChapters failed: %v", err)
+	}
+
+	want := []string{
+		"/audiobooks/7iHfbu1YPACw6oZPAFJtqe?market=GB",
+		"/audiobooks?ids=7iHfbu1YPACw6oZPAFJtqe&market=GB",
+		"/audiobooks/7iHfbu1YPACw6oZPAFJtqe/chapters?limit=20&market=GB",
+	}
+	if !slices.Equal(queries, want) {
+		t.Errorf("queries = %v, want %v", queries, want)
+	}
+
+	if _, err := client.GetAudiobook(ctx, "7iHfbu1YPACw6oZPAFJtqe", "GBR"); err == nil {
+		t.Error("expected error for invalid market")
+	}
+	if _, err := client.GetAudiobookChapters(ctx, "7iHfbu1YPACw6oZPAFJtqe", &spotigo.AudiobookChaptersOptions{Market: "GBR"}); err == nil {
+		t.Error("expected error for invalid market")
+	}
+}
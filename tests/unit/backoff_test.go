@@ -0,0 +1,88 @@
+package unit
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/sv4u/spotigo"
+	"github.com/sv4u/spotigo/tests"
+)
+
+func TestLinearBackoff(t *testing.T) {
+	backoff := spotigo.LinearBackoff{Factor: 0.5, Max: 2 * time.Second}
+	want := []time.Duration{500 * time.Millisecond, time.Second, 1500 * time.Millisecond, 2 * time.Second, 2 * time.Second}
+	for attempt, expected := range want {
+		if got := backoff.Delay(attempt); got != expected {
+			t.Errorf("attempt %d: expected %v, got %v", attempt, expected, got)
+		}
+	}
+}
+
+func TestExponentialBackoff(t *testing.T) {
+	backoff := &spotigo.ExponentialBackoff{Base: 100 * time.Millisecond, Max: time.Second}
+	want := []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond, 800 * time.Millisecond, time.Second, time.Second}
+	for attempt, expected := range want {
+		if got := backoff.Delay(attempt); got != expected {
+			t.Errorf("attempt %d: expected %v, got %v", attempt, expected, got)
+		}
+	}
+
+	// Large attempts must not overflow
+	if got := backoff.Delay(200); got != time.Second {
+		t.Errorf("expected capped delay for large attempt, got %v", got)
+	}
+}
+
+func TestExponentialBackoffJitter(t *testing.T) {
+	backoff := spotigo.NewExponentialBackoff(100*time.Millisecond, time.Second)
+	for attempt := 0; attempt < 6; attempt++ {
+		ceiling := 100 * time.Millisecond << attempt
+		if ceiling > time.Second {
+			ceiling = time.Second
+		}
+		for i := 0; i < 50; i++ {
+			if got := backoff.Delay(attempt); got < 0 || got > ceiling {
+				t.Fatalf("attempt %d: delay %v outside [0, %v]", attempt, got, ceiling)
+			}
+		}
+	}
+}
+
+func TestWithBackoffStrategy(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id": "4iV5W9uYEdYUVa79Axb7Rh", "name": "Test Track"}`))
+	}))
+	defer server.Close()
+
+	var attempts []int
+	auth := &tests.MockAuthManager{
+		Token: &spotigo.TokenInfo{AccessToken: "test_token", TokenType: "Bearer"},
+	}
+	client, err := spotigo.NewClient(auth,
+		spotigo.WithAPIPrefix(server.URL+"/"),
+		spotigo.WithBackoffStrategy(spotigo.BackoffFunc(func(attempt int) time.Duration {
+			attempts = append(attempts, attempt)
+			return time.Millisecond
+		})),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, err := client.Track(context.Background(), "4iV5W9uYEdYUVa79Axb7Rh"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(attempts) != 1 || attempts[0] != 0 {
+		t.Errorf("expected backoff strategy to be called for attempt 0, got %v", attempts)
+	}
+}
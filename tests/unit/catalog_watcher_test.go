@@ -0,0 +1,148 @@
+package unit
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/sv4u/spotigo"
+	"github.com/sv4u/spotigo/tests"
+)
+
+func TestCatalogWatcher(t *testing.T) {
+	var mu sync.Mutex
+	poll := 0
+	var trackBatches, albumBatches []int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if market := r.URL.Query().Get("market"); market != "US" {
+			t.Errorf("expected market US, got %q", market)
+		}
+		ids := strings.Split(r.URL.Query().Get("ids"), ",")
+		switch r.URL.Path {
+		case "/tracks":
+			trackBatches = append(trackBatches, len(ids))
+			tracks := make([]interface{}, len(ids))
+			for i, id := range ids {
+				track := map[string]interface{}{"id": id, "name": id, "popularity": 40, "is_playable": true}
+				switch {
+				case id == base62ID("t", 3) && poll > 0:
+					track["popularity"] = 41
+				case id == base62ID("t", 7) && poll > 0:
+					track["is_playable"] = false
+				case id == base62ID("t", 9) && poll > 0:
+					continue // Removed from the catalog
+				}
+				tracks[i] = track
+			}
+			tests.WriteJSONResponse(w, http.StatusOK, map[string]interface{}{"tracks": tracks})
+		case "/albums":
+			albumBatches = append(albumBatches, len(ids))
+			albums := make([]map[string]interface{}, len(ids))
+			for i, id := range ids {
+				albums[i] = map[string]interface{}{"id": id, "name": id, "popularity": 60}
+				if id == base62ID("al", 1) && poll > 0 {
+					albums[i]["restrictions"] = map[string]interface{}{"reason": "market"}
+				}
+			}
+			tests.WriteJSONResponse(w, http.StatusOK, map[string]interface{}{"albums": albums})
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+
+	var trackIDs, albumIDs []string
+	for i := 0; i < 60; i++ {
+		trackIDs = append(trackIDs, base62ID("t", i))
+	}
+	trackIDs = append(trackIDs, "spotify:track:"+base62ID("t", 3)) // Duplicate
+	for i := 0; i < 25; i++ {
+		albumIDs = append(albumIDs, base62ID("al", i))
+	}
+
+	var notified []spotigo.CatalogChange
+	watcher := client.NewCatalogWatcher(trackIDs, albumIDs, func(change spotigo.CatalogChange) {
+		notified = append(notified, change)
+	})
+	watcher.Market = "US"
+
+	changes, err := watcher.Poll(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(changes) != 0 {
+		t.Errorf("expected the first poll to only record a baseline, got %d changes", len(changes))
+	}
+	if snapshot, ok := watcher.Snapshot("track", base62ID("t", 3)); !ok || snapshot.Popularity != 40 || !snapshot.Available {
+		t.Errorf("unexpected baseline snapshot: %+v", snapshot)
+	}
+
+	mu.Lock()
+	poll++
+	mu.Unlock()
+
+	changes, err = watcher.Poll(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(changes) != 4 || len(notified) != 4 {
+		t.Fatalf("expected 4 changes, got %d (%d notified)", len(changes), len(notified))
+	}
+
+	byID := map[string]spotigo.CatalogChange{}
+	for _, change := range changes {
+		byID[change.Previous.ID] = change
+	}
+	if c := byID[base62ID("t", 3)]; c.Current == nil || c.Previous.Popularity != 40 || c.Current.Popularity != 41 {
+		t.Errorf("unexpected popularity change: %+v", c)
+	}
+	if c := byID[base62ID("t", 7)]; c.Current == nil || c.Current.Available {
+		t.Errorf("expected track 7 to become unavailable: %+v", c)
+	}
+	if c, ok := byID[base62ID("t", 9)]; !ok || c.Current != nil {
+		t.Errorf("expected track 9 to be reported removed: %+v", c)
+	}
+	if c := byID[base62ID("al", 1)]; c.Current == nil || c.Current.Kind != "album" || c.Current.Available {
+		t.Errorf("expected album 1 to become unavailable: %+v", c)
+	}
+
+	if trackBatches[0] != 50 || trackBatches[1] != 10 || albumBatches[0] != 20 || albumBatches[1] != 5 {
+		t.Errorf("expected track batches of 50 and album batches of 20, got %v and %v", trackBatches, albumBatches)
+	}
+}
+
+func TestCatalogWatcherRunDefaultInterval(t *testing.T) {
+	var polls atomic.Int32
+	server := httptest.NewServer(countRequests(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tests.WriteJSONResponse(w, http.StatusOK, map[string]interface{}{"tracks": []interface{}{
+			map[string]interface{}{"id": base62ID("t", 1), "name": "t1", "popularity": 40},
+		}})
+	}), &polls))
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+	watcher := client.NewCatalogWatcher([]string{base62ID("t", 1)}, nil, nil)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+
+	// A zero interval falls back to the default instead of panicking, so
+	// only the immediate poll happens before the context expires
+	if err := watcher.Run(ctx, 0); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected context.DeadlineExceeded, got %v", err)
+	}
+	if got := polls.Load(); got != 1 {
+		t.Errorf("expected 1 poll before the default interval, got %d", got)
+	}
+}
@@ -0,0 +1,86 @@
+package unit
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/sv4u/spotigo"
+	"github.com/sv4u/spotigo/tests"
+)
+
+func TestBrowseCategoryLocaleFallback(t *testing.T) {
+	var locales []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		locale := r.URL.Query().Get("locale")
+		locales = append(locales, locale)
+		if r.URL.Query().Get("country") != "MX" {
+			t.Errorf("expected country to be kept, got %s", r.URL.RawQuery)
+		}
+		if locale != "" {
+			tests.WriteJSONResponse(w, http.StatusNotFound, tests.CreateErrorResponse(http.StatusNotFound, "Not found.", ""))
+			return
+		}
+		tests.WriteJSONResponse(w, http.StatusOK, map[string]interface{}{"id": "toplists", "name": "Top Lists"})
+	}))
+	defer server.Close()
+	client := newPlayerTestClient(t, server)
+
+	category, err := client.BrowseCategory(context.Background(), "toplists", &spotigo.BrowseCategoriesOptions{
+		Country:        "MX",
+		Locale:         "es_MX",
+		LocaleFallback: true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if category.Name != "Top Lists" {
+		t.Errorf("unexpected category %+v", category)
+	}
+	if !slices.Equal(locales, []string{"es_MX", "es", ""}) {
+		t.Errorf("expected locales es_MX, es, default; got %q", locales)
+	}
+
+	locales = nil
+	_, err = client.BrowseCategory(context.Background(), "toplists", &spotigo.BrowseCategoriesOptions{Country: "MX", Locale: "es_MX"})
+	if err == nil || len(locales) != 1 {
+		t.Errorf("expected a single failing request without LocaleFallback, got %q (%v)", locales, err)
+	}
+}
+
+func TestBrowseCategoryLocaleFallbackDefaultLocale(t *testing.T) {
+	var locales []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		locale := r.URL.Query().Get("locale")
+		locales = append(locales, locale)
+		if locale != "" {
+			tests.WriteJSONResponse(w, http.StatusNotFound, tests.CreateErrorResponse(http.StatusNotFound, "Not found.", ""))
+			return
+		}
+		tests.WriteJSONResponse(w, http.StatusOK, map[string]interface{}{"id": "toplists", "name": "Top Lists"})
+	}))
+	defer server.Close()
+	client := newPlayerTestClient(t, server)
+	spotigo.WithDefaultLocale("pt_BR")(client)
+
+	// The chain starts from the client's locale and the last step is sent
+	// without it
+	if _, err := client.BrowseCategory(context.Background(), "toplists", &spotigo.BrowseCategoriesOptions{LocaleFallback: true}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !slices.Equal(locales, []string{"pt_BR", "pt", ""}) {
+		t.Errorf("expected locales pt_BR, pt, default; got %q", locales)
+	}
+
+	// The context's locale takes precedence over the client's
+	locales = nil
+	ctx := spotigo.ContextWithLocale(context.Background(), "es_MX")
+	if _, err := client.BrowseCategory(ctx, "toplists", &spotigo.BrowseCategoriesOptions{LocaleFallback: true}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !slices.Equal(locales, []string{"es_MX", "es", ""}) {
+		t.Errorf("expected locales es_MX, es, default; got %q", locales)
+	}
+}
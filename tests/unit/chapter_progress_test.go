@@ -0,0 +1,63 @@
+package unit
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/sv4u/spotigo/tests"
+)
+
+func TestGetAudiobookProgress(t *testing.T) {
+	audiobookID := base62ID("book", 1)
+	chapter := func(n, positionMs int, fullyPlayed bool) map[string]interface{} {
+		return map[string]interface{}{
+			"id":             fmt.Sprintf("c%d", n),
+			"name":           fmt.Sprintf("Chapter %d", n),
+			"chapter_number": n,
+			"duration_ms":    60000,
+			"resume_point":   map[string]interface{}{"resume_position_ms": positionMs, "fully_played": fullyPlayed},
+		}
+	}
+
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.Path != "/audiobooks/"+audiobookID+"/chapters" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		var next interface{}
+		items := []map[string]interface{}{chapter(2, 30000, false), chapter(3, 0, false)}
+		if r.URL.Query().Get("offset") == "" {
+			if r.URL.Query().Get("limit") != "50" || r.URL.Query().Get("market") != "US" {
+				t.Errorf("unexpected query %s", r.URL.RawQuery)
+			}
+			items = []map[string]interface{}{chapter(0, 0, true), chapter(1, 0, true)}
+			next = fmt.Sprintf("http://%s%s?offset=2&limit=2", r.Host, r.URL.Path)
+		}
+		tests.WriteJSONResponse(w, http.StatusOK, map[string]interface{}{"items": items, "next": next, "limit": 2, "total": 4})
+	}))
+	defer server.Close()
+	client := newPlayerTestClient(t, server)
+
+	progress, err := client.GetAudiobookProgress(context.Background(), "spotify:audiobook:"+audiobookID, "US")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if requests != 2 || len(progress.Chapters) != 4 || progress.AudiobookID != audiobookID {
+		t.Fatalf("expected 4 chapters from 2 requests, got %+v after %d requests", progress, requests)
+	}
+	if got := progress.Fraction(); got != 2.5/4 {
+		t.Errorf("expected 62.5%% listened, got %v", got)
+	}
+	if got := progress.Remaining(); got != 90*time.Second {
+		t.Errorf("expected 90s remaining, got %s", got)
+	}
+	next, ok := progress.ResumeChapter()
+	if !ok || next.ChapterID != "c2" || next.Position != 30*time.Second || next.Fraction() != 0.5 {
+		t.Errorf("expected to resume chapter 2 halfway, got %+v", next)
+	}
+}
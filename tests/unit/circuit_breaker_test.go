@@ -0,0 +1,111 @@
+package unit
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/sv4u/spotigo"
+)
+
+// newCircuitBreakerTestClient creates a client with a circuit breaker and fast retries
+func newCircuitBreakerTestClient(t *testing.T, server *httptest.Server, threshold int, cooldown time.Duration) *spotigo.Client {
+	t.Helper()
+	client := newPlayerTestClient(t, server)
+	client.RetryConfig = spotigo.DefaultRetryConfig()
+	client.RetryConfig.BackoffFactor = 0.001
+	client.RetryConfig.StatusRetries = 0
+	client.RetryConfig.CircuitBreakerThreshold = threshold
+	client.RetryConfig.CircuitBreakerCooldown = cooldown
+	return client
+}
+
+func TestCircuitBreakerOpensAfterConsecutiveFailures(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+		w.Write([]byte(`{"error": {"status": 503, "message": "Service unavailable"}}`))
+	}))
+	defer server.Close()
+
+	client := newCircuitBreakerTestClient(t, server, 2, time.Hour)
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		_, err := client.Track(ctx, "4iV5W9uYEdYUVa79Axb7Rh", "")
+		if err == nil || errors.Is(err, spotigo.ErrCircuitOpen) {
+			t.Fatalf("request %d: expected server error, got %v", i, err)
+		}
+	}
+
+	_, err := client.Track(ctx, "4iV5W9uYEdYUVa79Axb7Rh", "")
+	if !errors.Is(err, spotigo.ErrCircuitOpen) {
+		t.Fatalf("expected ErrCircuitOpen, got %v", err)
+	}
+	var openErr *spotigo.CircuitOpenError
+	if !errors.As(err, &openErr) || openErr.Failures != 2 {
+		t.Errorf("expected CircuitOpenError with 2 failures, got %v", err)
+	}
+	if got := atomic.LoadInt32(&calls); got != 2 {
+		t.Errorf("expected open circuit to skip the server, got %d calls", got)
+	}
+
+	client.ResetCircuitBreaker()
+	if _, err := client.Track(ctx, "4iV5W9uYEdYUVa79Axb7Rh", ""); errors.Is(err, spotigo.ErrCircuitOpen) {
+		t.Error("expected reset circuit to allow requests")
+	}
+}
+
+func TestCircuitBreakerClosesAfterSuccessfulTrial(t *testing.T) {
+	var failing atomic.Bool
+	failing.Store(true)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if failing.Load() {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id": "4iV5W9uYEdYUVa79Axb7Rh", "name": "Test Track"}`))
+	}))
+	defer server.Close()
+
+	client := newCircuitBreakerTestClient(t, server, 1, 20*time.Millisecond)
+	ctx := context.Background()
+
+	if _, err := client.Track(ctx, "4iV5W9uYEdYUVa79Axb7Rh", ""); err == nil {
+		t.Fatal("expected server error")
+	}
+	if _, err := client.Track(ctx, "4iV5W9uYEdYUVa79Axb7Rh", ""); !errors.Is(err, spotigo.ErrCircuitOpen) {
+		t.Fatalf("expected ErrCircuitOpen, got %v", err)
+	}
+
+	failing.Store(false)
+	time.Sleep(30 * time.Millisecond)
+
+	if _, err := client.Track(ctx, "4iV5W9uYEdYUVa79Axb7Rh", ""); err != nil {
+		t.Fatalf("expected trial request to succeed, got %v", err)
+	}
+	if _, err := client.Track(ctx, "4iV5W9uYEdYUVa79Axb7Rh", ""); err != nil {
+		t.Errorf("expected closed circuit, got %v", err)
+	}
+}
+
+func TestCircuitBreakerIgnoresClientErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"error": {"status": 404, "message": "Not found"}}`))
+	}))
+	defer server.Close()
+
+	client := newCircuitBreakerTestClient(t, server, 1, time.Hour)
+	for i := 0; i < 3; i++ {
+		if _, err := client.Track(context.Background(), "4iV5W9uYEdYUVa79Axb7Rh", ""); errors.Is(err, spotigo.ErrCircuitOpen) {
+			t.Fatal("expected 4xx responses not to open the circuit")
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package unit
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/sv4u/spotigo"
+	"github.com/sv4u/spotigo/tests"
+)
+
+func TestClientPool(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Limit", "100")
+		w.Header().Set("X-RateLimit-Remaining", "42")
+		user := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer token_")
+		tests.WriteJSONResponse(w, http.StatusOK, map[string]interface{}{"id": user})
+	}))
+	defer server.Close()
+
+	var mu sync.Mutex
+	created := map[string]int{}
+	pool, err := spotigo.NewClientPool(func(ctx context.Context, userID string) (spotigo.AuthManager, error) {
+		mu.Lock()
+		created[userID]++
+		mu.Unlock()
+		return &tests.MockAuthManager{Token: &spotigo.TokenInfo{AccessToken: "token_" + userID, TokenType: "Bearer"}}, nil
+	}, 2, spotigo.WithAPIPrefix(server.URL+"/"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	ctx := context.Background()
+
+	alice, err := pool.Get(ctx, "alice")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	bob, err := pool.Get(ctx, "bob")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if alice == bob {
+		t.Fatal("expected a client per user")
+	}
+	if alice.HTTPClient != bob.HTTPClient {
+		t.Error("expected clients to share one http.Client")
+	}
+	if again, _ := pool.Get(ctx, "alice"); again != alice {
+		t.Error("expected the pooled client to be reused")
+	}
+
+	user, err := bob.CurrentUser(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if user.ID != "bob" {
+		t.Errorf("expected bob's auth manager to be used, got %q", user.ID)
+	}
+	if state := alice.RateLimitState(); !state.Known || state.Remaining != 42 {
+		t.Errorf("expected rate limit state to be shared, got %+v", state)
+	}
+	if state := pool.RateLimitState(); state.Remaining != 42 {
+		t.Errorf("expected pool rate limit state, got %+v", state)
+	}
+
+	// Bob is the least recently used, so carol evicts him
+	if _, err := pool.Get(ctx, "carol"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if pool.Len() != 2 {
+		t.Errorf("expected 2 pooled clients, got %d", pool.Len())
+	}
+	if again, _ := pool.Get(ctx, "alice"); again != alice {
+		t.Error("expected alice to survive eviction")
+	}
+	if again, _ := pool.Get(ctx, "bob"); again == bob {
+		t.Error("expected bob to have been evicted")
+	}
+	if created["bob"] != 2 || created["alice"] != 1 {
+		t.Errorf("unexpected auth factory calls: %v", created)
+	}
+
+	pool.Remove("bob")
+	if pool.Len() != 1 {
+		t.Errorf("expected 1 pooled client after Remove, got %d", pool.Len())
+	}
+}
+
+func TestClientPoolValidation(t *testing.T) {
+	if _, err := spotigo.NewClientPool(nil, 0); err == nil {
+		t.Error("expected error for nil auth factory")
+	}
+
+	pool, err := spotigo.NewClientPool(func(ctx context.Context, userID string) (spotigo.AuthManager, error) {
+		return &tests.MockAuthManager{}, nil
+	}, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := pool.Get(context.Background(), ""); err == nil {
+		t.Error("expected error for empty user ID")
+	}
+}
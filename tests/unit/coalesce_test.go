@@ -0,0 +1,141 @@
+package unit
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/sv4u/spotigo"
+	"github.com/sv4u/spotigo/tests"
+)
+
+// blockingTrackServer serves a track, holding each response until release
+// is closed, and signals started when a request arrives
+func blockingTrackServer(t *testing.T, requests *atomic.Int32, started chan<- struct{}, release <-chan struct{}) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		select {
+		case started <- struct{}{}:
+		default:
+		}
+		select {
+		case <-release:
+		case <-r.Context().Done():
+			return
+		}
+		tests.WriteJSONResponse(w, http.StatusOK, map[string]interface{}{"id": "4iV5W9uYEdYUVa79Axb7Rh", "name": "Shared"})
+	}))
+}
+
+// fetchTracksConcurrently starts n Track calls once the first is in flight
+func fetchTracksConcurrently(t *testing.T, client *spotigo.Client, n int, started <-chan struct{}, release chan<- struct{}) []*spotigo.Track {
+	t.Helper()
+	tracks := make([]*spotigo.Track, n)
+	var wg sync.WaitGroup
+	fetch := func(i int) {
+		defer wg.Done()
+		track, err := client.Track(context.Background(), "4iV5W9uYEdYUVa79Axb7Rh")
+		if err != nil {
+			t.Errorf("call %d: unexpected error: %v", i, err)
+		}
+		tracks[i] = track
+	}
+
+	wg.Add(n)
+	go fetch(0)
+	<-started
+	for i := 1; i < n; i++ {
+		go fetch(i)
+	}
+	time.Sleep(100 * time.Millisecond) // Let the other calls find the in-flight request
+	close(release)
+	wg.Wait()
+	return tracks
+}
+
+// TestRequestCoalescing tests that identical concurrent GETs share a request
+func TestRequestCoalescing(t *testing.T) {
+	var requests atomic.Int32
+	started, release := make(chan struct{}, 1), make(chan struct{})
+	server := blockingTrackServer(t, &requests, started, release)
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+	spotigo.WithRequestCoalescing(true)(client)
+
+	tracks := fetchTracksConcurrently(t, client, 10, started, release)
+	if requests.Load() != 1 {
+		t.Errorf("expected 1 request, got %d", requests.Load())
+	}
+	for i, track := range tracks {
+		if track == nil || track.Name != "Shared" {
+			t.Errorf("call %d: unexpected track %+v", i, track)
+		}
+	}
+	if tracks[0] == tracks[1] {
+		t.Error("expected each caller to get its own track value")
+	}
+}
+
+// TestRequestCoalescingDisabled tests that requests are not shared by default
+func TestRequestCoalescingDisabled(t *testing.T) {
+	var requests atomic.Int32
+	started, release := make(chan struct{}, 1), make(chan struct{})
+	server := blockingTrackServer(t, &requests, started, release)
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+	fetchTracksConcurrently(t, client, 4, started, release)
+	if requests.Load() != 4 {
+		t.Errorf("expected 4 requests, got %d", requests.Load())
+	}
+}
+
+// TestRequestCoalescingLeaderCanceled tests that a waiter fetches for itself
+// when the shared request fails because its caller gave up
+func TestRequestCoalescingLeaderCanceled(t *testing.T) {
+	var requests atomic.Int32
+	started, release := make(chan struct{}, 1), make(chan struct{})
+	server := blockingTrackServer(t, &requests, started, release)
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+	spotigo.WithRequestCoalescing(true)(client)
+
+	leaderCtx, cancelLeader := context.WithCancel(context.Background())
+	leaderDone := make(chan error, 1)
+	go func() {
+		_, err := client.Track(leaderCtx, "4iV5W9uYEdYUVa79Axb7Rh")
+		leaderDone <- err
+	}()
+	<-started
+
+	waiterDone := make(chan *spotigo.Track, 1)
+	go func() {
+		track, err := client.Track(context.Background(), "4iV5W9uYEdYUVa79Axb7Rh")
+		if err != nil {
+			t.Errorf("unexpected waiter error: %v", err)
+		}
+		waiterDone <- track
+	}()
+	time.Sleep(100 * time.Millisecond)
+
+	cancelLeader()
+	if err := <-leaderDone; err == nil {
+		t.Fatal("expected the canceled caller to fail")
+	}
+	<-started
+	close(release)
+
+	if track := <-waiterDone; track == nil || track.Name != "Shared" {
+		t.Errorf("unexpected waiter track: %+v", track)
+	}
+	if requests.Load() != 2 {
+		t.Errorf("expected the waiter to make its own request, got %d requests", requests.Load())
+	}
+}
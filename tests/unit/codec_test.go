@@ -0,0 +1,79 @@
+package unit
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/sv4u/spotigo"
+	"github.com/sv4u/spotigo/tests"
+)
+
+// countingCodec wraps StdCodec, counting calls and optionally failing them
+type countingCodec struct {
+	spotigo.StdCodec
+	marshals, unmarshals int
+	err                  error
+}
+
+func (c *countingCodec) Marshal(v interface{}) ([]byte, error) {
+	c.marshals++
+	if c.err != nil {
+		return nil, c.err
+	}
+	return c.StdCodec.Marshal(v)
+}
+
+func (c *countingCodec) Unmarshal(data []byte, v interface{}) error {
+	c.unmarshals++
+	if c.err != nil {
+		return c.err
+	}
+	return c.StdCodec.Unmarshal(data, v)
+}
+
+func TestWithCodec(t *testing.T) {
+	var body string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			data, _ := io.ReadAll(r.Body)
+			body = string(data)
+			tests.WriteJSONResponse(w, http.StatusCreated, map[string]interface{}{"snapshot_id": "s1"})
+			return
+		}
+		tests.WriteJSONResponse(w, http.StatusOK, map[string]interface{}{"id": "4iV5W9uYEdYUVa79Axb7Rh", "name": "Test Track", "mood": "calm"})
+	}))
+	defer server.Close()
+
+	codec := &countingCodec{}
+	client := newPlayerTestClient(t, server)
+	spotigo.WithCodec(codec)(client)
+
+	track, err := client.Track(context.Background(), "4iV5W9uYEdYUVa79Axb7Rh")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if codec.unmarshals != 1 || track.Name != "Test Track" {
+		t.Errorf("expected the response to be decoded by the codec, got %d calls", codec.unmarshals)
+	}
+	if _, ok := track.Extras["mood"]; !ok {
+		t.Error("expected unknown fields to still be kept in Extras")
+	}
+
+	if _, err := client.PlaylistAddItems(context.Background(), "37i9dQZF1DXcBWIGoYBM5M", []string{"spotify:track:4iV5W9uYEdYUVa79Axb7Rh"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if codec.marshals != 1 || !strings.Contains(body, "spotify:track:4iV5W9uYEdYUVa79Axb7Rh") {
+		t.Errorf("expected the request body to be encoded by the codec, got %d calls and body %q", codec.marshals, body)
+	}
+
+	// Codec errors are reported like encoding/json's
+	codec.err = errors.New("codec failure")
+	if _, err := client.Track(context.Background(), "4iV5W9uYEdYUVa79Axb7Rh"); !errors.Is(err, codec.err) {
+		t.Errorf("expected the codec's error, got %v", err)
+	}
+}
@@ -0,0 +1,171 @@
+package unit
+
+import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/sv4u/spotigo"
+	"github.com/sv4u/spotigo/tests"
+)
+
+// audioAnalysisJSON builds an audio analysis with n segments, about the size
+// of a real one for n around 1000
+func audioAnalysisJSON(n int) []byte {
+	segments := make([]map[string]interface{}, n)
+	for i := range segments {
+		segments[i] = map[string]interface{}{
+			"start": float64(i) * 0.25, "duration": 0.25, "confidence": 0.5,
+			"loudness_start": -20.1, "loudness_max": -8.7, "loudness_max_time": 0.04, "loudness_end": 0,
+			"pitches": []float64{0.9, 0.2, 0.1, 0.3, 0.1, 0.2, 0.4, 0.1, 0.2, 0.7, 0.1, 0.3},
+			"timbre":  []float64{42.1, 20.3, -30.4, 10.5, -8.6, 5.7, -2.8, 3.9, -1.0, 4.1, -2.2, 1.3},
+		}
+	}
+	data, _ := json.Marshal(map[string]interface{}{
+		"track":    map[string]interface{}{"duration": float64(n) * 0.25, "tempo": 120.0},
+		"segments": segments,
+	})
+	return data
+}
+
+// compressingServer serves body, encoded as the request's Accept-Encoding
+// allows, and records the Accept-Encoding headers it receives
+func compressingServer(t *testing.T, body []byte, acceptEncodings *[]string) *httptest.Server {
+	t.Helper()
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		accept := r.Header.Get("Accept-Encoding")
+		if acceptEncodings != nil {
+			*acceptEncodings = append(*acceptEncodings, accept)
+		}
+		w.Header().Set("Content-Type", "application/json")
+
+		var buf bytes.Buffer
+		switch {
+		case strings.Contains(accept, "gzip"):
+			w.Header().Set("Content-Encoding", "gzip")
+			zw := gzip.NewWriter(&buf)
+			zw.Write(body)
+			zw.Close()
+		case strings.Contains(accept, "deflate"):
+			w.Header().Set("Content-Encoding", "deflate")
+			zw := zlib.NewWriter(&buf)
+			zw.Write(body)
+			zw.Close()
+		default:
+			buf.Write(body)
+		}
+		w.Write(buf.Bytes())
+	}))
+}
+
+func TestResponseCompression(t *testing.T) {
+	body := audioAnalysisJSON(1000)
+	var acceptEncodings []string
+	server := compressingServer(t, body, &acceptEncodings)
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+
+	var raw spotigo.RawResponse
+	analysis, err := client.AudioAnalysis(spotigo.ContextWithRawResponse(context.Background(), &raw), "6b2oQwSGFkzsMtQruIWm2p")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(analysis.Segments) != 1000 || analysis.Track == nil || analysis.Track.Tempo != 120 {
+		t.Errorf("unexpected analysis: %d segments", len(analysis.Segments))
+	}
+	if acceptEncodings[0] != "gzip, deflate" {
+		t.Errorf("expected Accept-Encoding gzip, deflate, got %q", acceptEncodings[0])
+	}
+	if !bytes.Equal(raw.Body, body) || raw.Header.Get("Content-Encoding") != "" {
+		t.Errorf("expected the raw response to be decoded (Content-Encoding %q)", raw.Header.Get("Content-Encoding"))
+	}
+
+	received := client.Stats().BytesReceived
+	if received*4 > int64(len(body)) {
+		t.Errorf("expected the transfer to be at least 4x smaller than %d bytes, got %d", len(body), received)
+	}
+}
+
+func TestResponseCompressionDeflate(t *testing.T) {
+	server := compressingServer(t, audioAnalysisJSON(10), nil)
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+	client.DefaultHeaders = http.Header{"Accept-Encoding": {"deflate"}}
+
+	analysis, err := client.AudioAnalysis(context.Background(), "6b2oQwSGFkzsMtQruIWm2p")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(analysis.Segments) != 10 {
+		t.Errorf("expected 10 segments, got %d", len(analysis.Segments))
+	}
+}
+
+func TestResponseCompressionDisabled(t *testing.T) {
+	body := audioAnalysisJSON(10)
+	var acceptEncodings []string
+	server := compressingServer(t, body, &acceptEncodings)
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+	spotigo.WithCompression(false)(client)
+	// Keep the transport from requesting gzip on its own
+	client.HTTPClient = &http.Client{Transport: &http.Transport{DisableCompression: true}}
+
+	if _, err := client.AudioAnalysis(context.Background(), "6b2oQwSGFkzsMtQruIWm2p"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if acceptEncodings[0] != "" {
+		t.Errorf("expected no Accept-Encoding, got %q", acceptEncodings[0])
+	}
+	if received := client.Stats().BytesReceived; received != int64(len(body)) {
+		t.Errorf("expected %d bytes received, got %d", len(body), received)
+	}
+}
+
+// BenchmarkAudioAnalysisTransfer compares the bytes transferred for an audio
+// analysis with and without compression (see the transfer-B/op metric)
+func BenchmarkAudioAnalysisTransfer(b *testing.B) {
+	body := audioAnalysisJSON(1000)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.Contains(r.Header.Get("Accept-Encoding"), "gzip") {
+			w.Header().Set("Content-Encoding", "gzip")
+			zw := gzip.NewWriter(w)
+			zw.Write(body)
+			zw.Close()
+			return
+		}
+		w.Write(body)
+	}))
+	defer server.Close()
+
+	for _, compression := range []bool{true, false} {
+		b.Run(fmt.Sprintf("compression=%v", compression), func(b *testing.B) {
+			client, err := spotigo.NewClient(&tests.MockAuthManager{Token: &spotigo.TokenInfo{AccessToken: "test_token"}},
+				spotigo.WithCompression(compression))
+			if err != nil {
+				b.Fatal(err)
+			}
+			client.APIPrefix = server.URL + "/"
+			client.HTTPClient = &http.Client{Transport: &http.Transport{DisableCompression: true}}
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if _, err := client.AudioAnalysis(context.Background(), "6b2oQwSGFkzsMtQruIWm2p"); err != nil {
+					b.Fatal(err)
+				}
+			}
+			b.ReportMetric(float64(client.Stats().BytesReceived)/float64(b.N), "transfer-B/op")
+		})
+	}
+}
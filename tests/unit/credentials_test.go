@@ -0,0 +1,95 @@
+package unit
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/sv4u/spotigo"
+)
+
+func TestClientCredentialsFromProviderRotates(t *testing.T) {
+	secret := "secret_v1"
+	provider := spotigo.CredentialsProviderFunc(func(ctx context.Context) (string, string, error) {
+		return "client_id", secret, nil
+	})
+
+	auth, err := spotigo.NewClientCredentialsFromProvider(provider)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var secretsSeen []string
+	auth.HTTPClient = &http.Client{Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+		user, pass, ok := r.BasicAuth()
+		if !ok || user != "client_id" {
+			t.Errorf("unexpected basic auth: %q %q", user, pass)
+		}
+		secretsSeen = append(secretsSeen, pass)
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+			Body:       io.NopCloser(strings.NewReader(`{"access_token": "access", "token_type": "Bearer", "expires_in": 0}`)),
+			Request:    r,
+		}, nil
+	})}
+
+	ctx := context.Background()
+	if _, err := auth.GetAccessToken(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Token expires immediately, so the next call requests a new one with rotated credentials
+	secret = "secret_v2"
+	if _, err := auth.GetAccessToken(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(secretsSeen) != 2 || secretsSeen[0] != "secret_v1" || secretsSeen[1] != "secret_v2" {
+		t.Errorf("expected rotated secrets [secret_v1 secret_v2], got %v", secretsSeen)
+	}
+}
+
+func TestCredentialsProviderError(t *testing.T) {
+	providerErr := errors.New("vault sealed")
+	auth, err := spotigo.NewClientCredentialsFromProvider(spotigo.CredentialsProviderFunc(
+		func(ctx context.Context) (string, string, error) {
+			return "", "", providerErr
+		},
+	))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, err := auth.GetAccessToken(context.Background()); !errors.Is(err, providerErr) {
+		t.Errorf("expected provider error, got %v", err)
+	}
+
+	if _, err := spotigo.NewClientCredentialsFromProvider(nil); err == nil {
+		t.Error("expected error for nil provider")
+	}
+}
+
+func TestStaticAndEnvCredentials(t *testing.T) {
+	ctx := context.Background()
+
+	id, secret, err := spotigo.StaticCredentials{ClientID: "id", ClientSecret: "secret"}.Credentials(ctx)
+	if err != nil || id != "id" || secret != "secret" {
+		t.Errorf("unexpected static credentials: %q %q %v", id, secret, err)
+	}
+
+	t.Setenv("TEST_SPOTIFY_ID", "env_id")
+	t.Setenv("TEST_SPOTIFY_SECRET", "env_secret")
+	provider := spotigo.EnvCredentials{ClientIDVar: "TEST_SPOTIFY_ID", ClientSecretVar: "TEST_SPOTIFY_SECRET"}
+	id, secret, err = provider.Credentials(ctx)
+	if err != nil || id != "env_id" || secret != "env_secret" {
+		t.Errorf("unexpected env credentials: %q %q %v", id, secret, err)
+	}
+
+	if _, _, err := (spotigo.EnvCredentials{ClientIDVar: "TEST_SPOTIFY_UNSET"}).Credentials(ctx); err == nil {
+		t.Error("expected error for unset variable")
+	}
+}
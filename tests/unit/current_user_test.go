@@ -0,0 +1,71 @@
+package unit
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sv4u/spotigo"
+	"github.com/sv4u/spotigo/tests"
+)
+
+func TestMeIDCachesCurrentUser(t *testing.T) {
+	meCalls := 0
+	userID := "alice"
+	var created string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/me":
+			meCalls++
+			tests.WriteJSONResponse(w, http.StatusOK, map[string]interface{}{"id": userID, "display_name": "Alice"})
+		case "/users/alice/playlists", "/users/bob/playlists":
+			created = r.URL.Path
+			tests.WriteJSONResponse(w, http.StatusCreated, map[string]interface{}{"id": "p1", "name": "Mix"})
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		id, err := client.MeID(ctx)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if id != "alice" {
+			t.Errorf("expected alice, got %q", id)
+		}
+	}
+	if meCalls != 1 {
+		t.Errorf("expected the profile to be fetched once, got %d requests", meCalls)
+	}
+
+	// An empty user ID creates the playlist for the current user
+	if _, err := client.UserPlaylistCreate(ctx, "", &spotigo.CreatePlaylistOptions{Name: "Mix"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if created != "/users/alice/playlists" || meCalls != 1 {
+		t.Errorf("expected a cached current-user playlist create, got %s with %d profile requests", created, meCalls)
+	}
+
+	// Changes to the cached copy do not leak into the cache
+	me, _ := client.Me(ctx)
+	me.ID = "mallory"
+	if id, _ := client.MeID(ctx); id != "alice" {
+		t.Errorf("expected the cache to hold its own copy, got %q", id)
+	}
+
+	// After invalidation the profile is fetched again
+	userID = "bob"
+	client.InvalidateCurrentUser()
+	if id, err := client.MeID(ctx); err != nil || id != "bob" {
+		t.Errorf("expected bob after invalidation, got %q (%v)", id, err)
+	}
+	if meCalls != 2 {
+		t.Errorf("expected a second profile request, got %d", meCalls)
+	}
+}
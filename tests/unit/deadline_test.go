@@ -0,0 +1,165 @@
+package unit
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/sv4u/spotigo"
+	"github.com/sv4u/spotigo/tests"
+)
+
+// recordingLogger records warnings
+type recordingLogger struct {
+	warnings []string
+}
+
+func (l *recordingLogger) Debug(format string, v ...interface{}) {}
+func (l *recordingLogger) Info(format string, v ...interface{})  {}
+func (l *recordingLogger) Warn(format string, v ...interface{}) {
+	l.warnings = append(l.warnings, format)
+}
+func (l *recordingLogger) Error(format string, v ...interface{}) {}
+
+// trackServer serves a single track for every request
+func trackServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id": "4iV5W9uYEdYUVa79Axb7Rh", "name": "Test Track"}`))
+	}))
+}
+
+func TestDeadlineRequire(t *testing.T) {
+	server := trackServer(t)
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+	client.DeadlinePolicy = spotigo.DeadlineRequire
+
+	_, err := client.Track(context.Background(), "4iV5W9uYEdYUVa79Axb7Rh")
+	if !errors.Is(err, spotigo.ErrNoDeadline) {
+		t.Fatalf("expected ErrNoDeadline, got %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if _, err := client.Track(ctx, "4iV5W9uYEdYUVa79Axb7Rh"); err != nil {
+		t.Errorf("expected call with deadline to succeed, got %v", err)
+	}
+}
+
+func TestDeadlineWarn(t *testing.T) {
+	server := trackServer(t)
+	defer server.Close()
+
+	logger := &recordingLogger{}
+	client := newPlayerTestClient(t, server)
+	client.Logger = logger
+	client.DeadlinePolicy = spotigo.DeadlineWarn
+
+	if _, err := client.Track(context.Background(), "4iV5W9uYEdYUVa79Axb7Rh"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(logger.warnings) != 1 || !strings.Contains(logger.warnings[0], "deadline") {
+		t.Errorf("expected one deadline warning, got %v", logger.warnings)
+	}
+}
+
+func TestDefaultCallTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+	client.DeadlinePolicy = spotigo.DeadlineRequire
+	client.DefaultCallTimeout = 50 * time.Millisecond
+	client.RetryConfig.MaxRetries = 0
+
+	start := time.Now()
+	_, err := client.Track(context.Background(), "4iV5W9uYEdYUVa79Axb7Rh")
+	if err == nil {
+		t.Fatal("expected timeout error")
+	}
+	if errors.Is(err, spotigo.ErrNoDeadline) {
+		t.Fatal("expected default timeout to satisfy DeadlineRequire")
+	}
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected context.DeadlineExceeded, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Errorf("expected call to stop at the default timeout, took %v", elapsed)
+	}
+}
+
+func TestRetryBackoffFitsDeadline(t *testing.T) {
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts.Add(1)
+		tests.WriteJSONResponse(w, http.StatusServiceUnavailable, tests.CreateErrorResponse(503, "Service Unavailable", ""))
+	}))
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+	client.RetryConfig.MaxRetries = 3
+	client.RetryConfig.Backoff = spotigo.LinearBackoff{Factor: 10} // Far longer than the deadline
+
+	ctx, cancel := context.WithTimeout(context.Background(), 400*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := client.Track(ctx, "4iV5W9uYEdYUVa79Axb7Rh")
+	elapsed := time.Since(start)
+
+	// The first backoff is shrunk to fit one more attempt; the second fails fast
+	if !errors.Is(err, spotigo.ErrDeadlineWouldExceed) {
+		t.Fatalf("expected ErrDeadlineWouldExceed, got %v", err)
+	}
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected the error to match context.DeadlineExceeded")
+	}
+	var spotifyErr *spotigo.SpotifyError
+	if !errors.As(err, &spotifyErr) || spotifyErr.HTTPStatus != 503 {
+		t.Errorf("expected the last attempt's 503 to be wrapped, got %v", err)
+	}
+	var exceeded *spotigo.DeadlineWouldExceedError
+	if !errors.As(err, &exceeded) || exceeded.Attempt != 2 || exceeded.Delay != 20*time.Second {
+		t.Errorf("unexpected error details: %+v", exceeded)
+	}
+	if got := attempts.Load(); got != 2 {
+		t.Errorf("expected 2 attempts, got %d", got)
+	}
+	if elapsed >= 400*time.Millisecond {
+		t.Errorf("expected the call to return before the deadline, took %v", elapsed)
+	}
+}
+
+func TestRetryAfterBeyondDeadlineFailsFast(t *testing.T) {
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts.Add(1)
+		w.Header().Set("Retry-After", "30")
+		tests.WriteJSONResponse(w, http.StatusTooManyRequests, tests.CreateErrorResponse(429, "Too Many Requests", ""))
+	}))
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := client.Track(ctx, "4iV5W9uYEdYUVa79Axb7Rh")
+	var exceeded *spotigo.DeadlineWouldExceedError
+	if !errors.As(err, &exceeded) || exceeded.Delay != 30*time.Second {
+		t.Fatalf("expected a DeadlineWouldExceedError for the Retry-After delay, got %v", err)
+	}
+	if got := attempts.Load(); got != 1 {
+		t.Errorf("expected Retry-After not to be shrunk, got %d attempts", got)
+	}
+}
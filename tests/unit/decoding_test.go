@@ -0,0 +1,99 @@
+package unit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/sv4u/spotigo"
+)
+
+func TestModelExtras(t *testing.T) {
+	var track spotigo.Track
+	if err := json.Unmarshal([]byte(`{"id": "t1", "name": "Song", "new_field": {"a": 1}}`), &track); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if track.ID != "t1" || track.Name != "Song" {
+		t.Errorf("known fields not decoded: %+v", track)
+	}
+	if string(track.Extras["new_field"]) != `{"a": 1}` || len(track.Extras) != 1 {
+		t.Errorf("unexpected extras: %v", track.Extras)
+	}
+
+	// Fields of embedded models are known too
+	var playlist spotigo.Playlist
+	data := `{"id": "p1", "name": "Mix", "description": "d", "followers": {"total": 3}, "primary_color": "#fff"}`
+	if err := json.Unmarshal([]byte(data), &playlist); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if playlist.Name != "Mix" || playlist.Followers == nil || playlist.Followers.Total != 3 ||
+		playlist.Description == nil || *playlist.Description != "d" {
+		t.Errorf("known fields not decoded: %+v", playlist)
+	}
+	if len(playlist.Extras) != 1 || playlist.Extras["primary_color"] == nil {
+		t.Errorf("unexpected extras: %v", playlist.Extras)
+	}
+
+	var artist spotigo.Artist
+	if err := json.Unmarshal([]byte(`{"id": "a1"}`), &artist); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if artist.Extras != nil {
+		t.Errorf("expected nil extras without unknown fields, got %v", artist.Extras)
+	}
+}
+
+func TestStrictDecoding(t *testing.T) {
+	responses := map[string]string{
+		"/tracks/4iV5W9uYEdYUVa79Axb7Rh":  `{"id": "4iV5W9uYEdYUVa79Axb7Rh", "album": {"id": "a1"}, "new_field": 1}`,
+		"/tracks":                         `{"tracks": [{"id": "t1", "lyrics": "la"}, {"id": "t2", "mood": "calm"}]}`,
+		"/me/player/devices":              `{"devices": [], "new_field": true}`,
+		"/artists/0OdUWJ0sBjDrqHygGUXeCF": `{"id": "0OdUWJ0sBjDrqHygGUXeCF", "name": "Band"}`,
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(responses[r.URL.Path]))
+	}))
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+	ctx := context.Background()
+
+	// Lenient by default
+	track, err := client.Track(ctx, "4iV5W9uYEdYUVa79Axb7Rh")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if track.Extras["new_field"] == nil {
+		t.Errorf("expected new_field in extras, got %v", track.Extras)
+	}
+
+	spotigo.WithStrictDecoding(true)(client)
+
+	var unknown *spotigo.UnknownFieldError
+	_, err = client.Track(ctx, "4iV5W9uYEdYUVa79Axb7Rh")
+	if !errors.As(err, &unknown) || !errors.Is(err, spotigo.ErrUnknownField) {
+		t.Fatalf("expected UnknownFieldError, got %v", err)
+	}
+	if strings.Join(unknown.Fields, ",") != "new_field" {
+		t.Errorf("unexpected fields: %v", unknown.Fields)
+	}
+
+	_, err = client.Tracks(ctx, []string{"4iV5W9uYEdYUVa79Axb7Rh", "0OdUWJ0sBjDrqHygGUXeCF"})
+	if !errors.As(err, &unknown) || strings.Join(unknown.Fields, ",") != "tracks.lyrics,tracks.mood" {
+		t.Errorf("expected nested unknown fields, got %v", err)
+	}
+
+	_, err = client.CurrentUserDevices(ctx)
+	if !errors.As(err, &unknown) || strings.Join(unknown.Fields, ",") != "new_field" {
+		t.Errorf("expected unknown field in a non-core response, got %v", err)
+	}
+
+	if _, err := client.Artist(ctx, "0OdUWJ0sBjDrqHygGUXeCF"); err != nil {
+		t.Errorf("unexpected error for a response matching the model: %v", err)
+	}
+}
@@ -0,0 +1,124 @@
+package unit
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sv4u/spotigo"
+	"github.com/sv4u/spotigo/tests"
+)
+
+// sunsetServer refuses the sunset endpoints with 404s and serves the
+// category, artist, and search endpoints the fallbacks use
+func sunsetServer(t *testing.T, requests *[]string) *httptest.Server {
+	t.Helper()
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*requests = append(*requests, r.URL.Path)
+		switch r.URL.Path {
+		case "/browse/categories/party":
+			tests.WriteJSONResponse(w, http.StatusOK, map[string]interface{}{"id": "party", "name": "Party"})
+		case "/artists/3jOstUTkEu2JkjvRdBA5Gu":
+			tests.WriteJSONResponse(w, http.StatusOK, map[string]interface{}{"id": "3jOstUTkEu2JkjvRdBA5Gu", "genres": []string{"dream pop", "shoegaze"}})
+		case "/search":
+			switch r.URL.Query().Get("type") {
+			case "playlist":
+				if q := r.URL.Query().Get("q"); q != "Party" {
+					t.Errorf("expected category name as query, got %q", q)
+				}
+				if market := r.URL.Query().Get("market"); market != "SE" {
+					t.Errorf("expected market SE, got %q", market)
+				}
+				tests.WriteJSONResponse(w, http.StatusOK, map[string]interface{}{
+					"playlists": map[string]interface{}{"items": []interface{}{map[string]interface{}{"id": "p1"}, nil}, "limit": 5, "total": 2},
+				})
+			case "artist":
+				if q := r.URL.Query().Get("q"); q != `genre:"dream pop"` {
+					t.Errorf("expected genre query, got %q", q)
+				}
+				tests.WriteJSONResponse(w, http.StatusOK, map[string]interface{}{
+					"artists": map[string]interface{}{"items": []map[string]interface{}{{"id": "3jOstUTkEu2JkjvRdBA5Gu"}, {"id": "a2"}}},
+				})
+			}
+		default:
+			tests.WriteJSONResponse(w, http.StatusNotFound, tests.CreateErrorResponse(404, "Not Found", ""))
+		}
+	}))
+}
+
+func TestDeprecatedEndpointError(t *testing.T) {
+	var requests []string
+	server := sunsetServer(t, &requests)
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+
+	_, err := client.AudioFeatures(context.Background(), "6b2oQwSGFkzsMtQruIWm2p")
+	if !errors.Is(err, spotigo.ErrEndpointDeprecated) {
+		t.Fatalf("expected ErrEndpointDeprecated, got %v", err)
+	}
+	var deprecated *spotigo.EndpointDeprecatedError
+	if !errors.As(err, &deprecated) || deprecated.Endpoint != spotigo.EndpointAudioFeatures || deprecated.Guidance == "" {
+		t.Errorf("unexpected error: %#v", err)
+	}
+	var spotifyErr *spotigo.SpotifyError
+	if !errors.As(err, &spotifyErr) || spotifyErr.HTTPStatus != 404 {
+		t.Errorf("expected the 404 to be wrapped, got %v", err)
+	}
+
+	// Without fallbacks, the category playlists endpoint fails the same way
+	if _, err := client.BrowseCategoryPlaylists(context.Background(), "party", nil); !errors.Is(err, spotigo.ErrEndpointDeprecated) {
+		t.Errorf("expected ErrEndpointDeprecated, got %v", err)
+	}
+}
+
+func TestDisabledEndpoints(t *testing.T) {
+	var requests []string
+	server := sunsetServer(t, &requests)
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+	spotigo.WithDisabledEndpoints(spotigo.EndpointRecommendations)(client)
+
+	_, err := client.Recommendations(context.Background(), &spotigo.RecommendationsOptions{SeedGenres: []string{"pop"}})
+	var deprecated *spotigo.EndpointDeprecatedError
+	if !errors.As(err, &deprecated) || deprecated.Err != nil {
+		t.Errorf("expected a disabled-endpoint error, got %v", err)
+	}
+	if len(requests) != 0 {
+		t.Errorf("expected no requests to a disabled endpoint, got %v", requests)
+	}
+}
+
+func TestDeprecationFallbacks(t *testing.T) {
+	var requests []string
+	server := sunsetServer(t, &requests)
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+	spotigo.WithDeprecationFallbacks(true)(client)
+
+	playlists, err := client.BrowseCategoryPlaylists(context.Background(), "party", &spotigo.CategoryPlaylistsOptions{Country: "SE", Limit: 5})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(playlists.Playlists.Items) != 1 || playlists.Playlists.Items[0].ID != "p1" {
+		t.Errorf("unexpected playlists: %+v", playlists.Playlists.Items)
+	}
+
+	related, err := client.ArtistRelatedArtists(context.Background(), "3jOstUTkEu2JkjvRdBA5Gu")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(related.Artists) != 1 || related.Artists[0].ID != "a2" {
+		t.Errorf("expected the artist itself to be left out, got %+v", related.Artists)
+	}
+
+	// Endpoints without a fallback still fail
+	if _, err := client.AudioAnalysis(context.Background(), "6b2oQwSGFkzsMtQruIWm2p"); !errors.Is(err, spotigo.ErrEndpointDeprecated) {
+		t.Errorf("expected ErrEndpointDeprecated, got %v", err)
+	}
+}
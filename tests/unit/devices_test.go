@@ -0,0 +1,411 @@
+package unit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/sv4u/spotigo"
+	"github.com/sv4u/spotigo/tests"
+)
+
+// devicesResponse is a canned /me/player/devices response
+var devicesResponse = map[string]interface{}{
+	"devices": []map[string]interface{}{
+		{"id": "device1", "name": "Kitchen Speaker", "type": "Speaker", "is_active": false},
+		{"id": "device2", "name": "Laptop", "type": "Computer", "is_active": true},
+	},
+}
+
+// TestDeviceByName tests case-insensitive lookup and caching
+func TestDeviceByName(t *testing.T) {
+	var deviceCalls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/me/player/devices" {
+			atomic.AddInt32(&deviceCalls, 1)
+			tests.WriteJSONResponse(w, http.StatusOK, devicesResponse)
+			return
+		}
+		t.Errorf("unexpected path: %s", r.URL.Path)
+	}))
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+	ctx := context.Background()
+
+	device, err := client.DeviceByName(ctx, "kitchen speaker")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if device.ID == nil || *device.ID != "device1" {
+		t.Errorf("expected device1, got %v", device.ID)
+	}
+
+	if _, err := client.DeviceByName(ctx, "Laptop"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if calls := atomic.LoadInt32(&deviceCalls); calls != 1 {
+		t.Errorf("expected 1 devices call with cache, got %d", calls)
+	}
+}
+
+// TestDeviceByNameNotFound tests that a missing device returns ErrDeviceNotFound
+func TestDeviceByNameNotFound(t *testing.T) {
+	var deviceCalls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&deviceCalls, 1)
+		tests.WriteJSONResponse(w, http.StatusOK, devicesResponse)
+	}))
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+
+	_, err := client.DeviceByName(context.Background(), "Bedroom")
+	if !errors.Is(err, spotigo.ErrDeviceNotFound) {
+		t.Fatalf("expected ErrDeviceNotFound, got %v", err)
+	}
+
+	var notFound *spotigo.DeviceNotFoundError
+	if !errors.As(err, &notFound) || notFound.Name != "Bedroom" {
+		t.Errorf("expected DeviceNotFoundError for Bedroom, got %v", err)
+	}
+
+	// A miss forces one refresh of the cached list
+	if calls := atomic.LoadInt32(&deviceCalls); calls != 2 {
+		t.Errorf("expected 2 devices calls, got %d", calls)
+	}
+}
+
+// TestDeviceCacheDisabled tests that a zero TTL disables caching
+func TestDeviceCacheDisabled(t *testing.T) {
+	var deviceCalls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&deviceCalls, 1)
+		tests.WriteJSONResponse(w, http.StatusOK, devicesResponse)
+	}))
+	defer server.Close()
+
+	auth := &tests.MockAuthManager{Token: &spotigo.TokenInfo{AccessToken: "test_token"}}
+	client, err := spotigo.NewClient(auth, spotigo.WithDeviceCacheTTL(0))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	client.APIPrefix = server.URL + "/"
+
+	ctx := context.Background()
+	for i := 0; i < 2; i++ {
+		if _, err := client.DeviceByName(ctx, "Laptop"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	if calls := atomic.LoadInt32(&deviceCalls); calls != 2 {
+		t.Errorf("expected 2 devices calls without cache, got %d", calls)
+	}
+}
+
+// TestPausePlaybackOnDevice tests that device names resolve to device_id
+func TestPausePlaybackOnDevice(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/me/player/devices":
+			tests.WriteJSONResponse(w, http.StatusOK, devicesResponse)
+		case "/me/player/pause":
+			if got := r.URL.Query().Get("device_id"); got != "device1" {
+				t.Errorf("expected device_id=device1, got %q", got)
+			}
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+
+	if err := client.PausePlaybackOnDevice(context.Background(), "Kitchen Speaker"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+// TestStartPlaybackOnDeviceNotFound tests that playback variants surface ErrDeviceNotFound
+func TestStartPlaybackOnDeviceNotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/me/player/devices" {
+			t.Errorf("unexpected request to %s", r.URL.Path)
+		}
+		tests.WriteJSONResponse(w, http.StatusOK, devicesResponse)
+	}))
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+
+	err := client.StartPlaybackOnDevice(context.Background(), "Car", nil)
+	if !errors.Is(err, spotigo.ErrDeviceNotFound) {
+		t.Errorf("expected ErrDeviceNotFound, got %v", err)
+	}
+}
+
+// TestEnsureActiveDeviceAlreadyActive tests that no transfer happens when a device is active
+func TestEnsureActiveDeviceAlreadyActive(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/me/player" || r.Method != http.MethodGet {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		tests.WriteJSONResponse(w, http.StatusOK, map[string]interface{}{
+			"device":     map[string]interface{}{"id": "device2", "name": "Laptop", "is_active": true},
+			"is_playing": false,
+		})
+	}))
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+
+	device, err := client.EnsureActiveDevice(context.Background(), "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if device.ID == nil || *device.ID != "device2" {
+		t.Errorf("expected device2, got %v", device.ID)
+	}
+}
+
+// TestEnsureActiveDeviceTransfers tests that playback is transferred when nothing is active
+func TestEnsureActiveDeviceTransfers(t *testing.T) {
+	var transferred []interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/me/player" && r.Method == http.MethodGet:
+			w.WriteHeader(http.StatusNoContent)
+		case r.URL.Path == "/me/player" && r.Method == http.MethodPut:
+			var body map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Fatalf("failed to decode request body: %v", err)
+			}
+			transferred, _ = body["device_ids"].([]interface{})
+			if body["play"] != false {
+				t.Errorf("expected play=false, got %v", body["play"])
+			}
+			w.WriteHeader(http.StatusNoContent)
+		case r.URL.Path == "/me/player/devices":
+			tests.WriteJSONResponse(w, http.StatusOK, map[string]interface{}{
+				"devices": []map[string]interface{}{
+					{"id": "device1", "name": "Kitchen Speaker", "is_active": false},
+				},
+			})
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+
+	device, err := client.EnsureActiveDevice(context.Background(), "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if device.ID == nil || *device.ID != "device1" {
+		t.Errorf("expected device1, got %v", device.ID)
+	}
+	if len(transferred) != 1 || transferred[0] != "device1" {
+		t.Errorf("expected transfer to device1, got %v", transferred)
+	}
+}
+
+// TestWithActiveDeviceRetries tests that NO_ACTIVE_DEVICE triggers activation and a retry
+func TestWithActiveDeviceRetries(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/me/player" && r.Method == http.MethodGet:
+			w.WriteHeader(http.StatusNoContent)
+		case r.URL.Path == "/me/player" && r.Method == http.MethodPut:
+			w.WriteHeader(http.StatusNoContent)
+		case r.URL.Path == "/me/player/devices":
+			tests.WriteJSONResponse(w, http.StatusOK, devicesResponse)
+		case r.URL.Path == "/me/player/play":
+			if r.URL.Query().Get("device_id") == "" {
+				tests.WriteJSONResponse(w, http.StatusNotFound,
+					tests.CreateErrorResponse(404, "Player command failed: No active device found", "NO_ACTIVE_DEVICE"))
+				return
+			}
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+
+	var deviceIDs []string
+	err := client.WithActiveDevice(context.Background(), "", func(ctx context.Context, deviceID string) error {
+		deviceIDs = append(deviceIDs, deviceID)
+		return client.CurrentUserStartPlayback(ctx, &spotigo.StartPlaybackOptions{DeviceID: deviceID})
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(deviceIDs) != 2 || deviceIDs[0] != "" || deviceIDs[1] == "" {
+		t.Errorf("expected an empty device ID and then the activated one, got %q", deviceIDs)
+	}
+}
+
+// TestWithActiveDevicePreferredFirst tests that the first attempt targets the preferred device
+func TestWithActiveDevicePreferredFirst(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+	}))
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+
+	var deviceIDs []string
+	err := client.WithActiveDevice(context.Background(), "device1", func(ctx context.Context, deviceID string) error {
+		deviceIDs = append(deviceIDs, deviceID)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(deviceIDs) != 1 || deviceIDs[0] != "device1" {
+		t.Errorf("expected a single call with device1, got %q", deviceIDs)
+	}
+}
+
+// TestIsNoActiveDeviceError tests NO_ACTIVE_DEVICE detection
+func TestIsNoActiveDeviceError(t *testing.T) {
+	err := &spotigo.SpotifyError{HTTPStatus: 404, Reason: "NO_ACTIVE_DEVICE"}
+	if !spotigo.IsNoActiveDeviceError(err) {
+		t.Error("expected NO_ACTIVE_DEVICE to be detected")
+	}
+	if spotigo.IsNoActiveDeviceError(&spotigo.SpotifyError{HTTPStatus: 404}) {
+		t.Error("expected plain 404 not to be detected")
+	}
+	if spotigo.IsNoActiveDeviceError(nil) {
+		t.Error("expected nil not to be detected")
+	}
+}
+
+// TestCurrentUserDevicesCacheInvalidatedByTransfer tests device caching and transfer invalidation
+func TestCurrentUserDevicesCacheInvalidatedByTransfer(t *testing.T) {
+	var deviceCalls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/me/player/devices":
+			atomic.AddInt32(&deviceCalls, 1)
+			tests.WriteJSONResponse(w, http.StatusOK, devicesResponse)
+		case "/me/player":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		if _, err := client.CurrentUserDevices(ctx); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if calls := atomic.LoadInt32(&deviceCalls); calls != 1 {
+		t.Errorf("expected 1 devices call with cache, got %d", calls)
+	}
+
+	if err := client.CurrentUserTransferPlayback(ctx, []string{"device1"}, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := client.CurrentUserDevices(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if calls := atomic.LoadInt32(&deviceCalls); calls != 2 {
+		t.Errorf("expected transfer to invalidate cache, got %d calls", calls)
+	}
+}
+
+// TestWatchDevices tests that device list changes are reported as events
+func TestWatchDevices(t *testing.T) {
+	var polls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&polls, 1)
+		devices := []map[string]interface{}{
+			{"id": "device1", "name": "Kitchen Speaker", "is_active": false},
+		}
+		if n >= 3 {
+			devices = []map[string]interface{}{
+				{"id": "device1", "name": "Kitchen Speaker", "is_active": true},
+				{"id": "device3", "name": "Phone", "is_active": false},
+			}
+		}
+		tests.WriteJSONResponse(w, http.StatusOK, map[string]interface{}{"devices": devices})
+	}))
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	events := client.WatchDevices(ctx, 10*time.Millisecond)
+
+	initial := <-events
+	if initial.Err != nil {
+		t.Fatalf("unexpected error: %v", initial.Err)
+	}
+	if len(initial.Added) != 1 {
+		t.Errorf("expected initial event to add 1 device, got %d", len(initial.Added))
+	}
+
+	// Poll 2 is unchanged and must not produce an event
+	change := <-events
+	if change.Err != nil {
+		t.Fatalf("unexpected error: %v", change.Err)
+	}
+	if len(change.Added) != 1 || *change.Added[0].ID != "device3" {
+		t.Errorf("expected device3 to be added, got %+v", change.Added)
+	}
+	if len(change.Changed) != 1 || *change.Changed[0].ID != "device1" {
+		t.Errorf("expected device1 to change, got %+v", change.Changed)
+	}
+	if len(change.Devices) != 2 {
+		t.Errorf("expected 2 devices, got %d", len(change.Devices))
+	}
+
+	cancel()
+	for range events {
+	}
+}
+
+func TestWatchDevicesDefaultInterval(t *testing.T) {
+	var polls atomic.Int32
+	server := httptest.NewServer(countRequests(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tests.WriteJSONResponse(w, http.StatusOK, map[string]interface{}{"devices": []map[string]interface{}{
+			{"id": "device1", "name": "Kitchen Speaker"},
+		}})
+	}), &polls))
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	// A zero interval falls back to the default instead of panicking
+	event := <-client.WatchDevices(ctx, 0)
+	if event.Err != nil {
+		t.Fatalf("unexpected error: %v", event.Err)
+	}
+	if len(event.Added) != 1 {
+		t.Errorf("expected initial event to add 1 device, got %d", len(event.Added))
+	}
+	expectNoMorePolls(t, &polls, 1)
+}
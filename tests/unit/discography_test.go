@@ -0,0 +1,118 @@
+package unit
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/sv4u/spotigo"
+	"github.com/sv4u/spotigo/tests"
+)
+
+func TestArtistDiscography(t *testing.T) {
+	artistID := base62ID("artist", 1)
+
+	// 24 albums and a single, then re-releases of albums 3 and 5 and a
+	// same-named album with a later date
+	var catalog []map[string]interface{}
+	for n := 0; n < 24; n++ {
+		catalog = append(catalog, map[string]interface{}{
+			"id": base62ID("al", n), "name": fmt.Sprintf("Album %d", n), "album_group": "album",
+			"release_date": fmt.Sprintf("20%02d", n), "total_tracks": 10,
+		})
+	}
+	catalog = append(catalog,
+		map[string]interface{}{"id": base62ID("si", 0), "name": "Album 3", "album_group": "single", "release_date": "2003", "total_tracks": 1},
+		map[string]interface{}{"id": base62ID("dup", 3), "name": "album  3", "album_group": "album", "release_date": "2003", "total_tracks": 12},
+		map[string]interface{}{"id": base62ID("dup", 5), "name": "Album 5", "album_group": "album", "release_date": "2005", "total_tracks": 8},
+		map[string]interface{}{"id": base62ID("dup", 6), "name": "Album 6", "album_group": "album", "release_date": "2016", "total_tracks": 10},
+	)
+
+	var batches []int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/artists/" + artistID + "/albums":
+			if groups := r.URL.Query().Get("include_groups"); groups != "album,single" {
+				t.Errorf("unexpected include_groups %q", groups)
+			}
+			offset := 0
+			fmt.Sscanf(r.URL.Query().Get("offset"), "%d", &offset)
+			end := min(offset+10, len(catalog))
+			var next interface{}
+			if end < len(catalog) {
+				next = fmt.Sprintf("http://%s/artists/%s/albums?include_groups=album,single&offset=%d&limit=10", r.Host, artistID, end)
+			}
+			tests.WriteJSONResponse(w, http.StatusOK, map[string]interface{}{
+				"items": catalog[offset:end], "next": next, "offset": offset, "limit": 10, "total": len(catalog),
+			})
+		case "/albums":
+			ids := strings.Split(r.URL.Query().Get("ids"), ",")
+			batches = append(batches, len(ids))
+			albums := make([]map[string]interface{}, len(ids))
+			for i, id := range ids {
+				albums[i] = map[string]interface{}{"id": id, "label": "Label " + id}
+			}
+			tests.WriteJSONResponse(w, http.StatusOK, map[string]interface{}{"albums": albums})
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+
+	disco, err := client.ArtistDiscography(context.Background(), "spotify:artist:"+artistID, &spotigo.DiscographyOptions{
+		Groups:     []spotigo.AlbumGroup{spotigo.AlbumGroupAlbum, spotigo.AlbumGroupSingle},
+		FullAlbums: true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if disco.ArtistID != artistID {
+		t.Errorf("expected artist ID %s, got %s", artistID, disco.ArtistID)
+	}
+	// Album 6 has a different release date, so it is not a re-release
+	if len(disco.Albums) != 25 || len(disco.Singles) != 1 {
+		t.Fatalf("expected 25 albums and 1 single, got %d and %d", len(disco.Albums), len(disco.Singles))
+	}
+	if got := disco.Group(spotigo.AlbumGroupSingle); len(got) != 1 || got[0].Album.ID != base62ID("si", 0) {
+		t.Errorf("unexpected singles: %+v", got)
+	}
+
+	// The edition with the most tracks is kept, in the original position
+	album3 := disco.Albums[3]
+	if album3.Album.ID != base62ID("dup", 3) || len(album3.Editions) != 1 || album3.Editions[0].ID != base62ID("al", 3) {
+		t.Errorf("unexpected release for album 3: %+v", album3)
+	}
+	album5 := disco.Albums[5]
+	if album5.Album.ID != base62ID("al", 5) || len(album5.Editions) != 1 || album5.Editions[0].ID != base62ID("dup", 5) {
+		t.Errorf("unexpected release for album 5: %+v", album5)
+	}
+
+	if len(batches) != 2 || batches[0] != 20 || batches[1] != 6 {
+		t.Errorf("expected full albums in batches of 20 and 6, got %v", batches)
+	}
+	for _, release := range append(disco.Albums, disco.Singles...) {
+		if release.Full == nil || release.Full.Label != "Label "+release.Album.ID {
+			t.Errorf("missing full album for %s", release.Album.ID)
+		}
+	}
+}
+
+func TestArtistDiscographyInvalidGroup(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+
+	_, err := client.ArtistDiscography(context.Background(), base62ID("artist", 1), &spotigo.DiscographyOptions{
+		Groups: []spotigo.AlbumGroup{"bootleg"},
+	})
+	if err == nil {
+		t.Error("expected error for an unknown album group")
+	}
+}
@@ -185,6 +185,49 @@ func TestSearchEndpoint(t *testing.T) {
 	}
 }
 
+func TestSearchShowsEpisodesAudiobooks(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("type"); got != "show,episode,audiobook" {
+			t.Errorf("expected type show,episode,audiobook, got %q", got)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"shows": map[string]interface{}{
+				"items": []map[string]interface{}{{"id": "5CfCWKI5pZ28U0uOzXkDHe", "name": "Show", "publisher": "Pub"}},
+			},
+			"episodes": map[string]interface{}{
+				"items": []map[string]interface{}{{"id": "512ojhOuo1ktJprKbVcKyQ", "name": "Episode", "duration_ms": 1000}},
+			},
+			"audiobooks": map[string]interface{}{
+				"items": []map[string]interface{}{{"id": "7iHfbu1YPACw6oZPAFJtqe", "name": "Book", "authors": []map[string]interface{}{{"name": "Author"}}}},
+			},
+		})
+	}))
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+	result, err := client.Search(context.Background(), "query", "", &spotigo.SearchOptions{
+		Types: []spotigo.SearchType{spotigo.SearchTypeShow, spotigo.SearchTypeEpisode, spotigo.SearchTypeAudiobook},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result.Shows == nil || len(result.Shows.Items) != 1 || result.Shows.Items[0].Publisher != "Pub" {
+		t.Errorf("unexpected shows: %+v", result.Shows)
+	}
+	if result.Episodes == nil || len(result.Episodes.Items) != 1 || result.Episodes.Items[0].DurationMs != 1000 {
+		t.Errorf("unexpected episodes: %+v", result.Episodes)
+	}
+	if result.Audiobooks == nil || len(result.Audiobooks.Items) != 1 || result.Audiobooks.Items[0].Authors[0].Name != "Author" {
+		t.Errorf("unexpected audiobooks: %+v", result.Audiobooks)
+	}
+	if result.Tracks != nil {
+		t.Error("expected no tracks bucket")
+	}
+}
+
 func TestTracksMaxLimit(t *testing.T) {
 	auth := &tests.MockAuthManager{
 		Token: &spotigo.TokenInfo{
@@ -830,6 +873,43 @@ func TestCurrentUserPlaylistsEndpoint(t *testing.T) {
 	}
 }
 
+// TestUserPlaylistsEndpoint tests the UserPlaylists endpoint
+func TestUserPlaylistsEndpoint(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/users/some.user/playlists" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		if r.URL.Query().Get("limit") != "50" || r.URL.Query().Get("offset") != "10" {
+			t.Errorf("unexpected query: %s", r.URL.RawQuery)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"items": []map[string]interface{}{
+				{"id": "2oCEWyyAPbZp9xhVSxZavx", "name": "Playlist 1", "type": "playlist"},
+			},
+			"total":  11,
+			"limit":  50,
+			"offset": 10,
+		})
+	}))
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+
+	result, err := client.UserPlaylists(context.Background(), "some.user", &spotigo.UserPlaylistsOptions{Limit: 80, Offset: 10})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Items) != 1 || result.Items[0].Name != "Playlist 1" {
+		t.Errorf("unexpected playlists: %+v", result.Items)
+	}
+
+	if _, err := client.UserPlaylists(context.Background(), "", nil); err == nil {
+		t.Error("expected error for empty user ID")
+	}
+}
+
 // TestUserPlaylistCreateEndpoint tests the UserPlaylistCreate endpoint
 func TestUserPlaylistCreateEndpoint(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -1225,6 +1305,14 @@ func TestPlaylistUploadCoverImageEndpoint(t *testing.T) {
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
+
+	result, err := client.PlaylistUploadCoverImageStatus(ctx, "2oCEWyyAPbZp9xhVSxZavx", imageData)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.StatusCode != http.StatusAccepted || !result.Accepted() || result.Applied() {
+		t.Errorf("expected 202 Accepted result, got %d", result.StatusCode)
+	}
 }
 
 // TestPlaylistUploadCoverImageValidation tests validation for PlaylistUploadCoverImage
@@ -5776,6 +5864,68 @@ func TestPlaylistAddItemsInvalidPosition(t *testing.T) {
 	}
 }
 
+// TestPlaylistAddTypedItems tests that PlaylistAddTypedItems and URLs route episodes correctly
+func TestPlaylistAddTypedItems(t *testing.T) {
+	var uris []interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("failed to decode request body: %v", err)
+		}
+		uris, _ = body["uris"].([]interface{})
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"snapshot_id": "snapshot_id",
+		})
+	}))
+	defer server.Close()
+
+	auth := &tests.MockAuthManager{
+		Token: &spotigo.TokenInfo{
+			AccessToken: "test_token",
+			TokenType:   "Bearer",
+		},
+	}
+
+	client, err := spotigo.NewClient(auth)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	client.APIPrefix = server.URL + "/"
+
+	ctx := context.Background()
+	_, err = client.PlaylistAddTypedItems(ctx, "2oCEWyyAPbZp9xhVSxZavx", []spotigo.TypedItem{
+		{Kind: "track", ID: "6b2oQwSGFkzsMtQruIWm2p"},
+		{Kind: "episode", ID: "512ojhOuo1ktJprKbVcKyQ"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if fmt.Sprint(uris) != "[spotify:track:6b2oQwSGFkzsMtQruIWm2p spotify:episode:512ojhOuo1ktJprKbVcKyQ]" {
+		t.Errorf("unexpected uris: %v", uris)
+	}
+
+	_, err = client.PlaylistAddItems(ctx, "2oCEWyyAPbZp9xhVSxZavx", []string{
+		"https://open.spotify.com/episode/512ojhOuo1ktJprKbVcKyQ?si=abc",
+		"spotify:album:4aawyAB9vmqN3uQ7FjRGTy",
+	})
+	if err == nil || !strings.Contains(err.Error(), "must be a track or episode") {
+		t.Errorf("expected error for album item, got %v", err)
+	}
+	if fmt.Sprint(uris) != "[spotify:episode:512ojhOuo1ktJprKbVcKyQ]" {
+		t.Errorf("unexpected uris: %v", uris)
+	}
+
+	_, err = client.PlaylistAddTypedItems(ctx, "2oCEWyyAPbZp9xhVSxZavx", []spotigo.TypedItem{
+		{Kind: "track", ID: "spotify:episode:512ojhOuo1ktJprKbVcKyQ"},
+	})
+	if err == nil {
+		t.Error("expected error for URI that contradicts Kind")
+	}
+}
+
 // TestCurrentUserAddToQueueWithDeviceID tests CurrentUserAddToQueue with device ID
 func TestCurrentUserAddToQueueWithDeviceID(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -0,0 +1,129 @@
+package unit
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sv4u/spotigo"
+)
+
+func TestEnumValidation(t *testing.T) {
+	valid := []interface{ Validate() error }{
+		spotigo.RepeatOff, spotigo.TimeRangeLong, spotigo.SearchTypeAudiobook, spotigo.AlbumGroupAppearsOn,
+	}
+	for _, v := range valid {
+		if err := v.Validate(); err != nil {
+			t.Errorf("%v: unexpected error %v", v, err)
+		}
+	}
+
+	invalid := []interface{ Validate() error }{
+		spotigo.RepeatState("all"), spotigo.TimeRange("forever"), spotigo.SearchType("tracks"), spotigo.AlbumGroup("ep"),
+	}
+	for _, v := range invalid {
+		if err := v.Validate(); err == nil {
+			t.Errorf("%v: expected validation error", v)
+		}
+	}
+}
+
+func TestEnumJSON(t *testing.T) {
+	var out struct {
+		Repeat spotigo.RepeatState `json:"repeat"`
+		Range  spotigo.TimeRange   `json:"range"`
+		Device spotigo.DeviceType  `json:"device"`
+	}
+	if err := json.Unmarshal([]byte(`{"repeat": "context", "range": "short_term", "device": "smartphone"}`), &out); err != nil {
+		t.Fatalf("unmarshal failed: %v", err)
+	}
+	if out.Repeat != spotigo.RepeatContext || out.Range != spotigo.TimeRangeShort || out.Device != spotigo.DeviceTypeSmartphone {
+		t.Errorf("unexpected values: %+v", out)
+	}
+
+	if err := json.Unmarshal([]byte(`{"repeat": "sometimes"}`), &out); err == nil {
+		t.Error("expected error for unknown repeat state")
+	}
+
+	data, err := json.Marshal(map[string]spotigo.SearchType{"type": spotigo.SearchTypeShow})
+	if err != nil || string(data) != `{"type":"show"}` {
+		t.Errorf("marshal = %s, %v", data, err)
+	}
+	if _, err := json.Marshal(spotigo.AlbumGroup("bogus")); err == nil {
+		t.Error("expected error marshaling unknown album group")
+	}
+}
+
+func TestDeviceType(t *testing.T) {
+	device := spotigo.Device{Type: "COMPUTER"}
+	if device.DeviceType() != spotigo.DeviceTypeComputer {
+		t.Errorf("DeviceType = %q", device.DeviceType())
+	}
+
+	future := spotigo.ParseDeviceType("Hologram")
+	if future != "Hologram" || future.Known() {
+		t.Errorf("unknown type = %q, Known = %v", future, future.Known())
+	}
+
+	state := spotigo.PlaybackState{RepeatState: "track"}
+	if state.Repeat() != spotigo.RepeatTrack {
+		t.Errorf("Repeat = %q", state.Repeat())
+	}
+}
+
+func TestTypedOptionFields(t *testing.T) {
+	queries := map[string]string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries[r.URL.Path] = r.URL.RawQuery
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodPut {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+	ctx := context.Background()
+
+	if err := client.CurrentUserSetRepeatMode(ctx, &spotigo.SetRepeatModeOptions{Mode: spotigo.RepeatContext}); err != nil {
+		t.Fatalf("CurrentUserSetRepeatMode failed: %v", err)
+	}
+	if got := queries["/me/player/repeat"]; got != "state=context" {
+		t.Errorf("repeat query = %q", got)
+	}
+	if err := client.CurrentUserSetRepeatMode(ctx, &spotigo.SetRepeatModeOptions{State: "loop"}); err == nil {
+		t.Error("expected error for invalid deprecated State")
+	}
+
+	if _, err := client.CurrentUserTopTracks(ctx, &spotigo.TopItemsOptions{Range: spotigo.TimeRangeShort, TimeRange: "long_term"}); err != nil {
+		t.Fatalf("CurrentUserTopTracks failed: %v", err)
+	}
+	if got := queries["/me/top/tracks"]; got != "limit=20&time_range=short_term" {
+		t.Errorf("top tracks query = %q", got)
+	}
+
+	if _, err := client.ArtistAlbums(ctx, "0TnOYISbd1XYRBk9myaseg", &spotigo.ArtistAlbumsOptions{
+		Groups: []spotigo.AlbumGroup{spotigo.AlbumGroupAlbum, spotigo.AlbumGroupSingle},
+	}); err != nil {
+		t.Fatalf("ArtistAlbums failed: %v", err)
+	}
+	if got := queries["/artists/0TnOYISbd1XYRBk9myaseg/albums"]; got != "include_groups=album%2Csingle&limit=20" {
+		t.Errorf("artist albums query = %q", got)
+	}
+
+	if _, err := client.Search(ctx, "weezer", "", &spotigo.SearchOptions{
+		Types: []spotigo.SearchType{spotigo.SearchTypeArtist, spotigo.SearchTypeAlbum},
+	}); err != nil {
+		t.Fatalf("Search failed: %v", err)
+	}
+	if got := queries["/search"]; got != "limit=10&q=weezer&type=artist%2Calbum" {
+		t.Errorf("search query = %q", got)
+	}
+	if _, err := client.Search(ctx, "weezer", "tracks", nil); err == nil {
+		t.Error("expected error for invalid search type")
+	}
+}
@@ -0,0 +1,144 @@
+package unit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/sv4u/spotigo"
+)
+
+func TestEpisodeProgress(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/episodes" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		var episodes []interface{}
+		for _, id := range strings.Split(r.URL.Query().Get("ids"), ",") {
+			switch id {
+			case base62ID("e", 1):
+				episodes = append(episodes, map[string]interface{}{
+					"id": id, "name": "Halfway", "duration_ms": 600000,
+					"resume_point": map[string]interface{}{"resume_position_ms": 300000, "fully_played": false},
+				})
+			case base62ID("e", 2):
+				episodes = append(episodes, map[string]interface{}{
+					"id": id, "name": "Done", "duration_ms": 600000,
+					"resume_point": map[string]interface{}{"resume_position_ms": 0, "fully_played": true},
+				})
+			default:
+				episodes = append(episodes, nil)
+			}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{"episodes": episodes})
+	}))
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+	halfway := "spotify:episode:" + base62ID("e", 1)
+	progress, err := client.EpisodeProgress(context.Background(),
+		[]string{halfway, base62ID("e", 2), base62ID("e", 3), "not valid!"})
+
+	var multiErr *spotigo.MultiError
+	if !errors.As(err, &multiErr) || multiErr.Len() != 1 {
+		t.Fatalf("expected a MultiError for the invalid ID, got %v", err)
+	}
+	if len(progress) != 2 {
+		t.Fatalf("expected progress for 2 episodes, got %+v", progress)
+	}
+
+	p := progress[halfway]
+	if p.Name != "Halfway" || p.Position != 5*time.Minute || p.Duration != 10*time.Minute || p.FullyPlayed {
+		t.Errorf("unexpected progress: %+v", p)
+	}
+	if p.Fraction() != 0.5 || p.Remaining() != 5*time.Minute {
+		t.Errorf("unexpected fraction %v or remaining %v", p.Fraction(), p.Remaining())
+	}
+	if done := progress[base62ID("e", 2)]; !done.FullyPlayed || done.Fraction() != 1 || done.Remaining() != 0 {
+		t.Errorf("unexpected progress for a fully played episode: %+v", done)
+	}
+}
+
+func TestWatchEpisodeProgress(t *testing.T) {
+	episode := func(progressMs int, playing bool) string {
+		data, _ := json.Marshal(map[string]interface{}{
+			"is_playing":             playing,
+			"progress_ms":            progressMs,
+			"currently_playing_type": "episode",
+			"item":                   map[string]interface{}{"id": "ep1", "name": "Episode", "duration_ms": 600000},
+		})
+		return string(data)
+	}
+	server := httptest.NewServer(&playbackSequence{states: []string{
+		episode(1000, true),
+		episode(60000, true),
+		episode(60000, false),
+		episode(60000, false), // Unchanged: no event
+		episode(590000, true),
+		`{"is_playing": true, "progress_ms": 0, "currently_playing_type": "track", "item": {"id": "t1"}}`,
+	}})
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var got []spotigo.EpisodeProgressEvent
+	for event := range client.WatchEpisodeProgress(ctx, 10*time.Millisecond) {
+		if event.Err != nil {
+			t.Fatalf("unexpected error: %v", event.Err)
+		}
+		got = append(got, event)
+		if len(got) == 5 {
+			cancel()
+		}
+	}
+
+	if len(got) != 5 {
+		t.Fatalf("got %d events, want 5: %+v", len(got), got)
+	}
+	if got[0].Progress.Position != time.Second || !got[0].Playing {
+		t.Errorf("first event = %+v", got[0])
+	}
+	if got[1].Progress.Position != time.Minute || !got[1].Playing {
+		t.Errorf("progress event = %+v", got[1])
+	}
+	if got[2].Playing {
+		t.Errorf("pause event = %+v", got[2])
+	}
+	if got[3].Progress.Position != 590*time.Second {
+		t.Errorf("seek event = %+v", got[3])
+	}
+	if !got[4].Stopped || !got[4].Progress.FullyPlayed || got[4].Progress.EpisodeID != "ep1" {
+		t.Errorf("final event = %+v", got[4])
+	}
+}
+
+func TestWatchEpisodeProgressDefaultInterval(t *testing.T) {
+	var polls atomic.Int32
+	server := httptest.NewServer(countRequests(&playbackSequence{states: []string{
+		`{"is_playing": true, "progress_ms": 1000, "currently_playing_type": "episode", "item": {"id": "ep1", "name": "Episode", "duration_ms": 600000}}`,
+	}}, &polls))
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	// A zero interval falls back to the default instead of panicking
+	event := <-client.WatchEpisodeProgress(ctx, 0)
+	if event.Err != nil {
+		t.Fatalf("unexpected error: %v", event.Err)
+	}
+	if event.Progress.Position != time.Second || !event.Playing {
+		t.Errorf("first event = %+v", event)
+	}
+	expectNoMorePolls(t, &polls, 1)
+}
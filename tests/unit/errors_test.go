@@ -1,12 +1,18 @@
 package unit
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"strings"
 	"testing"
 	"time"
+	"unicode/utf8"
 
 	"github.com/sv4u/spotigo"
+	"github.com/sv4u/spotigo/tests"
 )
 
 func TestSpotifyError(t *testing.T) {
@@ -578,3 +584,264 @@ func TestWrapRetryError(t *testing.T) {
 		})
 	}
 }
+
+func TestMultiError(t *testing.T) {
+	sentinel := errors.New("sentinel failure")
+
+	multiErr := &spotigo.MultiError{}
+	if multiErr.ErrorOrNil() != nil {
+		t.Error("expected nil from empty MultiError")
+	}
+
+	multiErr.Add(0, "abc", fmt.Errorf("invalid base62 ID: abc"))
+	multiErr.Add(1, "def", nil) // nil errors are ignored
+	multiErr.Add(3, "ghi", sentinel)
+
+	if multiErr.Len() != 2 {
+		t.Fatalf("expected 2 errors, got %d", multiErr.Len())
+	}
+
+	err := multiErr.ErrorOrNil()
+	if err == nil {
+		t.Fatal("expected error from non-empty MultiError")
+	}
+
+	msg := err.Error()
+	if !strings.Contains(msg, "2 items failed") {
+		t.Errorf("expected summary in message, got %q", msg)
+	}
+	if !strings.Contains(msg, "[0] abc") || !strings.Contains(msg, "[3] ghi") {
+		t.Errorf("expected per-item context in message, got %q", msg)
+	}
+
+	wrapped := fmt.Errorf("batch failed: %w", err)
+	if !errors.Is(wrapped, sentinel) {
+		t.Error("expected errors.Is to find sentinel through MultiError")
+	}
+
+	var itemErr *spotigo.ItemError
+	if !errors.As(wrapped, &itemErr) {
+		t.Fatal("expected errors.As to find ItemError")
+	}
+	if itemErr.Index != 0 || itemErr.ID != "abc" {
+		t.Errorf("expected first item error, got index %d id %q", itemErr.Index, itemErr.ID)
+	}
+}
+
+func TestPlaylistAddItemsMultiError(t *testing.T) {
+	client, err := spotigo.NewClient(&tests.MockAuthManager{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	_, err = client.PlaylistAddItems(context.Background(), "2oCEWyyAPbZp9xhVSxZavx", []string{"not-valid!", "also bad"})
+	if err == nil {
+		t.Fatal("expected error for invalid items")
+	}
+
+	var multiErr *spotigo.MultiError
+	if !errors.As(err, &multiErr) {
+		t.Fatalf("expected MultiError, got %T", err)
+	}
+	if multiErr.Len() != 2 {
+		t.Errorf("expected 2 item errors, got %d", multiErr.Len())
+	}
+	if multiErr.Errors[1].Index != 1 || multiErr.Errors[1].ID != "also bad" {
+		t.Errorf("unexpected item error: %+v", multiErr.Errors[1])
+	}
+}
+
+func TestValidationErrors(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		tests.WriteJSONResponse(w, http.StatusOK, map[string]interface{}{})
+	}))
+	defer server.Close()
+	client := newPlayerTestClient(t, server)
+	ctx := context.Background()
+
+	ids := make([]string, 51)
+	for i := range ids {
+		ids[i] = "4iV5W9uYEdYUVa79Axb7Rh"
+	}
+	_, err := client.Tracks(ctx, ids)
+	var tooMany *spotigo.TooManyIDsError
+	if !errors.As(err, &tooMany) {
+		t.Fatalf("expected TooManyIDsError, got %T: %v", err, err)
+	}
+	if tooMany.Kind != "tracks" || tooMany.Max != 50 || tooMany.Got != 51 {
+		t.Errorf("unexpected error fields: %+v", tooMany)
+	}
+	if !errors.Is(err, spotigo.ErrValidation) {
+		t.Error("expected TooManyIDsError to match ErrValidation")
+	}
+	if !strings.Contains(err.Error(), "maximum 50 tracks per request, got 51") {
+		t.Errorf("unexpected message: %q", err.Error())
+	}
+
+	_, err = client.Album(ctx, "4aawyAB9vmqN3uQ7FjRGTy", "USA")
+	var invalidMarket *spotigo.InvalidMarketError
+	if !errors.As(err, &invalidMarket) || invalidMarket.Code != "USA" {
+		t.Fatalf("expected InvalidMarketError for USA, got %T: %v", err, err)
+	}
+	if !errors.Is(err, spotigo.ErrValidation) {
+		t.Error("expected InvalidMarketError to match ErrValidation")
+	}
+
+	_, err = client.UserPlaylistCreate(ctx, "user", &spotigo.CreatePlaylistOptions{})
+	var missing *spotigo.MissingOptionError
+	if !errors.As(err, &missing) || missing.Field != "opts.Name" {
+		t.Fatalf("expected MissingOptionError for opts.Name, got %T: %v", err, err)
+	}
+	if !errors.Is(err, spotigo.ErrValidation) {
+		t.Error("expected MissingOptionError to match ErrValidation")
+	}
+
+	if requests != 0 {
+		t.Errorf("expected no requests to be sent, got %d", requests)
+	}
+}
+
+func TestValidationErrorsMatchErrValidation(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		tests.WriteJSONResponse(w, http.StatusOK, map[string]interface{}{})
+	}))
+	defer server.Close()
+	client := newPlayerTestClient(t, server)
+	ctx := context.Background()
+
+	playlistID := "37i9dQZF1DXcBWIGoYBM5M"
+	artistURI := "spotify:artist:0OdUWJ0sBjDrqHygGUXeCF"
+	checks := []struct {
+		name string
+		call func() error
+	}{
+		{"PlaylistAddItems position", func() error {
+			_, err := client.PlaylistAddItems(ctx, playlistID, []string{"spotify:track:4iV5W9uYEdYUVa79Axb7Rh"}, -1)
+			return err
+		}},
+		{"PlaylistUploadCoverImageStatus size", func() error {
+			_, err := client.PlaylistUploadCoverImageStatus(ctx, playlistID, make([]byte, 256*1024+1))
+			return err
+		}},
+		{"PlaylistUploadCoverImageStatus format", func() error {
+			_, err := client.PlaylistUploadCoverImageStatus(ctx, playlistID, []byte("not a jpeg"))
+			return err
+		}},
+		{"Recommendations seeds", func() error {
+			_, err := client.Recommendations(ctx, &spotigo.RecommendationsOptions{})
+			return err
+		}},
+		{"CurrentUserSetVolume", func() error {
+			return client.CurrentUserSetVolume(ctx, &spotigo.SetVolumeOptions{VolumePercent: 101})
+		}},
+		{"PlayContext offset", func() error {
+			return client.PlayContext(ctx, "spotify:album:1DFixLWuPkv3KT3TnV35m3", -1)
+		}},
+		{"PlayContext artist offset", func() error {
+			return client.PlayContext(ctx, artistURI, 1)
+		}},
+		{"PlayContextFromURI artist", func() error {
+			return client.PlayContextFromURI(ctx, artistURI, "spotify:track:4iV5W9uYEdYUVa79Axb7Rh")
+		}},
+		{"CurrentUserAddContextToQueue limit", func() error {
+			_, err := client.CurrentUserAddContextToQueue(ctx, "spotify:playlist:"+playlistID, &spotigo.AddContextToQueueOptions{Limit: -1})
+			return err
+		}},
+		{"CurrentUserStartPlayback", func() error {
+			return client.CurrentUserStartPlayback(ctx, &spotigo.StartPlaybackOptions{
+				ContextURI: "spotify:album:1DFixLWuPkv3KT3TnV35m3",
+				URIs:       []string{"spotify:track:4iV5W9uYEdYUVa79Axb7Rh"},
+			})
+		}},
+		{"NormalizeISRC", func() error {
+			_, err := spotigo.NormalizeISRC("bad")
+			return err
+		}},
+		{"NormalizeUPC", func() error {
+			_, err := spotigo.NormalizeUPC("12")
+			return err
+		}},
+	}
+	for _, check := range checks {
+		if err := check.call(); !errors.Is(err, spotigo.ErrValidation) {
+			t.Errorf("%s: expected ErrValidation, got %T: %v", check.name, err, err)
+		}
+	}
+
+	if requests != 0 {
+		t.Errorf("expected no requests to be sent, got %d", requests)
+	}
+}
+
+func TestWrapHTTPErrorGateway(t *testing.T) {
+	page := "<html><head><title>502 Bad Gateway</title></head><body>" + strings.Repeat("é", 400) + "</body></html>"
+	headers := map[string][]string{"Content-Type": {"text/html; charset=utf-8"}}
+
+	err := spotigo.WrapHTTPError(nil, 502, "GET", "https://api.spotify.com/v1/tracks/123", []byte(page), headers)
+	spotifyErr, ok := err.(*spotigo.SpotifyError)
+	if !ok {
+		t.Fatalf("expected SpotifyError, got %T", err)
+	}
+	if !spotifyErr.IsGatewayError() || !errors.Is(err, spotigo.ErrGateway) {
+		t.Error("expected an HTML 502 to be a gateway error")
+	}
+	if spotifyErr.Message != "502 Bad Gateway" {
+		t.Errorf("expected the page title as message, got %q", spotifyErr.Message)
+	}
+	if spotifyErr.ContentType != "text/html; charset=utf-8" {
+		t.Errorf("unexpected content type %q", spotifyErr.ContentType)
+	}
+	if len(spotifyErr.Body) > 512 || !strings.HasPrefix(spotifyErr.Body, "<html>") || !utf8.ValidString(spotifyErr.Body) {
+		t.Errorf("expected a valid snippet of at most 512 bytes, got %d bytes", len(spotifyErr.Body))
+	}
+
+	// Spotify's own 5xx errors carry a JSON body and are application errors
+	errorJSON := `{"error": {"status": 502, "message": "Bad gateway."}}`
+	err = spotigo.WrapHTTPError(nil, 502, "GET", "https://api.spotify.com/v1/tracks/123", []byte(errorJSON), nil)
+	if errors.Is(err, spotigo.ErrGateway) || err.(*spotigo.SpotifyError).Body != "" {
+		t.Errorf("expected a JSON 502 to be an application error, got %+v", err)
+	}
+
+	// Other statuses are never gateway errors
+	err = spotigo.WrapHTTPError(nil, 500, "GET", "https://api.spotify.com/v1/tracks/123", []byte(page), headers)
+	if errors.Is(err, spotigo.ErrGateway) {
+		t.Error("expected a 500 not to be a gateway error")
+	}
+}
+
+func TestGatewayErrorsAreRetried(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			w.Header().Set("Content-Type", "text/html")
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			w.Write([]byte("<html><body>upstream connect error</body></html>"))
+			return
+		}
+		w.Header().Set("Content-Type", "text/html")
+		w.WriteHeader(http.StatusGatewayTimeout)
+		w.Write([]byte("<html><title>504 Gateway Time-out</title></html>"))
+	}))
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+	client.RetryConfig = spotigo.DefaultRetryConfig()
+	client.RetryConfig.BackoffFactor = 0.001
+	client.RetryConfig.StatusRetries = 1
+	client.RetryConfig.MaxRetries = 1
+
+	_, err := client.Track(context.Background(), "4iV5W9uYEdYUVa79Axb7Rh", "")
+	if attempts != 2 {
+		t.Errorf("expected the gateway error to be retried once, got %d attempts", attempts)
+	}
+	var spotifyErr *spotigo.SpotifyError
+	if !errors.Is(err, spotigo.ErrGateway) || !errors.As(err, &spotifyErr) || spotifyErr.Message != "504 Gateway Time-out" {
+		t.Errorf("expected the last gateway error, got %v", err)
+	}
+}
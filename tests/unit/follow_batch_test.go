@@ -0,0 +1,116 @@
+package unit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/sv4u/spotigo"
+	"github.com/sv4u/spotigo/tests"
+)
+
+// TestFollowArtistsAll tests chunking and partial failure reporting
+func TestFollowArtistsAll(t *testing.T) {
+	var mu sync.Mutex
+	var batches [][]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/me/following" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		var body struct {
+			IDs []string `json:"ids"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+
+		mu.Lock()
+		batches = append(batches, body.IDs)
+		second := len(batches) == 2
+		mu.Unlock()
+
+		if second {
+			tests.WriteJSONResponse(w, http.StatusBadRequest, tests.CreateErrorResponse(http.StatusBadRequest, "Invalid ID", ""))
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	ids := []string{"not valid!"}
+	for i := range 120 {
+		ids = append(ids, base62ID("artist", i))
+	}
+	ids = append(ids, "spotify:artist:"+base62ID("artist", 0)) // Duplicate
+
+	client := newPlayerTestClient(t, server)
+	report, err := client.FollowArtistsAll(context.Background(), ids)
+
+	if len(batches) != 3 || len(batches[0]) != 50 || len(batches[1]) != 50 || len(batches[2]) != 20 {
+		t.Fatalf("unexpected batches: %d", len(batches))
+	}
+	if report.Succeeded != 70 || len(report.Chunks) != 3 {
+		t.Errorf("expected 70 followed in 3 chunks, got %d in %d", report.Succeeded, len(report.Chunks))
+	}
+	failedChunks := report.Failed()
+	if len(failedChunks) != 1 || failedChunks[0].Start != 51 || len(failedChunks[0].IDs) != 50 {
+		t.Errorf("unexpected failed chunks: %+v", failedChunks)
+	}
+
+	var multiErr *spotigo.MultiError
+	if !errors.As(err, &multiErr) || multiErr.Len() != 51 {
+		t.Fatalf("expected 51 failed items, got %v", err)
+	}
+	if multiErr.Errors[0].Index != 0 || multiErr.Errors[1].Index != 51 || multiErr.Errors[1].ID != base62ID("artist", 50) {
+		t.Errorf("unexpected item errors: %v, %v", multiErr.Errors[0], multiErr.Errors[1])
+	}
+}
+
+// TestUnfollowUsersAll tests unfollowing users in chunks
+func TestUnfollowUsersAll(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/me/following" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		requests++
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	userIDs := make([]string, 60)
+	for i := range userIDs {
+		userIDs[i] = base62ID("user.name", i)
+	}
+
+	client := newPlayerTestClient(t, server)
+	report, err := client.UnfollowUsersAll(context.Background(), userIDs)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if requests != 2 || report.Succeeded != 60 || len(report.Failed()) != 0 {
+		t.Errorf("expected 60 unfollowed in 2 requests, got %d in %d", report.Succeeded, requests)
+	}
+}
+
+// TestFollowArtistsAllCanceled tests that canceled contexts fail every chunk
+func TestFollowArtistsAllCanceled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request: %s", r.URL.Path)
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	client := newPlayerTestClient(t, server)
+	report, err := client.FollowArtistsAll(ctx, []string{base62ID("artist", 1), base62ID("artist", 2)})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+	if report.Succeeded != 0 || len(report.Failed()) != 1 {
+		t.Errorf("unexpected report: %+v", report)
+	}
+}
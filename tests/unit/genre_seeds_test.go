@@ -0,0 +1,111 @@
+package unit
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/sv4u/spotigo"
+	"github.com/sv4u/spotigo/tests"
+)
+
+// TestIsValidGenreSeed tests validation against the built-in genre seeds
+func TestIsValidGenreSeed(t *testing.T) {
+	for _, genre := range []string{"rock", "Hip Hop", " drum and bass ", "R-N-B"} {
+		if !spotigo.IsValidGenreSeed(genre) {
+			t.Errorf("expected %q to be valid", genre)
+		}
+	}
+	for _, genre := range []string{"", "vaporwave", "hip hop hooray"} {
+		if spotigo.IsValidGenreSeed(genre) {
+			t.Errorf("expected %q to be invalid", genre)
+		}
+	}
+}
+
+// TestGenreSeedsCached tests that GenreSeeds caches and sorts results
+func TestGenreSeedsCached(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		tests.WriteJSONResponse(w, http.StatusOK, map[string]interface{}{
+			"genres": []string{"rock", "acoustic", "jazz"},
+		})
+	}))
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+	for range 2 {
+		genres, err := client.GenreSeeds(context.Background())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !slices.Equal(genres, []string{"acoustic", "jazz", "rock"}) {
+			t.Errorf("unexpected genres: %v", genres)
+		}
+	}
+	if requests.Load() != 1 {
+		t.Errorf("expected 1 request, got %d", requests.Load())
+	}
+
+	spotigo.WithGenreSeedsCacheTTL(-1)(client)
+	if _, err := client.GenreSeeds(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if requests.Load() != 2 {
+		t.Errorf("expected a disabled cache to refetch, got %d requests", requests.Load())
+	}
+}
+
+// TestGenreSeedsFallback tests that failures fall back to the built-in list
+func TestGenreSeedsFallback(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	server.Close()
+
+	auth := &tests.MockAuthManager{Token: &spotigo.TokenInfo{AccessToken: "test_token", TokenType: "Bearer"}}
+	client, err := spotigo.NewClient(auth, spotigo.WithRetryConfig(&spotigo.RetryConfig{}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	client.APIPrefix = server.URL + "/"
+
+	genres, err := client.GenreSeeds(context.Background())
+	if err != nil {
+		t.Fatalf("expected the offline call to fall back, got %v", err)
+	}
+	if !slices.Contains(genres, "hip-hop") || !slices.IsSorted(genres) {
+		t.Errorf("expected the sorted built-in genres, got %d genres", len(genres))
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := client.GenreSeeds(ctx); err == nil {
+		t.Error("expected a canceled context to fail")
+	}
+}
+
+// TestGenreSeedsSunset tests that a disabled endpoint uses the built-in list
+// without a request
+func TestGenreSeedsSunset(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request: %s", r.URL.Path)
+	}))
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+	spotigo.WithDisabledEndpoints(spotigo.EndpointGenreSeeds)(client)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	var seeds spotigo.SeedSet
+	if err := seeds.AddGenres("Synth Pop"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := client.ValidateSeeds(ctx, &seeds); err != nil {
+		t.Fatalf("expected built-in genres to validate, got %v", err)
+	}
+}
@@ -0,0 +1,72 @@
+package unit
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sv4u/spotigo"
+)
+
+func TestWithUserAgentAndDefaultHeaders(t *testing.T) {
+	var got http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Clone()
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id": "4iV5W9uYEdYUVa79Axb7Rh", "name": "Test Track"}`))
+	}))
+	defer server.Close()
+
+	client, err := spotigo.NewClient(newProxyTestAuth(),
+		spotigo.WithAPIPrefix(server.URL+"/"),
+		spotigo.WithUserAgent("my-app/1.2.0"),
+		spotigo.WithDefaultHeaders(http.Header{"spotify-app-version": {"8.9.0"}}),
+		spotigo.WithDefaultHeaders(http.Header{
+			"X-Trace":       {"a", "b"},
+			"Authorization": {"Basic overridden"},
+		}),
+		spotigo.WithLanguage("de"),
+	)
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+
+	if _, err := client.Track(context.Background(), "4iV5W9uYEdYUVa79Axb7Rh"); err != nil {
+		t.Fatalf("Track failed: %v", err)
+	}
+
+	if ua := got.Get("User-Agent"); ua != "my-app/1.2.0" {
+		t.Errorf("User-Agent = %q, want my-app/1.2.0", ua)
+	}
+	if v := got.Get("Spotify-App-Version"); v != "8.9.0" {
+		t.Errorf("Spotify-App-Version = %q, want 8.9.0", v)
+	}
+	if v := got.Values("X-Trace"); len(v) != 2 {
+		t.Errorf("X-Trace = %v, want two values", v)
+	}
+	if v := got.Get("Authorization"); v != "Bearer test_token" {
+		t.Errorf("Authorization = %q, default headers must not override it", v)
+	}
+	if v := got.Get("Accept-Language"); v != "de" {
+		t.Errorf("Accept-Language = %q, want de", v)
+	}
+}
+
+func TestDefaultUserAgentUnchanged(t *testing.T) {
+	var ua string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ua = r.Header.Get("User-Agent")
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id": "4iV5W9uYEdYUVa79Axb7Rh"}`))
+	}))
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+	if _, err := client.Track(context.Background(), "4iV5W9uYEdYUVa79Axb7Rh"); err != nil {
+		t.Fatalf("Track failed: %v", err)
+	}
+	if ua == "" {
+		t.Error("expected Go's default User-Agent")
+	}
+}
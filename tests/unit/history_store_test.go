@@ -0,0 +1,288 @@
+package unit
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sv4u/spotigo"
+)
+
+// fakeSQLite is a database/sql driver that understands the statements
+// SQLiteHistoryStore sends, keeping rows in memory per DSN
+type fakeSQLite struct {
+	mu  sync.Mutex
+	dbs map[string]*fakeSQLiteDB
+}
+
+// fakeSQLiteDB holds the tables of one DSN, rows keyed by played_at
+type fakeSQLiteDB struct {
+	mu     sync.Mutex
+	tables map[string]map[string][]driver.Value
+}
+
+var fakeSQLiteDriver = &fakeSQLite{dbs: make(map[string]*fakeSQLiteDB)}
+
+func init() {
+	sql.Register("fakesqlite", fakeSQLiteDriver)
+}
+
+// openFakeSQLite opens a fresh in-memory database for the test
+func openFakeSQLite(t *testing.T) (*sql.DB, *fakeSQLiteDB) {
+	t.Helper()
+
+	fakeSQLiteDriver.mu.Lock()
+	db := &fakeSQLiteDB{tables: make(map[string]map[string][]driver.Value)}
+	fakeSQLiteDriver.dbs[t.Name()] = db
+	fakeSQLiteDriver.mu.Unlock()
+
+	sqlDB, err := sql.Open("fakesqlite", t.Name())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	t.Cleanup(func() { sqlDB.Close() })
+	return sqlDB, db
+}
+
+func (d *fakeSQLite) Open(name string) (driver.Conn, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	db, ok := d.dbs[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown database %q", name)
+	}
+	return &fakeSQLiteConn{db: db}, nil
+}
+
+// rows returns a copy of a table's rows, or nil if the table doesn't exist
+func (db *fakeSQLiteDB) rows(table string) map[string][]driver.Value {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	if db.tables[table] == nil {
+		return nil
+	}
+	rows := make(map[string][]driver.Value, len(db.tables[table]))
+	for key, row := range db.tables[table] {
+		rows[key] = row
+	}
+	return rows
+}
+
+// fakeSQLiteConn buffers inserts made in a transaction until Commit
+type fakeSQLiteConn struct {
+	db      *fakeSQLiteDB
+	pending map[string]map[string][]driver.Value // nil outside a transaction
+}
+
+func (c *fakeSQLiteConn) Prepare(query string) (driver.Stmt, error) {
+	return &fakeSQLiteStmt{conn: c, query: query}, nil
+}
+
+func (c *fakeSQLiteConn) Close() error { return nil }
+
+func (c *fakeSQLiteConn) Begin() (driver.Tx, error) {
+	c.pending = make(map[string]map[string][]driver.Value)
+	return c, nil
+}
+
+func (c *fakeSQLiteConn) Commit() error {
+	c.db.mu.Lock()
+	defer c.db.mu.Unlock()
+	for table, rows := range c.pending {
+		for key, row := range rows {
+			if _, ok := c.db.tables[table][key]; !ok {
+				c.db.tables[table][key] = row
+			}
+		}
+	}
+	c.pending = nil
+	return nil
+}
+
+func (c *fakeSQLiteConn) Rollback() error {
+	c.pending = nil
+	return nil
+}
+
+type fakeSQLiteStmt struct {
+	conn  *fakeSQLiteConn
+	query string
+}
+
+func (s *fakeSQLiteStmt) Close() error  { return nil }
+func (s *fakeSQLiteStmt) NumInput() int { return -1 }
+
+func (s *fakeSQLiteStmt) Exec(args []driver.Value) (driver.Result, error) {
+	db := s.conn.db
+	fields := strings.Fields(s.query)
+	switch {
+	case strings.HasPrefix(s.query, "CREATE TABLE IF NOT EXISTS "):
+		db.mu.Lock()
+		defer db.mu.Unlock()
+		if db.tables[fields[5]] == nil {
+			db.tables[fields[5]] = make(map[string][]driver.Value)
+		}
+		return driver.RowsAffected(0), nil
+	case strings.HasPrefix(s.query, "INSERT OR IGNORE INTO "):
+		table := fields[4]
+		if db.rows(table) == nil {
+			return nil, fmt.Errorf("no such table: %s", table)
+		}
+		if len(args) != 6 {
+			return nil, fmt.Errorf("expected 6 arguments, got %d", len(args))
+		}
+		if s.conn.pending == nil {
+			return nil, fmt.Errorf("insert outside a transaction")
+		}
+		if s.conn.pending[table] == nil {
+			s.conn.pending[table] = make(map[string][]driver.Value)
+		}
+		key := args[0].(string)
+		if _, ok := s.conn.pending[table][key]; !ok {
+			s.conn.pending[table][key] = args
+		}
+		return driver.RowsAffected(1), nil
+	}
+	return nil, fmt.Errorf("unsupported statement: %s", s.query)
+}
+
+func (s *fakeSQLiteStmt) Query(args []driver.Value) (driver.Rows, error) {
+	fields := strings.Fields(s.query)
+	if !strings.HasPrefix(s.query, "SELECT MAX(played_at_ms) FROM ") {
+		return nil, fmt.Errorf("unsupported query: %s", s.query)
+	}
+	rows := s.conn.db.rows(fields[3])
+	if rows == nil {
+		return nil, fmt.Errorf("no such table: %s", fields[3])
+	}
+	var last driver.Value // NULL for an empty table
+	for _, row := range rows {
+		if last == nil || row[1].(int64) > last.(int64) {
+			last = row[1]
+		}
+	}
+	return &fakeSQLiteRows{values: []driver.Value{last}}, nil
+}
+
+// fakeSQLiteRows is a single-row, single-column result
+type fakeSQLiteRows struct {
+	values []driver.Value
+	read   bool
+}
+
+func (r *fakeSQLiteRows) Columns() []string { return []string{"max"} }
+func (r *fakeSQLiteRows) Close() error      { return nil }
+
+func (r *fakeSQLiteRows) Next(dest []driver.Value) error {
+	if r.read {
+		return io.EOF
+	}
+	r.read = true
+	copy(dest, r.values)
+	return nil
+}
+
+func TestSQLiteHistoryStore(t *testing.T) {
+	sqlDB, db := openFakeSQLite(t)
+	ctx := context.Background()
+
+	store, err := spotigo.NewSQLiteHistoryStore(ctx, sqlDB, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if db.rows(spotigo.DefaultHistoryTable) == nil {
+		t.Fatalf("expected table %s to be created", spotigo.DefaultHistoryTable)
+	}
+
+	// Creating the store again keeps the existing table
+	if _, err := spotigo.NewSQLiteHistoryStore(ctx, sqlDB, ""); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	last, err := store.LastPlayedAt(ctx)
+	if err != nil || !last.IsZero() {
+		t.Fatalf("expected zero time for an empty table, got %v, %v", last, err)
+	}
+
+	first := []spotigo.PlayHistoryItem{
+		{Track: spotigo.Track{ID: "track1", Name: "Track 1"}, PlayedAt: "2024-01-01T10:00:00Z"},
+		{Track: spotigo.Track{ID: "track2", Name: "Track 2"}, PlayedAt: "2024-01-01T10:05:00.123Z", Context: &spotigo.Context{URI: "spotify:playlist:37i9dQZF1DXcBWIGoYBM5M"}},
+	}
+	if err := store.Append(ctx, first); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// The second play is already stored and is ignored
+	if err := store.Append(ctx, []spotigo.PlayHistoryItem{
+		first[1],
+		{Track: spotigo.Track{ID: "track3", Name: "Track 3"}, PlayedAt: "2024-01-01T09:55:00Z"},
+	}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	rows := db.rows(spotigo.DefaultHistoryTable)
+	if len(rows) != 3 {
+		t.Fatalf("expected 3 stored plays, got %d", len(rows))
+	}
+	row := rows["2024-01-01T10:05:00.123Z"]
+	if row == nil {
+		t.Fatalf("expected the play to be keyed by played_at, got %v", rows)
+	}
+	if row[1].(int64) != time.Date(2024, 1, 1, 10, 5, 0, 123e6, time.UTC).UnixMilli() || row[2] != "track2" || row[3] != "Track 2" {
+		t.Errorf("unexpected row %v", row)
+	}
+	if row[4] != "spotify:playlist:37i9dQZF1DXcBWIGoYBM5M" || rows["2024-01-01T10:00:00Z"][4] != nil {
+		t.Errorf("expected context_uri to be set only for plays with a context, got %v and %v", row[4], rows["2024-01-01T10:00:00Z"][4])
+	}
+	var item spotigo.PlayHistoryItem
+	if err := json.Unmarshal([]byte(row[5].(string)), &item); err != nil || item.Track.ID != "track2" {
+		t.Errorf("expected the item as JSON, got %v (%v)", row[5], err)
+	}
+
+	last, err = store.LastPlayedAt(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := time.Date(2024, 1, 1, 10, 5, 0, 123e6, time.UTC); !last.Equal(want) {
+		t.Errorf("LastPlayedAt = %v, want %v", last, want)
+	}
+}
+
+func TestSQLiteHistoryStoreAppendRollsBack(t *testing.T) {
+	sqlDB, db := openFakeSQLite(t)
+	ctx := context.Background()
+
+	store, err := spotigo.NewSQLiteHistoryStore(ctx, sqlDB, "plays")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	err = store.Append(ctx, []spotigo.PlayHistoryItem{
+		{Track: spotigo.Track{ID: "track1"}, PlayedAt: "2024-01-01T10:00:00Z"},
+		{Track: spotigo.Track{ID: "track2"}, PlayedAt: "yesterday"},
+	})
+	if err == nil {
+		t.Fatal("expected error for an invalid played_at")
+	}
+	if rows := db.rows("plays"); len(rows) != 0 {
+		t.Errorf("expected the transaction to be rolled back, got %v", rows)
+	}
+}
+
+func TestNewSQLiteHistoryStoreValidation(t *testing.T) {
+	sqlDB, _ := openFakeSQLite(t)
+	ctx := context.Background()
+
+	if _, err := spotigo.NewSQLiteHistoryStore(ctx, nil, ""); err == nil {
+		t.Error("expected error for a nil db")
+	}
+	if _, err := spotigo.NewSQLiteHistoryStore(ctx, sqlDB, "plays; DROP TABLE users"); err == nil {
+		t.Error("expected error for an invalid table name")
+	}
+}
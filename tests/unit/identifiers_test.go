@@ -0,0 +1,148 @@
+package unit
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/sv4u/spotigo"
+)
+
+func TestNormalizeISRC(t *testing.T) {
+	got, err := spotigo.NormalizeISRC(" us-um7-19-00001 ")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != "USUM71900001" {
+		t.Errorf("NormalizeISRC = %q, want USUM71900001", got)
+	}
+
+	for _, invalid := range []string{"", "USUM7190000", "1SUM71900001", "USUM7190000X"} {
+		if _, err := spotigo.NormalizeISRC(invalid); err == nil {
+			t.Errorf("NormalizeISRC(%q) expected error", invalid)
+		}
+	}
+}
+
+func TestNormalizeUPC(t *testing.T) {
+	got, err := spotigo.NormalizeUPC("0 602577 435235")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != "0602577435235" {
+		t.Errorf("NormalizeUPC = %q, want 0602577435235", got)
+	}
+
+	for _, invalid := range []string{"", "12345", "60257743523X", "00602577435235"} {
+		if _, err := spotigo.NormalizeUPC(invalid); err == nil {
+			t.Errorf("NormalizeUPC(%q) expected error", invalid)
+		}
+	}
+}
+
+func TestTrackByISRC(t *testing.T) {
+	var query string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query().Get("q")
+		if got := r.URL.Query().Get("market"); got != "US" {
+			t.Errorf("market = %q, want US", got)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"tracks": {"items": [
+			{"id": "single", "popularity": 40, "external_ids": {"isrc": "USUM71900001"}},
+			{"id": "other", "popularity": 90, "external_ids": {"isrc": "GBAYE0000001"}},
+			{"id": "album", "popularity": 70, "external_ids": {"isrc": "usum71900001"}}
+		]}}`))
+	}))
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+
+	track, err := client.TrackByISRC(context.Background(), "US-UM7-19-00001", "US")
+	if err != nil {
+		t.Fatalf("TrackByISRC failed: %v", err)
+	}
+	if query != "isrc:USUM71900001" {
+		t.Errorf("query = %q, want isrc:USUM71900001", query)
+	}
+	if track.ID != "album" {
+		t.Errorf("best match = %q, want most popular matching track", track.ID)
+	}
+
+	tracks, err := client.TracksByISRC(context.Background(), "USUM71900001", "US")
+	if err != nil {
+		t.Fatalf("TracksByISRC failed: %v", err)
+	}
+	if len(tracks) != 2 || tracks[0].ID != "album" || tracks[1].ID != "single" {
+		t.Errorf("TracksByISRC returned %+v", tracks)
+	}
+}
+
+func TestTrackByISRCNotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"tracks": {"items": []}}`))
+	}))
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+
+	_, err := client.TrackByISRC(context.Background(), "USUM71900001")
+	if !errors.Is(err, spotigo.ErrIdentifierNotFound) {
+		t.Fatalf("expected ErrIdentifierNotFound, got %v", err)
+	}
+	var notFound *spotigo.IdentifierNotFoundError
+	if !errors.As(err, &notFound) || notFound.Kind != "isrc" || notFound.Value != "USUM71900001" {
+		t.Errorf("unexpected error details: %+v", notFound)
+	}
+
+	if _, err := client.TrackByISRC(context.Background(), "not-an-isrc"); err == nil || errors.Is(err, spotigo.ErrIdentifierNotFound) {
+		t.Errorf("expected validation error, got %v", err)
+	}
+}
+
+func TestAlbumByUPC(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/search"):
+			if q := r.URL.Query().Get("q"); q != "upc:602577435235" {
+				t.Errorf("query = %q, want upc:602577435235", q)
+			}
+			w.Write([]byte(`{"albums": {"items": [{"id": "a1"}, {"id": "a2"}, {"id": "a3"}]}}`))
+		case strings.HasSuffix(r.URL.Path, "/albums"):
+			if ids := r.URL.Query().Get("ids"); ids != "a1,a2,a3" {
+				t.Errorf("ids = %q, want a1,a2,a3", ids)
+			}
+			w.Write([]byte(`{"albums": [
+				{"id": "a1", "popularity": 10, "external_ids": {"upc": "0602577435235"}},
+				{"id": "a2", "popularity": 80, "external_ids": {"upc": "111111111111"}},
+				{"id": "a3", "popularity": 50, "external_ids": {"ean": "0602577435235"}}
+			]}`))
+		default:
+			t.Errorf("unexpected request: %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+
+	album, err := client.AlbumByUPC(context.Background(), "602577435235")
+	if err != nil {
+		t.Fatalf("AlbumByUPC failed: %v", err)
+	}
+	if album.ID != "a3" {
+		t.Errorf("best match = %q, want a3", album.ID)
+	}
+
+	albums, err := client.AlbumsByUPC(context.Background(), "602577435235")
+	if err != nil {
+		t.Fatalf("AlbumsByUPC failed: %v", err)
+	}
+	if len(albums) != 2 {
+		t.Errorf("AlbumsByUPC returned %d albums, want 2", len(albums))
+	}
+}
@@ -0,0 +1,189 @@
+package unit
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sv4u/spotigo"
+)
+
+// noiseImage returns an image of random pixels, which compresses poorly
+func noiseImage(width, height int) *image.RGBA {
+	rng := rand.New(rand.NewPCG(1, 2))
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			img.Set(x, y, color.RGBA{R: uint8(rng.IntN(256)), G: uint8(rng.IntN(256)), B: uint8(rng.IntN(256)), A: 255})
+		}
+	}
+	return img
+}
+
+func TestImageDownload(t *testing.T) {
+	var encoded bytes.Buffer
+	if err := png.Encode(&encoded, noiseImage(4, 3)); err != nil {
+		t.Fatal(err)
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/cover" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write(encoded.Bytes())
+	}))
+	defer server.Close()
+
+	img := spotigo.Image{URL: server.URL + "/cover"}
+	data, err := img.Download(context.Background(), server.Client())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !bytes.Equal(data, encoded.Bytes()) {
+		t.Error("downloaded bytes differ from the served image")
+	}
+
+	decoded, err := img.DownloadDecoded(context.Background(), server.Client())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if b := decoded.Bounds(); b.Dx() != 4 || b.Dy() != 3 {
+		t.Errorf("unexpected decoded size %v", b)
+	}
+
+	if _, err := (spotigo.Image{URL: server.URL + "/missing"}).Download(context.Background(), server.Client()); err == nil {
+		t.Error("expected error for a missing image")
+	}
+}
+
+func TestBestImageFor(t *testing.T) {
+	size := func(n int) *int { return &n }
+	images := []spotigo.Image{
+		{URL: "640", Width: size(640), Height: size(640)},
+		{URL: "300", Width: size(300), Height: size(300)},
+		{URL: "64", Width: size(64), Height: size(64)},
+	}
+
+	for _, tc := range []struct {
+		width int
+		want  string
+	}{
+		{32, "64"},
+		{64, "64"},
+		{200, "300"},
+		{301, "640"},
+		{2000, "640"},
+	} {
+		if got, ok := spotigo.BestImageFor(images, tc.width); !ok || got.URL != tc.want {
+			t.Errorf("BestImageFor(%d) = %q, want %q", tc.width, got.URL, tc.want)
+		}
+	}
+
+	if got, ok := spotigo.BestImageFor([]spotigo.Image{{URL: "mosaic"}}, 300); !ok || got.URL != "mosaic" {
+		t.Errorf("expected the unsized image, got %q", got.URL)
+	}
+	if _, ok := spotigo.BestImageFor(nil, 300); ok {
+		t.Error("expected no image from an empty list")
+	}
+}
+
+func TestPrepareCoverImage(t *testing.T) {
+	// A small JPEG is returned unchanged
+	var small bytes.Buffer
+	if err := jpeg.Encode(&small, noiseImage(32, 32), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := spotigo.PrepareCoverImage(small.Bytes()); err != nil || !bytes.Equal(got, small.Bytes()) {
+		t.Errorf("expected small JPEG unchanged, err %v", err)
+	}
+
+	// A large PNG of noise is scaled down and re-encoded
+	var large bytes.Buffer
+	if err := png.Encode(&large, noiseImage(1500, 1200)); err != nil {
+		t.Fatal(err)
+	}
+	cover, err := spotigo.PrepareCoverImage(large.Bytes())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if base64.StdEncoding.EncodedLen(len(cover)) > 256*1024 {
+		t.Errorf("encoded cover is %d bytes", base64.StdEncoding.EncodedLen(len(cover)))
+	}
+	decoded, format, err := image.Decode(bytes.NewReader(cover))
+	if err != nil || format != "jpeg" {
+		t.Fatalf("expected a JPEG, got %q: %v", format, err)
+	}
+	// The 5:4 aspect ratio is kept, up to rounding
+	if b := decoded.Bounds(); b.Dx() > 1024 || b.Dy() > 1024 || b.Dx()*4-b.Dy()*5 > 5 || b.Dy()*5-b.Dx()*4 > 5 {
+		t.Errorf("unexpected cover size %v", b)
+	}
+
+	if _, err := spotigo.PrepareCoverImage([]byte("not an image")); err == nil {
+		t.Error("expected error for undecodable data")
+	}
+}
+
+// coverUploadServer accepts cover uploads and decodes each into uploaded
+func coverUploadServer(t *testing.T, uploaded *[]image.Image) *httptest.Server {
+	t.Helper()
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/playlists/2oCEWyyAPbZp9xhVSxZavx/images" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		body, _ := io.ReadAll(r.Body)
+		if len(body) > 256*1024 {
+			t.Errorf("upload is %d bytes", len(body))
+		}
+		data, err := base64.StdEncoding.DecodeString(string(body))
+		if err != nil {
+			t.Fatalf("upload is not base64: %v", err)
+		}
+		img, err := jpeg.Decode(bytes.NewReader(data))
+		if err != nil {
+			t.Fatalf("upload is not a JPEG: %v", err)
+		}
+		*uploaded = append(*uploaded, img)
+		w.WriteHeader(http.StatusAccepted)
+	}))
+}
+
+func TestPlaylistUploadCoverImageFrom(t *testing.T) {
+	var uploaded []image.Image
+	server := coverUploadServer(t, &uploaded)
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+
+	var encoded bytes.Buffer
+	if err := png.Encode(&encoded, noiseImage(800, 800)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.PlaylistUploadCoverImageFrom(context.Background(), "2oCEWyyAPbZp9xhVSxZavx", &encoded); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, err := client.PlaylistUploadCoverImageDecoded(context.Background(), "2oCEWyyAPbZp9xhVSxZavx", noiseImage(100, 100)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(uploaded) != 2 {
+		t.Fatalf("expected 2 uploads, got %d", len(uploaded))
+	}
+	if b := uploaded[1].Bounds(); b.Dx() != 100 || b.Dy() != 100 {
+		t.Errorf("expected a small image to keep its size, got %v", b)
+	}
+
+	if _, err := client.PlaylistUploadCoverImageFrom(context.Background(), "2oCEWyyAPbZp9xhVSxZavx", bytes.NewReader([]byte("GIF89a"))); err == nil {
+		t.Error("expected error for an unsupported format")
+	}
+}
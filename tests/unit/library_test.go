@@ -0,0 +1,111 @@
+package unit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/sv4u/spotigo"
+)
+
+// base62ID returns a valid 22-character ID ending in n
+func base62ID(prefix string, n int) string {
+	id := fmt.Sprintf("%s%d", prefix, n)
+	return id + strings.Repeat("x", 22-len(id))
+}
+
+func TestLibraryContains(t *testing.T) {
+	var mu sync.Mutex
+	requests := map[string][]int{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ids := strings.Split(r.URL.Query().Get("ids"), ",")
+		mu.Lock()
+		requests[r.URL.Path] = append(requests[r.URL.Path], len(ids))
+		mu.Unlock()
+
+		// IDs whose number is even are saved
+		saved := make([]bool, len(ids))
+		for i, id := range ids {
+			var n int
+			fmt.Sscanf(strings.TrimLeft(id, "tae"), "%d", &n)
+			saved[i] = n%2 == 0
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(saved)
+	}))
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+
+	var uris []string
+	for i := 0; i < 60; i++ {
+		uris = append(uris, "spotify:track:"+base62ID("t", i))
+	}
+	uris = append(uris,
+		"spotify:album:"+base62ID("a", 2),
+		"https://open.spotify.com/episode/"+base62ID("e", 3),
+		"spotify:track:"+base62ID("t", 0), // Duplicate
+	)
+
+	saved, err := client.LibraryContains(context.Background(), uris)
+	if err != nil {
+		t.Fatalf("LibraryContains failed: %v", err)
+	}
+
+	if len(saved) != 62 {
+		t.Errorf("got %d results, want 62", len(saved))
+	}
+	if !saved["spotify:track:"+base62ID("t", 58)] || saved["spotify:track:"+base62ID("t", 59)] {
+		t.Error("unexpected track results")
+	}
+	if !saved["spotify:album:"+base62ID("a", 2)] {
+		t.Error("album should be saved")
+	}
+	if saved["https://open.spotify.com/episode/"+base62ID("e", 3)] {
+		t.Error("episode should not be saved")
+	}
+
+	if got := requests["/me/tracks/contains"]; len(got) != 2 || got[0] != 50 || got[1] != 10 {
+		t.Errorf("track batches = %v, want [50 10]", got)
+	}
+	if got := requests["/me/albums/contains"]; len(got) != 1 {
+		t.Errorf("album batches = %v, want one", got)
+	}
+	if _, ok := requests["/me/shows/contains"]; ok {
+		t.Error("no show requests expected")
+	}
+}
+
+func TestLibraryContainsInvalidItems(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[true]`))
+	}))
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+
+	track := "spotify:track:4iV5W9uYEdYUVa79Axb7Rh"
+	saved, err := client.LibraryContains(context.Background(), []string{
+		"4iV5W9uYEdYUVa79Axb7Rh",                // Raw ID
+		"spotify:artist:0TnOYISbd1XYRBk9myaseg", // Unsupported type
+		track,
+	})
+
+	var multiErr *spotigo.MultiError
+	if !errors.As(err, &multiErr) || multiErr.Len() != 2 {
+		t.Fatalf("expected MultiError with 2 items, got %v", err)
+	}
+	if multiErr.Errors[0].Index != 0 || multiErr.Errors[1].Index != 1 {
+		t.Errorf("unexpected item indexes: %v", multiErr)
+	}
+	if !saved[track] || len(saved) != 1 {
+		t.Errorf("valid item results = %v", saved)
+	}
+}
@@ -0,0 +1,45 @@
+package unit
+
+import (
+	"testing"
+
+	"github.com/sv4u/spotigo"
+)
+
+func TestModelLinks(t *testing.T) {
+	track := &spotigo.Track{ID: "003vvx7Niy0yvhvHt4a68B"}
+	if got := track.ExternalURL(); got != "https://open.spotify.com/track/003vvx7Niy0yvhvHt4a68B" {
+		t.Errorf("unexpected track URL %q", got)
+	}
+	if got := track.SpotifyURI(); got != "spotify:track:003vvx7Niy0yvhvHt4a68B" {
+		t.Errorf("unexpected track URI %q", got)
+	}
+	if got := track.EmbedURL(spotigo.EmbedThemeDefault); got != "https://open.spotify.com/embed/track/003vvx7Niy0yvhvHt4a68B" {
+		t.Errorf("unexpected track embed URL %q", got)
+	}
+
+	// Full models get the links of the simplified ones they embed
+	playlist := &spotigo.Playlist{SimplifiedPlaylist: spotigo.SimplifiedPlaylist{ID: "37i9dQZF1DXcBWIGoYBM5M"}}
+	if got := playlist.EmbedURL(spotigo.EmbedThemeDark); got != "https://open.spotify.com/embed/playlist/37i9dQZF1DXcBWIGoYBM5M?theme=0" {
+		t.Errorf("unexpected playlist embed URL %q", got)
+	}
+	episode := &spotigo.Episode{SimplifiedEpisode: spotigo.SimplifiedEpisode{ID: "512ojhOuo1ktJprKbVcKyQ"}}
+	if got := episode.SpotifyURI(); got != "spotify:episode:512ojhOuo1ktJprKbVcKyQ" {
+		t.Errorf("unexpected episode URI %q", got)
+	}
+
+	artist := &spotigo.SimplifiedArtist{ID: "0OdUWJ0sBjDrqHygGUXeCF"}
+	if got := artist.ExternalURL(); got != "https://open.spotify.com/artist/0OdUWJ0sBjDrqHygGUXeCF" {
+		t.Errorf("unexpected artist URL %q", got)
+	}
+	user := &spotigo.PublicUser{ID: "jane doe"}
+	if got := user.ExternalURL(); got != "https://open.spotify.com/user/jane%20doe" {
+		t.Errorf("unexpected user URL %q", got)
+	}
+
+	// Items without an ID, such as local files, have no links
+	local := &spotigo.Track{URI: "spotify:local:Artist:Album:Song:180", IsLocal: true}
+	if local.ExternalURL() != "" || local.SpotifyURI() != "" || local.EmbedURL(spotigo.EmbedThemeDefault) != "" {
+		t.Error("expected no links for a track without an ID")
+	}
+}
@@ -0,0 +1,124 @@
+package unit
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sv4u/spotigo"
+	"github.com/sv4u/spotigo/tests"
+)
+
+// listeningReportServer serves top items, recent plays, and audio features
+func listeningReportServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	track := func(id, artist, releaseDate string, popularity int) map[string]interface{} {
+		return map[string]interface{}{
+			"id": id, "name": id, "popularity": popularity,
+			"artists": []interface{}{map[string]interface{}{"id": artist, "name": artist}},
+			"album":   map[string]interface{}{"release_date": releaseDate, "release_date_precision": "day"},
+		}
+	}
+	page := func(items []interface{}) map[string]interface{} {
+		return map[string]interface{}{"items": items, "total": len(items), "limit": 50, "offset": 0}
+	}
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/me/top/tracks":
+			if r.URL.Query().Get("time_range") != "short_term" {
+				t.Errorf("expected short_term, got %q", r.URL.Query().Get("time_range"))
+			}
+			tests.WriteJSONResponse(w, http.StatusOK, page([]interface{}{
+				track("t1", "Alpha", "1994-05-01", 80),
+				track("t2", "Beta", "1998", 60),
+				track("t3", "Alpha", "2015-02", 40),
+			}))
+		case "/me/top/artists":
+			tests.WriteJSONResponse(w, http.StatusOK, page([]interface{}{
+				map[string]interface{}{"id": "a1", "name": "Alpha", "genres": []string{"indie", "rock"}},
+				map[string]interface{}{"id": "a2", "name": "Beta", "genres": []string{"rock"}},
+				map[string]interface{}{"id": "a3", "name": "Gamma"},
+			}))
+		case "/me/player/recently-played":
+			tests.WriteJSONResponse(w, http.StatusOK, map[string]interface{}{"items": []interface{}{
+				map[string]interface{}{"track": track("t2", "Beta", "1998", 60), "played_at": "2026-10-01T10:00:00Z"},
+				map[string]interface{}{"track": track("t9", "Beta", "2001", 10), "played_at": "2026-10-01T09:56:00Z"},
+				map[string]interface{}{"track": track("t1", "Alpha", "1994", 80), "played_at": "2026-10-01T09:52:00Z"},
+			}})
+		case "/audio-features":
+			if r.URL.Query().Get("ids") != "t1,t2,t3" {
+				t.Errorf("unexpected ids: %s", r.URL.Query().Get("ids"))
+			}
+			tests.WriteJSONResponse(w, http.StatusOK, map[string]interface{}{"audio_features": []interface{}{
+				map[string]interface{}{"id": "t1", "energy": 0.9, "tempo": 120.0},
+				map[string]interface{}{"id": "t2", "energy": 0.5, "tempo": 100.0},
+				nil,
+			}})
+		default:
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+	}))
+}
+
+// TestListeningReport tests the distributions and averages of a report
+func TestListeningReport(t *testing.T) {
+	server := listeningReportServer(t)
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+	report, err := client.ListeningReport(context.Background(), spotigo.TimeRangeShort)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(report.TopTracks) != 3 || len(report.TopArtists) != 3 || len(report.RecentlyPlayed) != 3 {
+		t.Fatalf("unexpected item counts: %d tracks, %d artists, %d plays",
+			len(report.TopTracks), len(report.TopArtists), len(report.RecentlyPlayed))
+	}
+
+	wantGenres := []spotigo.Tally{{Name: "rock", Count: 2, Fraction: 1}, {Name: "indie", Count: 1, Fraction: 0.5}}
+	if len(report.Genres) != 2 || report.Genres[0] != wantGenres[0] || report.Genres[1] != wantGenres[1] {
+		t.Errorf("unexpected genres: %+v", report.Genres)
+	}
+	if len(report.Decades) != 2 || report.Decades[0].Name != "1990s" || report.Decades[0].Count != 2 || report.Decades[1].Name != "2010s" {
+		t.Errorf("unexpected decades: %+v", report.Decades)
+	}
+	if len(report.RecentArtists) != 2 || report.RecentArtists[0].Name != "Beta" || report.RecentArtists[0].Count != 2 {
+		t.Errorf("unexpected recent artists: %+v", report.RecentArtists)
+	}
+	if report.AveragePopularity != 60 {
+		t.Errorf("expected average popularity 60, got %v", report.AveragePopularity)
+	}
+
+	profile := report.AudioProfile
+	if profile == nil {
+		t.Fatal("expected an audio profile")
+	}
+	if profile.Tracks != 2 || math.Abs(profile.Energy-0.7) > 1e-9 || profile.Tempo != 110 {
+		t.Errorf("unexpected audio profile: %+v", profile)
+	}
+}
+
+// TestListeningReportWithoutAudioFeatures tests that sunset audio features
+// leave the report without a profile
+func TestListeningReportWithoutAudioFeatures(t *testing.T) {
+	server := listeningReportServer(t)
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+	spotigo.WithDisabledEndpoints(spotigo.EndpointAudioFeatures)(client)
+
+	report, err := client.ListeningReport(context.Background(), spotigo.TimeRangeShort)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if report.AudioProfile != nil {
+		t.Errorf("expected no audio profile, got %+v", report.AudioProfile)
+	}
+	if len(report.Genres) == 0 {
+		t.Error("expected the rest of the report")
+	}
+}
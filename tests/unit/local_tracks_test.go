@@ -0,0 +1,96 @@
+package unit
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/sv4u/spotigo"
+)
+
+// TestParseLocalURI tests reading tags from local file URIs
+func TestParseLocalURI(t *testing.T) {
+	local, err := spotigo.ParseLocalURI("spotify:local:Nick+Drake:Pink+Moon:Place+To+Be%3A+Demo:163")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := spotigo.LocalTrack{
+		URI:        "spotify:local:Nick+Drake:Pink+Moon:Place+To+Be%3A+Demo:163",
+		Name:       "Place To Be: Demo",
+		Artist:     "Nick Drake",
+		Album:      "Pink Moon",
+		DurationMs: 163000,
+	}
+	if *local != want {
+		t.Errorf("got %+v, want %+v", *local, want)
+	}
+
+	if local, err := spotigo.ParseLocalURI("spotify:local:::Untitled:"); err != nil || local.Name != "Untitled" || local.DurationMs != 0 {
+		t.Errorf("unexpected result for untagged file: %+v, %v", local, err)
+	}
+	for _, uri := range []string{"spotify:track:6LgJvl0Xdtc73RJ1mmpotq", "spotify:local:a:b", "spotify:local:a:b:c:long"} {
+		if _, err := spotigo.ParseLocalURI(uri); err == nil {
+			t.Errorf("expected error for %s", uri)
+		}
+	}
+}
+
+// TestPlaylistItemUnion tests decoding playlist items into the union
+func TestPlaylistItemUnion(t *testing.T) {
+	var items []spotigo.PlaylistItem
+	err := json.Unmarshal([]byte(`[
+		{"added_at": "2024-01-01T00:00:00Z", "track": {"id": "6LgJvl0Xdtc73RJ1mmpotq", "uri": "spotify:track:6LgJvl0Xdtc73RJ1mmpotq", "type": "track", "name": "Song"}},
+		{"track": {"id": "512ojhOuo1ktJprKbVcKyQ", "uri": "spotify:episode:512ojhOuo1ktJprKbVcKyQ", "type": "episode", "name": "Episode"}},
+		{"is_local": true, "track": {"id": null, "uri": "spotify:local:Band::Demo:95", "type": "track", "name": "Demo",
+			"is_local": true, "duration_ms": 95000, "album": {"name": null}, "artists": [{"name": "Band"}]}},
+		{"track": null}
+	]`), &items)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if items[0].Track == nil || items[0].Track.Name != "Song" || items[0].Episode != nil || items[0].Local != nil {
+		t.Errorf("expected a track, got %+v", items[0])
+	}
+	if items[1].Episode == nil || items[1].Episode.Name != "Episode" || items[1].Track != nil {
+		t.Errorf("expected an episode, got %+v", items[1])
+	}
+	local := items[2].Local
+	if local == nil || items[2].Track != nil || local.Name != "Demo" || local.Artist != "Band" || local.DurationMs != 95000 {
+		t.Errorf("expected a local file, got %+v", items[2])
+	}
+	if items[3].Kind() != spotigo.PlaylistItemUnavailable {
+		t.Errorf("expected an unavailable item, got %s", items[3].Kind())
+	}
+
+	// Items survive a round trip through JSON
+	data, err := json.Marshal(items)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var decoded []spotigo.PlaylistItem
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if decoded[1].Episode == nil || decoded[2].Local == nil || *decoded[2].Local != *local {
+		t.Errorf("round trip lost items: %+v", decoded)
+	}
+}
+
+// TestPlaylistTrackItem tests converting PlaylistTracks results to the union
+func TestPlaylistTrackItem(t *testing.T) {
+	var page spotigo.Paging[spotigo.PlaylistTrack]
+	err := json.Unmarshal([]byte(`{"items": [
+		{"is_local": true, "track": {"uri": "spotify:local:::Voice+Memo:42", "name": "", "is_local": true}}
+	]}`), &page)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	item := page.Items[0].Item()
+	if item.Local == nil || item.Local.Name != "Voice Memo" || item.Local.DurationMs != 42000 {
+		t.Errorf("expected tags from the URI, got %+v", item.Local)
+	}
+	if _, ok := page.Items[0].AsLocal(); !ok {
+		t.Error("expected AsLocal to succeed")
+	}
+}
@@ -0,0 +1,301 @@
+package unit
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/sv4u/spotigo"
+	"github.com/sv4u/spotigo/tests"
+)
+
+func TestTrackPlayableIn(t *testing.T) {
+	playable, unplayable := true, false
+
+	tests := []struct {
+		name   string
+		track  spotigo.Track
+		market string
+		want   bool
+	}{
+		{"listed market", spotigo.Track{AvailableMarkets: []string{"US", "DE"}}, "de", true},
+		{"unlisted market", spotigo.Track{AvailableMarkets: []string{"US"}}, "DE", false},
+		{"no markets", spotigo.Track{}, "US", false},
+		{"is_playable true", spotigo.Track{IsPlayable: &playable}, "DE", true},
+		{"is_playable false", spotigo.Track{IsPlayable: &unplayable, AvailableMarkets: []string{"DE"}}, "DE", false},
+		{"restricted", spotigo.Track{IsPlayable: &playable, Restrictions: &spotigo.Restrictions{Reason: "market"}}, "DE", false},
+		{"from_token without is_playable", spotigo.Track{}, spotigo.MarketFromToken, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.track.PlayableIn(tt.market); got != tt.want {
+				t.Errorf("PlayableIn(%q) = %v, want %v", tt.market, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFilterPlayable(t *testing.T) {
+	tracks := []spotigo.Track{
+		{ID: "a", AvailableMarkets: []string{"US"}},
+		{ID: "b", AvailableMarkets: []string{"DE"}},
+		{ID: "c", AvailableMarkets: []string{"US", "DE"}},
+	}
+
+	got := spotigo.FilterPlayable(tracks, "US")
+	if len(got) != 2 || got[0].ID != "a" || got[1].ID != "c" {
+		t.Errorf("FilterPlayable = %+v", got)
+	}
+
+	albums := []spotigo.SimplifiedAlbum{
+		{ID: "x", AvailableMarkets: []string{"US"}},
+		{ID: "y", Restrictions: &spotigo.Restrictions{Reason: "market"}, AvailableMarkets: []string{"US"}},
+	}
+	if got := spotigo.FilterPlayable(albums, "US"); len(got) != 1 || got[0].ID != "x" {
+		t.Errorf("FilterPlayable(albums) = %+v", got)
+	}
+}
+
+func TestTrackRelinking(t *testing.T) {
+	track := spotigo.Track{ID: "relinked", LinkedFrom: &spotigo.TrackLink{ID: "original"}}
+	if !track.IsRelinked() || track.RequestedID() != "original" {
+		t.Errorf("IsRelinked = %v, RequestedID = %q", track.IsRelinked(), track.RequestedID())
+	}
+
+	plain := spotigo.Track{ID: "plain"}
+	if plain.IsRelinked() || plain.RequestedID() != "plain" {
+		t.Errorf("IsRelinked = %v, RequestedID = %q", plain.IsRelinked(), plain.RequestedID())
+	}
+}
+
+func TestWithMarketFromToken(t *testing.T) {
+	markets := map[string]string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		markets[r.URL.Path] = r.URL.Query().Get("market")
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id": "4iV5W9uYEdYUVa79Axb7Rh"}`))
+	}))
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+	spotigo.WithMarketFromToken()(client)
+	ctx := context.Background()
+
+	if _, err := client.Track(ctx, "4iV5W9uYEdYUVa79Axb7Rh"); err != nil {
+		t.Fatalf("Track failed: %v", err)
+	}
+	if _, err := client.Album(ctx, "4aawyAB9vmqN3uQ7FjRGTy", "SE"); err != nil {
+		t.Fatalf("Album failed: %v", err)
+	}
+	if _, err := client.CurrentUser(ctx); err != nil {
+		t.Fatalf("CurrentUser failed: %v", err)
+	}
+
+	if got := markets["/tracks/4iV5W9uYEdYUVa79Axb7Rh"]; got != "from_token" {
+		t.Errorf("track market = %q, want from_token", got)
+	}
+	if got := markets["/albums/4aawyAB9vmqN3uQ7FjRGTy"]; got != "SE" {
+		t.Errorf("album market = %q, explicit market must win", got)
+	}
+	if got := markets["/me"]; got != "" {
+		t.Errorf("profile market = %q, endpoint takes no market", got)
+	}
+}
+
+func TestWithMarketFromTokenSkipsClientCredentials(t *testing.T) {
+	var market string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		market = r.URL.Query().Get("market")
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id": "4iV5W9uYEdYUVa79Axb7Rh"}`))
+	}))
+	defer server.Close()
+
+	auth, err := spotigo.NewClientCredentials("id", "secret")
+	if err != nil {
+		t.Fatalf("NewClientCredentials failed: %v", err)
+	}
+	auth.TokenInfo = &spotigo.TokenInfo{AccessToken: "cc", TokenType: "Bearer", ExpiresAt: int(time.Now().Add(time.Hour).Unix())}
+
+	client, err := spotigo.NewClient(auth, spotigo.WithMarketFromToken(), spotigo.WithAPIPrefix(server.URL+"/"))
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+	if _, err := client.Track(context.Background(), "4iV5W9uYEdYUVa79Axb7Rh"); err != nil {
+		t.Fatalf("Track failed: %v", err)
+	}
+	if market != "" {
+		t.Errorf("market = %q, Client Credentials requests must not use from_token", market)
+	}
+}
+
+// appOnlyAuth is a custom auth manager whose tokens belong to the app
+type appOnlyAuth struct {
+	tests.MockAuthManager
+}
+
+func (appOnlyAuth) UserScoped() bool { return false }
+
+func TestWithAutoFromTokenMarket(t *testing.T) {
+	var market string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		market = r.URL.Query().Get("market")
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id": "4iV5W9uYEdYUVa79Axb7Rh"}`))
+	}))
+	defer server.Close()
+	ctx := context.Background()
+
+	client := newPlayerTestClient(t, server)
+	spotigo.WithAutoFromTokenMarket(true)(client)
+	if _, err := client.Track(ctx, "4iV5W9uYEdYUVa79Axb7Rh"); err != nil {
+		t.Fatalf("Track failed: %v", err)
+	}
+	if market != spotigo.MarketFromToken {
+		t.Errorf("market = %q, expected from_token for a user-scoped client", market)
+	}
+
+	spotigo.WithAutoFromTokenMarket(false)(client)
+	if _, err := client.Track(ctx, "4iV5W9uYEdYUVa79Axb7Rh"); err != nil {
+		t.Fatalf("Track failed: %v", err)
+	}
+	if market != "" {
+		t.Errorf("market = %q, expected none once disabled", market)
+	}
+
+	auth := &appOnlyAuth{tests.MockAuthManager{Token: &spotigo.TokenInfo{AccessToken: "app"}}}
+	client, err := spotigo.NewClient(auth, spotigo.WithAutoFromTokenMarket(true), spotigo.WithAPIPrefix(server.URL+"/"))
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+	if _, err := client.Track(ctx, "4iV5W9uYEdYUVa79Axb7Rh"); err != nil {
+		t.Fatalf("Track failed: %v", err)
+	}
+	if market != "" {
+		t.Errorf("market = %q, auth managers that are not user-scoped must not use from_token", market)
+	}
+}
+
+func TestDefaultMarketAndLocale(t *testing.T) {
+	queries := map[string]string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries[r.URL.Path] = r.URL.RawQuery
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+	spotigo.WithMarketFromToken()(client)
+	spotigo.WithDefaultMarket("US")(client)
+	spotigo.WithDefaultLocale("en_US")(client)
+	ctx := context.Background()
+
+	if _, err := client.Track(ctx, "4iV5W9uYEdYUVa79Axb7Rh"); err != nil {
+		t.Fatalf("Track failed: %v", err)
+	}
+	if got := queries["/tracks/4iV5W9uYEdYUVa79Axb7Rh"]; got != "market=US" {
+		t.Errorf("track query = %q, default market must win over from_token", got)
+	}
+
+	// Context overrides the client defaults; explicit options override both
+	ctxDE := spotigo.ContextWithLocale(spotigo.ContextWithMarket(ctx, "DE"), "de_DE")
+	if _, err := client.Album(ctxDE, "4aawyAB9vmqN3uQ7FjRGTy"); err != nil {
+		t.Fatalf("Album failed: %v", err)
+	}
+	if got := queries["/albums/4aawyAB9vmqN3uQ7FjRGTy"]; got != "market=DE" {
+		t.Errorf("album query = %q", got)
+	}
+	if _, err := client.BrowseCategories(ctxDE, &spotigo.BrowseCategoriesOptions{Country: "AT"}); err != nil {
+		t.Fatalf("BrowseCategories failed: %v", err)
+	}
+	if got := queries["/browse/categories"]; got != "country=AT&limit=20&locale=de_DE" {
+		t.Errorf("categories query = %q", got)
+	}
+
+	if _, err := client.CurrentUser(ctx); err != nil {
+		t.Fatalf("CurrentUser failed: %v", err)
+	}
+	if got := queries["/me"]; got != "" {
+		t.Errorf("profile query = %q, endpoint takes no market or locale", got)
+	}
+
+	if _, err := client.Track(spotigo.ContextWithMarket(ctx, "USA"), "4iV5W9uYEdYUVa79Axb7Rh"); err == nil {
+		t.Error("expected error for invalid context market")
+	}
+	if _, err := client.BrowseCategory(spotigo.ContextWithLocale(ctx, "english"), "toplists", nil); err == nil {
+		t.Error("expected error for invalid context locale")
+	}
+}
+
+func TestLookupCountry(t *testing.T) {
+	for code := range spotigo.SupportedCountryCodes {
+		country, ok := spotigo.LookupCountry(code)
+		if !ok || country.Code != code || country.Name == "" || country.Region == "" {
+			t.Errorf("missing metadata for %s: %+v", code, country)
+		}
+	}
+
+	country, ok := spotigo.LookupCountry("de")
+	if !ok || country.Name != "Germany" || country.Region != "Europe" {
+		t.Errorf("unexpected lookup for de: %+v", country)
+	}
+	if _, ok := spotigo.LookupCountry("ZZ"); ok {
+		t.Error("expected unknown code to be rejected")
+	}
+}
+
+func TestMarketsCache(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"markets": ["US", "DE", "QQ", "AR"]}`))
+	}))
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+	ctx := context.Background()
+
+	markets, err := client.Markets(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []spotigo.Country{
+		{Code: "AR", Name: "Argentina", Region: "Americas"},
+		{Code: "DE", Name: "Germany", Region: "Europe"},
+		{Code: "QQ", Name: "QQ"},
+		{Code: "US", Name: "United States", Region: "Americas"},
+	}
+	if len(markets) != len(want) {
+		t.Fatalf("got %+v, want %+v", markets, want)
+	}
+	for i := range want {
+		if markets[i] != want[i] {
+			t.Errorf("market %d = %+v, want %+v", i, markets[i], want[i])
+		}
+	}
+
+	markets[0].Name = "modified"
+	again, err := client.Markets(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if requests != 1 {
+		t.Errorf("expected cached result, got %d requests", requests)
+	}
+	if again[0].Name != "Argentina" {
+		t.Error("expected callers not to share the cached slice")
+	}
+
+	client.MarketsCacheTTL = -1
+	if _, err := client.Markets(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if requests != 2 {
+		t.Errorf("expected a fetch with caching disabled, got %d requests", requests)
+	}
+}
@@ -0,0 +1,76 @@
+package unit
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/sv4u/spotigo"
+)
+
+// setSpotifyEnv clears the variables NewFromEnv reads and sets vars
+func setSpotifyEnv(t *testing.T, vars map[string]string) {
+	t.Helper()
+	for _, name := range []string{
+		spotigo.EnvClientID, spotigo.EnvClientSecret, spotigo.EnvRedirectURI, spotigo.EnvRefreshToken, spotigo.EnvUsername,
+		spotigo.EnvSpotifyClientID, spotigo.EnvSpotifyClientSecret, spotigo.EnvSpotifyRedirectURI, spotigo.EnvSpotifyRefreshToken,
+	} {
+		t.Setenv(name, "")
+	}
+	t.Setenv(spotigo.EnvCachePath, filepath.Join(t.TempDir(), "token"))
+	for name, value := range vars {
+		t.Setenv(name, value)
+	}
+}
+
+func TestNewFromEnvSelectsAuthManager(t *testing.T) {
+	ctx := context.Background()
+
+	setSpotifyEnv(t, map[string]string{spotigo.EnvSpotifyClientID: "id", spotigo.EnvSpotifyClientSecret: "secret"})
+	client, err := spotigo.NewFromEnv(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if auth, ok := client.AuthManager.(*spotigo.ClientCredentials); !ok || auth.ClientID != "id" {
+		t.Errorf("expected Client Credentials, got %T", client.AuthManager)
+	}
+
+	setSpotifyEnv(t, map[string]string{spotigo.EnvSpotifyClientID: "id", spotigo.EnvSpotifyClientSecret: "secret", spotigo.EnvSpotifyRefreshToken: "refresh"})
+	client, err = spotigo.NewFromEnv(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if auth, ok := client.AuthManager.(*spotigo.SpotifyOAuth); !ok || auth.TokenInfo.RefreshToken != "refresh" || auth.CacheHandler == nil {
+		t.Errorf("expected Authorization Code with the refresh token, got %T", client.AuthManager)
+	}
+
+	// SPOTIGO_ names take precedence, and no secret means PKCE
+	setSpotifyEnv(t, map[string]string{spotigo.EnvClientID: "spotigo_id", spotigo.EnvSpotifyClientID: "id", spotigo.EnvRefreshToken: "refresh"})
+	client, err = spotigo.NewFromEnv(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if auth, ok := client.AuthManager.(*spotigo.SpotifyPKCE); !ok || auth.ClientID != "spotigo_id" {
+		t.Errorf("expected PKCE with the SPOTIGO_ client ID, got %T", client.AuthManager)
+	}
+}
+
+func TestNewFromEnvErrors(t *testing.T) {
+	ctx := context.Background()
+
+	setSpotifyEnv(t, nil)
+	if _, err := spotigo.NewFromEnv(ctx); err == nil {
+		t.Error("expected an error without a client ID")
+	}
+
+	setSpotifyEnv(t, map[string]string{spotigo.EnvSpotifyClientID: "id"})
+	if _, err := spotigo.NewFromEnv(ctx); err == nil {
+		t.Error("expected an error without a secret or refresh token")
+	}
+
+	// A redirect URI alone needs a cached token
+	setSpotifyEnv(t, map[string]string{spotigo.EnvSpotifyClientID: "id", spotigo.EnvSpotifyRedirectURI: "http://localhost/callback"})
+	if _, err := spotigo.NewFromEnv(ctx); err == nil {
+		t.Error("expected an error without a cached token")
+	}
+}
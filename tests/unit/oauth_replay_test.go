@@ -0,0 +1,182 @@
+package unit
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/sv4u/spotigo"
+)
+
+// roundTripFunc adapts a function to http.RoundTripper
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+// tokenTransport answers every token request with a fresh token
+func tokenTransport(calls *int32) http.RoundTripper {
+	return roundTripFunc(func(r *http.Request) (*http.Response, error) {
+		atomic.AddInt32(calls, 1)
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+			Body:       io.NopCloser(strings.NewReader(`{"access_token": "access", "token_type": "Bearer", "expires_in": 3600, "refresh_token": "refresh"}`)),
+			Request:    r,
+		}, nil
+	})
+}
+
+func TestExchangeCodeRejectsReplay(t *testing.T) {
+	auth, err := spotigo.NewSpotifyOAuth("client_id", "client_secret", "http://localhost:8080/callback", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var calls int32
+	auth.HTTPClient = &http.Client{Transport: tokenTransport(&calls)}
+	ctx := context.Background()
+
+	if err := auth.ExchangeCode(ctx, "code_123"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	err = auth.ExchangeCode(ctx, "code_123")
+	if !errors.Is(err, spotigo.ErrCallbackReplayed) {
+		t.Fatalf("expected ErrCallbackReplayed, got %v", err)
+	}
+	var replayErr *spotigo.SpotifyReplayError
+	if !errors.As(err, &replayErr) || replayErr.Kind != "code" {
+		t.Errorf("expected SpotifyReplayError for code, got %v", err)
+	}
+	if got := atomic.LoadInt32(&calls); got != 1 {
+		t.Errorf("expected replay not to reach the token endpoint, got %d calls", got)
+	}
+
+	if err := auth.ExchangeCode(ctx, "code_456"); err != nil {
+		t.Errorf("expected new code to be accepted, got %v", err)
+	}
+}
+
+func TestExchangeCallbackRejectsReplayedState(t *testing.T) {
+	auth, err := spotigo.NewSpotifyOAuth("client_id", "client_secret", "http://localhost:8080/callback", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	auth.State = "state_abc"
+	var calls int32
+	auth.HTTPClient = &http.Client{Transport: tokenTransport(&calls)}
+	ctx := context.Background()
+
+	if err := auth.ExchangeCallback(ctx, "http://localhost:8080/callback?code=code_123&state=state_abc"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Same state with a fresh code: rejected before the token endpoint
+	err = auth.ExchangeCallback(ctx, "http://localhost:8080/callback?code=code_456&state=state_abc")
+	var replayErr *spotigo.SpotifyReplayError
+	if !errors.As(err, &replayErr) || replayErr.Kind != "state" {
+		t.Fatalf("expected SpotifyReplayError for state, got %v", err)
+	}
+	if got := atomic.LoadInt32(&calls); got != 1 {
+		t.Errorf("expected replay not to reach the token endpoint, got %d calls", got)
+	}
+
+	var stateErr *spotigo.SpotifyStateError
+	err = auth.ExchangeCallback(ctx, "http://localhost:8080/callback?code=code_789&state=other")
+	if !errors.As(err, &stateErr) {
+		t.Errorf("expected SpotifyStateError for a mismatched state, got %v", err)
+	}
+}
+
+func TestPKCEExchangeCallbackRetriesAfterFailure(t *testing.T) {
+	auth, err := spotigo.NewSpotifyPKCE("client_id", "http://localhost:8080/callback", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	auth.CodeVerifier = "verifier"
+	auth.State = "state_abc"
+
+	var calls int32
+	auth.HTTPClient = &http.Client{Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			return nil, errors.New("connection reset")
+		}
+		return tokenTransport(new(int32)).RoundTrip(r)
+	})}
+
+	ctx := context.Background()
+	callback := "http://localhost:8080/callback?code=code_123&state=state_abc"
+	if err := auth.ExchangeCallback(ctx, callback); err == nil {
+		t.Fatal("expected network error")
+	}
+	if err := auth.ExchangeCallback(ctx, callback); err != nil {
+		t.Fatalf("expected retry after network error to succeed, got %v", err)
+	}
+	if err := auth.ExchangeCallback(ctx, callback); !errors.Is(err, spotigo.ErrCallbackReplayed) {
+		t.Errorf("expected ErrCallbackReplayed after a successful exchange, got %v", err)
+	}
+}
+
+func TestExchangeCodeAllowsRetryAfterNetworkError(t *testing.T) {
+	auth, err := spotigo.NewSpotifyPKCE("client_id", "http://localhost:8080/callback", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	auth.CodeVerifier = "verifier"
+
+	var calls int32
+	auth.HTTPClient = &http.Client{Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			return nil, errors.New("connection reset")
+		}
+		return tokenTransport(new(int32)).RoundTrip(r)
+	})}
+
+	ctx := context.Background()
+	if err := auth.ExchangeCode(ctx, "code_123"); err == nil {
+		t.Fatal("expected network error")
+	}
+	if err := auth.ExchangeCode(ctx, "code_123"); err != nil {
+		t.Errorf("expected retry after network error to succeed, got %v", err)
+	}
+}
+
+func TestCheckCallbackState(t *testing.T) {
+	auth, err := spotigo.NewSpotifyOAuth("client_id", "client_secret", "http://localhost:8080/callback", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := auth.CheckCallbackState("state_abc"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := auth.CheckCallbackState("state_abc"); !errors.Is(err, spotigo.ErrCallbackReplayed) {
+		t.Errorf("expected ErrCallbackReplayed, got %v", err)
+	}
+
+	// Disabling the guard accepts replays
+	auth.ReplayGuard = nil
+	if err := auth.CheckCallbackState("state_abc"); err != nil {
+		t.Errorf("expected nil guard to accept replays, got %v", err)
+	}
+}
+
+func TestImplicitGrantRejectsReplayedState(t *testing.T) {
+	auth, err := spotigo.NewSpotifyImplicitGrant("client_id", "http://localhost:8080/callback", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	callback := "http://localhost:8080/callback#access_token=token&token_type=Bearer&expires_in=3600&state=state_xyz"
+	if err := auth.ParseTokenFromURL(callback); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := auth.ParseTokenFromURL(callback); !errors.Is(err, spotigo.ErrCallbackReplayed) {
+		t.Errorf("expected ErrCallbackReplayed, got %v", err)
+	}
+}
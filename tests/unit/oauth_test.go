@@ -4,9 +4,11 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -131,6 +133,103 @@ func TestClientCredentialsTokenRefresh(t *testing.T) {
 	}
 }
 
+func TestClientCredentialsExpirySkew(t *testing.T) {
+	auth, err := spotigo.NewClientCredentials("client_id", "client_secret")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	token := &spotigo.TokenInfo{AccessToken: "token", ExpiresAt: int(time.Now().Add(5 * time.Minute).Unix())}
+	if auth.IsTokenExpired(token) {
+		t.Error("expected token to be valid with the default skew")
+	}
+	auth.ExpirySkew = 10 * time.Minute
+	if !auth.IsTokenExpired(token) {
+		t.Error("expected token within ExpirySkew of expiry to be expired")
+	}
+
+	if got := token.Expiry(); got.Unix() != int64(token.ExpiresAt) {
+		t.Errorf("expected Expiry to match ExpiresAt, got %v", got)
+	}
+	if !(&spotigo.TokenInfo{}).Expiry().IsZero() {
+		t.Error("expected zero Expiry for a token without ExpiresAt")
+	}
+}
+
+func TestClientCredentialsStartPrefetch(t *testing.T) {
+	auth, err := spotigo.NewClientCredentials("client_id", "client_secret")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var calls int32
+	auth.HTTPClient = &http.Client{Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+		n := atomic.AddInt32(&calls, 1)
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+			Body:       io.NopCloser(strings.NewReader(fmt.Sprintf(`{"access_token": "access_%d", "token_type": "Bearer", "expires_in": 2}`, n))),
+			Request:    r,
+		}, nil
+	})}
+	auth.ExpirySkew = 1500 * time.Millisecond
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	auth.StartPrefetch(ctx)
+
+	// Tokens last 2s and are refreshed 1.5s early, so a second fetch happens
+	// without any caller asking for a token
+	deadline := time.Now().Add(3 * time.Second)
+	for atomic.LoadInt32(&calls) < 2 && time.Now().Before(deadline) {
+		time.Sleep(20 * time.Millisecond)
+	}
+	if atomic.LoadInt32(&calls) < 2 {
+		t.Fatalf("expected a background fetch, got %d token requests", atomic.LoadInt32(&calls))
+	}
+
+	token, err := auth.GetAccessToken(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if token == "access_1" {
+		t.Error("expected the prefetched token")
+	}
+}
+
+func TestClientCredentialsPrefetchSkewLongerThanLifetime(t *testing.T) {
+	auth, err := spotigo.NewClientCredentials("client_id", "client_secret")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var calls int32
+	auth.HTTPClient = &http.Client{Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+		atomic.AddInt32(&calls, 1)
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+			Body:       io.NopCloser(strings.NewReader(`{"access_token": "access", "token_type": "Bearer", "expires_in": 3600}`)),
+			Request:    r,
+		}, nil
+	})}
+	auth.ExpirySkew = 2 * time.Hour // Longer than the token lives
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	auth.StartPrefetch(ctx)
+	time.Sleep(300 * time.Millisecond)
+
+	// The skew is capped, so the new token is neither refetched in a loop nor
+	// treated as expired by callers
+	if _, err := auth.GetAccessToken(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if n := atomic.LoadInt32(&calls); n != 1 {
+		t.Errorf("expected a single token request, got %d", n)
+	}
+}
+
 func TestClientCredentialsInvalidCredentials(t *testing.T) {
 	// Test that invalid credentials are caught during creation
 	// Note: This will use environment variables if not provided
@@ -870,3 +969,31 @@ func TestSpotifyImplicitGrantGetAccessToken(t *testing.T) {
 		t.Errorf("expected 'valid_token', got %q", token)
 	}
 }
+
+func TestOnTokenRefreshedReceivesRotatedToken(t *testing.T) {
+	auth, err := spotigo.NewSpotifyOAuth("client_id", "client_secret", "http://localhost/callback", "user-read-private")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	auth.TokenInfo = &spotigo.TokenInfo{AccessToken: "old_access", RefreshToken: "old_refresh"}
+	auth.HTTPClient = &http.Client{Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+			Body:       io.NopCloser(strings.NewReader(`{"access_token": "new_access", "token_type": "Bearer", "expires_in": 3600, "refresh_token": "new_refresh"}`)),
+			Request:    r,
+		}, nil
+	})}
+
+	var refreshed []spotigo.TokenInfo
+	auth.OnTokenRefreshed = func(token spotigo.TokenInfo) {
+		refreshed = append(refreshed, token)
+	}
+
+	if err := auth.RefreshToken(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(refreshed) != 1 || refreshed[0].AccessToken != "new_access" || refreshed[0].RefreshToken != "new_refresh" {
+		t.Errorf("expected the rotated token to be reported once, got %+v", refreshed)
+	}
+}
@@ -0,0 +1,78 @@
+package unit
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/sv4u/spotigo"
+)
+
+func TestOEmbed(t *testing.T) {
+	var requested []string
+	client := newShareLinkClient(t, &requested, func(r *http.Request) *http.Response {
+		if r.URL.Host != "open.spotify.com" || r.URL.Path != "/oembed" {
+			t.Errorf("unexpected request to %s", r.URL)
+		}
+		if u := r.URL.Query().Get("url"); u != "https://open.spotify.com/album/4aawyAB9vmqN3uQ7FjRGTy" {
+			t.Errorf("unexpected url parameter %q", u)
+		}
+		if auth := r.Header.Get("Authorization"); auth != "" {
+			t.Errorf("expected no Authorization header, got %q", auth)
+		}
+		body := `{"type": "rich", "version": "1.0", "title": "Global Warming", "html": "<iframe src=\"https://open.spotify.com/embed/album/4aawyAB9vmqN3uQ7FjRGTy\"></iframe>",
+			"width": 456, "height": 352, "thumbnail_url": "https://image-cdn.spotify.com/abc", "thumbnail_width": 300, "provider_name": "Spotify"}`
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(body))}
+	})
+
+	embed, err := client.OEmbed(context.Background(), "spotify:album:4aawyAB9vmqN3uQ7FjRGTy")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if embed.Title != "Global Warming" || embed.ThumbnailURL != "https://image-cdn.spotify.com/abc" || embed.Height != 352 {
+		t.Errorf("unexpected embed: %+v", embed)
+	}
+	if !strings.HasPrefix(embed.HTML, "<iframe") {
+		t.Errorf("expected iframe HTML, got %q", embed.HTML)
+	}
+	if len(requested) != 1 {
+		t.Errorf("expected 1 request, got %v", requested)
+	}
+}
+
+func TestOEmbedErrors(t *testing.T) {
+	var requested []string
+	client := newShareLinkClient(t, &requested, func(r *http.Request) *http.Response {
+		return &http.Response{StatusCode: http.StatusNotFound, Body: io.NopCloser(strings.NewReader("Not Found"))}
+	})
+
+	_, err := client.OEmbed(context.Background(), "https://open.spotify.com/track/4iV5W9uYEdYUVa79Axb7Rh")
+	var spotifyErr *spotigo.SpotifyError
+	if !errors.As(err, &spotifyErr) || spotifyErr.HTTPStatus != http.StatusNotFound {
+		t.Errorf("expected a 404 SpotifyError, got %v", err)
+	}
+
+	if _, err := client.OEmbed(context.Background(), "4iV5W9uYEdYUVa79Axb7Rh"); err == nil {
+		t.Error("expected error for a raw ID without a type")
+	}
+}
+
+func TestOEmbedTooLarge(t *testing.T) {
+	var requested []string
+	client := newShareLinkClient(t, &requested, func(r *http.Request) *http.Response {
+		body := `{"type": "rich", "html": "` + strings.Repeat("x", 1<<20) + `"}`
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(body))}
+	})
+
+	_, err := client.OEmbed(context.Background(), "spotify:album:4aawyAB9vmqN3uQ7FjRGTy")
+	var tooLarge *spotigo.ResponseTooLargeError
+	if !errors.As(err, &tooLarge) || !errors.Is(err, spotigo.ErrResponseTooLarge) {
+		t.Fatalf("expected a ResponseTooLargeError, got %v", err)
+	}
+	if tooLarge.Read > tooLarge.Limit+1 {
+		t.Errorf("expected reading to stop at the limit, read %d of limit %d", tooLarge.Read, tooLarge.Limit)
+	}
+}
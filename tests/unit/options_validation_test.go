@@ -0,0 +1,69 @@
+package unit
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/sv4u/spotigo"
+)
+
+func TestOptionsValidateReportsAllProblems(t *testing.T) {
+	opts := &spotigo.SearchOptions{
+		Market:          "XX",
+		Limit:           -1,
+		Offset:          -5,
+		Types:           []spotigo.SearchType{"track", "song"},
+		IncludeExternal: "video",
+	}
+	err := opts.Validate()
+	if err == nil {
+		t.Fatal("expected validation errors")
+	}
+	for _, want := range []string{"limit must be non-negative", "offset must be non-negative", "XX", `"song"`, `"video"`} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("expected %q among the errors, got:\n%v", want, err)
+		}
+	}
+	if !errors.Is(err, spotigo.ErrValidation) {
+		t.Error("expected the joined error to match ErrValidation")
+	}
+	var marketErr *spotigo.InvalidMarketError
+	if !errors.As(err, &marketErr) || marketErr.Code != "XX" {
+		t.Errorf("expected an InvalidMarketError among the errors, got %v", err)
+	}
+	var optionErr *spotigo.InvalidOptionError
+	if !errors.As(err, &optionErr) || optionErr.Field != "limit" {
+		t.Errorf("expected the limit error first, got %v", optionErr)
+	}
+
+	top := &spotigo.TopItemsOptions{Range: "weekly", Offset: 60}
+	err = top.Validate()
+	if err == nil || !strings.Contains(err.Error(), "at most 49") || !strings.Contains(err.Error(), `"weekly"`) {
+		t.Errorf("expected offset and time range errors, got %v", err)
+	}
+
+	var none *spotigo.PlaylistTracksOptions
+	if err := none.Validate(); err != nil {
+		t.Errorf("expected nil options to be valid, got %v", err)
+	}
+	if err := (&spotigo.PlaylistTracksOptions{AdditionalTypes: "track, episode", Market: "from_token"}).Validate(); err != nil {
+		t.Errorf("expected valid options, got %v", err)
+	}
+}
+
+func TestOptionsValidatedBeforeRequest(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s", r.URL)
+	}))
+	defer server.Close()
+	client := newPlayerTestClient(t, server)
+
+	_, err := client.CurrentUserSavedTracks(context.Background(), &spotigo.SavedTracksOptions{Limit: -1, Market: "ZZ"})
+	if err == nil || !strings.Contains(err.Error(), "limit") || !strings.Contains(err.Error(), "ZZ") {
+		t.Errorf("expected both problems to be reported, got %v", err)
+	}
+}
@@ -0,0 +1,364 @@
+package unit
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/sv4u/spotigo"
+	"github.com/sv4u/spotigo/tests"
+)
+
+// followedArtistsServer serves followed artists in pages of two, keyed by the after cursor
+func followedArtistsServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	pages := map[string]map[string]interface{}{
+		"":   {"items": []map[string]interface{}{{"id": "a1"}, {"id": "a2"}}, "after": "a2"},
+		"a2": {"items": []map[string]interface{}{{"id": "a3"}, {"id": "a4"}}, "after": "a4"},
+		"a4": {"items": []map[string]interface{}{{"id": "a5"}}, "after": nil},
+	}
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/me/following" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		if r.URL.Query().Get("type") != "artist" {
+			t.Errorf("expected type=artist, got %q", r.URL.Query().Get("type"))
+		}
+
+		page, ok := pages[r.URL.Query().Get("after")]
+		if !ok {
+			t.Fatalf("unexpected cursor: %q", r.URL.Query().Get("after"))
+		}
+
+		var next interface{}
+		if page["after"] != nil {
+			next = fmt.Sprintf("http://%s/me/following?type=artist&limit=2&after=%s", r.Host, page["after"])
+		}
+		tests.WriteJSONResponse(w, http.StatusOK, map[string]interface{}{
+			"artists": map[string]interface{}{
+				"items":   page["items"],
+				"next":    next,
+				"cursors": map[string]interface{}{"after": page["after"]},
+				"limit":   2,
+				"total":   5,
+			},
+		})
+	}))
+}
+
+func TestCurrentUserFollowedArtistsAll(t *testing.T) {
+	server := followedArtistsServer(t)
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+
+	artists, err := client.CurrentUserFollowedArtistsAll(context.Background(), &spotigo.FollowedArtistsOptions{Limit: 2})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(artists) != 5 {
+		t.Fatalf("expected 5 artists, got %d", len(artists))
+	}
+	for i, artist := range artists {
+		if expected := fmt.Sprintf("a%d", i+1); artist.ID != expected {
+			t.Errorf("expected artist %s at index %d, got %s", expected, i, artist.ID)
+		}
+	}
+}
+
+func TestCurrentUserFollowedArtistsIterStopsEarly(t *testing.T) {
+	server := followedArtistsServer(t)
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+
+	count := 0
+	for artist, err := range client.CurrentUserFollowedArtistsIter(context.Background(), nil) {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		count++
+		if artist.ID == "a3" {
+			break
+		}
+	}
+
+	if count != 3 {
+		t.Errorf("expected to stop after 3 artists, got %d", count)
+	}
+}
+
+func TestCurrentUserFollowedArtistsAllError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tests.WriteJSONResponse(w, http.StatusForbidden, tests.CreateErrorResponse(403, "Insufficient client scope", ""))
+	}))
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+
+	if _, err := client.CurrentUserFollowedArtistsAll(context.Background(), nil); err == nil {
+		t.Error("expected error from failing endpoint")
+	}
+}
+
+func TestIterateCursorRecentlyPlayed(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/me/player/recently-played" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+
+		if r.URL.Query().Get("before") == "" {
+			tests.WriteJSONResponse(w, http.StatusOK, map[string]interface{}{
+				"items": []map[string]interface{}{
+					{"track": map[string]interface{}{"id": "t1"}, "played_at": "2024-01-01T10:00:00Z"},
+					{"track": map[string]interface{}{"id": "t2"}, "played_at": "2024-01-01T09:00:00Z"},
+				},
+				"next":    fmt.Sprintf("http://%s/me/player/recently-played?before=1704099600000", r.Host),
+				"cursors": map[string]interface{}{"before": "1704099600000"},
+			})
+			return
+		}
+
+		tests.WriteJSONResponse(w, http.StatusOK, map[string]interface{}{
+			"items": []map[string]interface{}{
+				{"track": map[string]interface{}{"id": "t3"}, "played_at": "2024-01-01T08:00:00Z"},
+			},
+			"next": nil,
+		})
+	}))
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+	ctx := context.Background()
+
+	first, err := client.CurrentUserRecentlyPlayed(ctx, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var ids []string
+	for item, err := range spotigo.IterateCursor(client, ctx, first) {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		ids = append(ids, item.Track.ID)
+	}
+
+	if len(ids) != 3 || ids[0] != "t1" || ids[2] != "t3" {
+		t.Errorf("expected [t1 t2 t3], got %v", ids)
+	}
+
+	count := 0
+	for _, err := range client.CurrentUserRecentlyPlayedIter(ctx, nil) {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		count++
+	}
+	if count != 3 {
+		t.Errorf("expected 3 items from CurrentUserRecentlyPlayedIter, got %d", count)
+	}
+}
+
+func TestUserPlaylistsAll(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/users/testuser/playlists" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+
+		var next interface{}
+		items := []map[string]interface{}{{"id": "p3"}}
+		if r.URL.Query().Get("offset") == "" {
+			items = []map[string]interface{}{{"id": "p1"}, {"id": "p2"}}
+			next = fmt.Sprintf("http://%s/users/testuser/playlists?offset=2&limit=2", r.Host)
+		}
+		tests.WriteJSONResponse(w, http.StatusOK, map[string]interface{}{
+			"items": items,
+			"next":  next,
+			"limit": 2,
+			"total": 3,
+		})
+	}))
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+
+	playlists, err := client.UserPlaylistsAll(context.Background(), "testuser", &spotigo.UserPlaylistsOptions{Limit: 2})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(playlists) != 3 || playlists[2].ID != "p3" {
+		t.Errorf("unexpected playlists: %+v", playlists)
+	}
+}
+
+func TestPaginationProgress(t *testing.T) {
+	server := followedArtistsServer(t)
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+
+	var reports []spotigo.PaginationProgress
+	ctx := spotigo.ContextWithPaginationProgress(context.Background(), func(p spotigo.PaginationProgress) {
+		reports = append(reports, p)
+	})
+
+	if _, err := client.CurrentUserFollowedArtistsAll(ctx, &spotigo.FollowedArtistsOptions{Limit: 2}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(reports) != 3 {
+		t.Fatalf("expected 3 progress reports, got %d", len(reports))
+	}
+	for i, want := range []int{2, 4, 5} {
+		if reports[i].Fetched != want || reports[i].Total != 5 || reports[i].Pages != i+1 || reports[i].Offset != -1 {
+			t.Errorf("report %d = %+v", i, reports[i])
+		}
+	}
+	if reports[0].ETA != 0 || reports[2].ETA != 0 {
+		t.Errorf("ETA must be 0 before a second page and after the last: %v, %v", reports[0].ETA, reports[2].ETA)
+	}
+}
+
+func TestPaginationProgressETAIncludesRateLimitWait(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		offset := 0
+		fmt.Sscanf(r.URL.Query().Get("offset"), "%d", &offset)
+
+		var next interface{}
+		if offset+2 < 6 {
+			next = fmt.Sprintf("http://%s/users/testuser/playlists?offset=%d&limit=2", r.Host, offset+2)
+		}
+		// The rate limit budget is exhausted for the next minute
+		w.Header().Set("X-RateLimit-Limit", "100")
+		w.Header().Set("X-RateLimit-Remaining", "0")
+		w.Header().Set("X-RateLimit-Reset", "60")
+		tests.WriteJSONResponse(w, http.StatusOK, map[string]interface{}{
+			"items":  []map[string]interface{}{{"id": fmt.Sprintf("p%d", offset)}, {"id": fmt.Sprintf("p%d", offset+1)}},
+			"next":   next,
+			"offset": offset,
+			"limit":  2,
+			"total":  6,
+		})
+	}))
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+
+	var reports []spotigo.PaginationProgress
+	ctx := spotigo.ContextWithPaginationProgress(context.Background(), func(p spotigo.PaginationProgress) {
+		reports = append(reports, p)
+	})
+
+	if _, err := client.UserPlaylistsAll(ctx, "testuser", &spotigo.UserPlaylistsOptions{Limit: 2}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(reports) != 3 || reports[1].Offset != 2 || reports[2].Fetched != 6 {
+		t.Fatalf("unexpected reports: %+v", reports)
+	}
+	if eta := reports[1].ETA; eta < 50*time.Second || eta > 61*time.Second {
+		t.Errorf("ETA = %v, want about a minute for the rate limit reset", eta)
+	}
+}
+
+func TestReadAheadPagination(t *testing.T) {
+	server := followedArtistsServer(t)
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+
+	ctx := spotigo.ContextWithReadAhead(context.Background(), 2)
+	artists, err := client.CurrentUserFollowedArtistsAll(ctx, &spotigo.FollowedArtistsOptions{Limit: 2})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(artists) != 5 {
+		t.Fatalf("expected 5 artists, got %d", len(artists))
+	}
+	for i, artist := range artists {
+		if expected := fmt.Sprintf("a%d", i+1); artist.ID != expected {
+			t.Errorf("expected artist %s at index %d, got %s", expected, i, artist.ID)
+		}
+	}
+}
+
+func TestReadAheadPrefetchesWhileConsuming(t *testing.T) {
+	fetched := make(chan int, 10)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		offset := 0
+		fmt.Sscanf(r.URL.Query().Get("offset"), "%d", &offset)
+		fetched <- offset
+
+		var next interface{}
+		if offset+1 < 4 {
+			next = fmt.Sprintf("http://%s/users/testuser/playlists?offset=%d&limit=1", r.Host, offset+1)
+		}
+		tests.WriteJSONResponse(w, http.StatusOK, map[string]interface{}{
+			"items":  []map[string]interface{}{{"id": fmt.Sprintf("p%d", offset)}},
+			"next":   next,
+			"offset": offset,
+			"limit":  1,
+			"total":  4,
+		})
+	}))
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+
+	ctx := spotigo.ContextWithReadAhead(context.Background(), 1)
+	page, err := client.UserPlaylists(ctx, "testuser", &spotigo.UserPlaylistsOptions{Limit: 1})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	<-fetched
+
+	for playlist, err := range spotigo.IteratePages(client, ctx, page) {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if playlist.ID != "p0" {
+			t.Fatalf("expected p0 first, got %s", playlist.ID)
+		}
+
+		// The second page is fetched while the first is still being consumed
+		select {
+		case offset := <-fetched:
+			if offset != 1 {
+				t.Errorf("expected the page at offset 1 to be prefetched, got %d", offset)
+			}
+		case <-time.After(2 * time.Second):
+			t.Fatal("next page was not prefetched")
+		}
+		break
+	}
+}
+
+func TestReadAheadError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("offset") != "" {
+			tests.WriteJSONResponse(w, http.StatusForbidden, tests.CreateErrorResponse(403, "Insufficient client scope", ""))
+			return
+		}
+		tests.WriteJSONResponse(w, http.StatusOK, map[string]interface{}{
+			"items": []map[string]interface{}{{"id": "p1"}},
+			"next":  fmt.Sprintf("http://%s/users/testuser/playlists?offset=1&limit=1", r.Host),
+			"limit": 1,
+			"total": 2,
+		})
+	}))
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+
+	ctx := spotigo.ContextWithReadAhead(context.Background(), 3)
+	if _, err := client.UserPlaylistsAll(ctx, "testuser", &spotigo.UserPlaylistsOptions{Limit: 1}); err == nil {
+		t.Error("expected error from failing page")
+	}
+}
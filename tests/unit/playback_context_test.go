@@ -0,0 +1,66 @@
+package unit
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sv4u/spotigo"
+	"github.com/sv4u/spotigo/tests"
+)
+
+func TestExpandContext(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/playlists/37i9dQZF1DXcBWIGoYBM5M":
+			if fields := r.URL.Query().Get("fields"); fields == "" {
+				t.Error("expected playlist to be fetched without its items")
+			}
+			tests.WriteJSONResponse(w, http.StatusOK, map[string]interface{}{
+				"id": "37i9dQZF1DXcBWIGoYBM5M", "name": "Today's Top Hits",
+				"images": []map[string]interface{}{{"url": "https://i.scdn.co/image/cover"}},
+			})
+		case "/albums/4aawyAB9vmqN3uQ7FjRGTy":
+			tests.WriteJSONResponse(w, http.StatusOK, map[string]interface{}{"id": "4aawyAB9vmqN3uQ7FjRGTy", "name": "Global Warming"})
+		case "/shows/5CfCWKI5pZ28U0uOzXkDHe":
+			tests.WriteJSONResponse(w, http.StatusOK, map[string]interface{}{"id": "5CfCWKI5pZ28U0uOzXkDHe", "name": "The Daily"})
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+	ctx := context.Background()
+
+	for _, tc := range []struct {
+		context  *spotigo.Context
+		wantType string
+		wantName string
+	}{
+		{&spotigo.Context{Type: "playlist", URI: "spotify:playlist:37i9dQZF1DXcBWIGoYBM5M"}, "playlist", "Today's Top Hits"},
+		{&spotigo.Context{URI: "spotify:user:spotify:playlist:37i9dQZF1DXcBWIGoYBM5M"}, "playlist", "Today's Top Hits"},
+		{&spotigo.Context{Type: "album", URI: "spotify:album:4aawyAB9vmqN3uQ7FjRGTy"}, "album", "Global Warming"},
+		{&spotigo.Context{Type: "show", URI: "spotify:show:5CfCWKI5pZ28U0uOzXkDHe"}, "show", "The Daily"},
+		{&spotigo.Context{Type: "playlist", URI: "spotify:user:alice:collection"}, "collection", "Liked Songs"},
+	} {
+		expanded, err := client.ExpandContext(ctx, &spotigo.PlaybackState{Context: tc.context})
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", tc.context.URI, err)
+		}
+		if expanded.Type != tc.wantType || expanded.Name() != tc.wantName || expanded.URI != tc.context.URI {
+			t.Errorf("%s: got type %q name %q", tc.context.URI, expanded.Type, expanded.Name())
+		}
+	}
+
+	expanded, err := client.ExpandContext(ctx, &spotigo.PlaybackState{Context: &spotigo.Context{Type: "playlist", URI: "spotify:playlist:37i9dQZF1DXcBWIGoYBM5M"}})
+	if err != nil || expanded.Playlist == nil || len(expanded.Images()) != 1 {
+		t.Errorf("expected the playlist and its images, got %+v, %v", expanded, err)
+	}
+
+	if expanded, err := client.ExpandContext(ctx, &spotigo.PlaybackState{}); expanded != nil || err != nil {
+		t.Errorf("expected nil for playback without a context, got %+v, %v", expanded, err)
+	}
+}
@@ -0,0 +1,174 @@
+package unit
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/sv4u/spotigo"
+)
+
+// playbackSequence serves a fixed sequence of playback states, repeating the last
+type playbackSequence struct {
+	mu     sync.Mutex
+	states []string
+}
+
+func (p *playbackSequence) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	state := p.states[0]
+	if len(p.states) > 1 {
+		p.states = p.states[1:]
+	}
+	if state == "" {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write([]byte(state))
+}
+
+// countRequests wraps handler, counting the requests it serves
+func countRequests(handler http.Handler, count *atomic.Int32) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		count.Add(1)
+		handler.ServeHTTP(w, r)
+	})
+}
+
+// expectNoMorePolls fails if the server sees more than want requests in the
+// next 200ms. Watchers given a zero interval must wait the default interval
+// (seconds) before polling again, not spin or poll at a short interval.
+func expectNoMorePolls(t *testing.T, count *atomic.Int32, want int32) {
+	t.Helper()
+	time.Sleep(200 * time.Millisecond)
+	if got := count.Load(); got != want {
+		t.Errorf("expected %d requests before the default interval, got %d", want, got)
+	}
+}
+
+func TestWatchPlayback(t *testing.T) {
+	playing := `{"device": {"id": "dev1"}, "is_playing": true, "progress_ms": 1000, "item": {"id": "t1"}}`
+	server := httptest.NewServer(&playbackSequence{states: []string{
+		playing,
+		playing, // Unchanged apart from progress: no event
+		`{"device": {"id": "dev1"}, "is_playing": false, "progress_ms": 1000, "item": {"id": "t1"}}`,
+		`{"device": {"id": "dev2"}, "is_playing": false, "progress_ms": 90000, "item": {"id": "t1"}}`,
+		`{"device": {"id": "dev2"}, "is_playing": false, "progress_ms": 0, "item": {"id": "t2"}}`,
+		"",
+	}})
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	events := client.WatchPlayback(ctx, 10*time.Millisecond)
+	var got []spotigo.PlaybackEvent
+	for event := range events {
+		if event.Err != nil {
+			t.Fatalf("unexpected error: %v", event.Err)
+		}
+		got = append(got, event)
+		if len(got) == 5 {
+			cancel()
+		}
+	}
+
+	if len(got) != 5 {
+		t.Fatalf("got %d events, want 5", len(got))
+	}
+	if !got[0].ItemChanged || got[0].State == nil {
+		t.Errorf("first event = %+v", got[0])
+	}
+	if !got[1].PlayingChanged || got[1].ItemChanged {
+		t.Errorf("pause event = %+v", got[1])
+	}
+	if !got[2].DeviceChanged || !got[2].Seeked {
+		t.Errorf("transfer event = %+v", got[2])
+	}
+	if !got[3].ItemChanged || got[3].Seeked {
+		t.Errorf("next item event = %+v", got[3])
+	}
+	if !got[4].ItemChanged || got[4].State != nil {
+		t.Errorf("stopped event = %+v", got[4])
+	}
+}
+
+func TestWatchPlaybackDefaultInterval(t *testing.T) {
+	var polls atomic.Int32
+	server := httptest.NewServer(countRequests(&playbackSequence{states: []string{
+		`{"device": {"id": "dev1"}, "is_playing": true, "progress_ms": 1000, "item": {"id": "t1"}}`,
+	}}, &polls))
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	// A zero interval falls back to the default instead of panicking
+	event := <-client.WatchPlayback(ctx, 0)
+	if event.Err != nil {
+		t.Fatalf("unexpected error: %v", event.Err)
+	}
+	if !event.ItemChanged || event.State == nil {
+		t.Errorf("first event = %+v", event)
+	}
+	expectNoMorePolls(t, &polls, 1)
+}
+
+func TestPlaybackEventsHandler(t *testing.T) {
+	api := httptest.NewServer(&playbackSequence{states: []string{
+		`{"device": {"id": "dev1"}, "is_playing": true, "progress_ms": 1000, "item": {"id": "t1", "name": "Song"}}`,
+	}})
+	defer api.Close()
+
+	client := newPlayerTestClient(t, api)
+	events := httptest.NewServer(client.PlaybackEventsHandler(10 * time.Millisecond))
+	defer events.Close()
+
+	// Two subscribers share the poller and both receive the event
+	for i := 0; i < 2; i++ {
+		resp, err := http.Get(events.URL)
+		if err != nil {
+			t.Fatalf("subscribe failed: %v", err)
+		}
+		defer resp.Body.Close()
+
+		if ct := resp.Header.Get("Content-Type"); ct != "text/event-stream" {
+			t.Errorf("Content-Type = %q", ct)
+		}
+
+		scanner := bufio.NewScanner(resp.Body)
+		var name, data string
+		for scanner.Scan() && data == "" {
+			line := scanner.Text()
+			if v, ok := strings.CutPrefix(line, "event: "); ok {
+				name = v
+			}
+			if v, ok := strings.CutPrefix(line, "data: "); ok {
+				data = v
+			}
+		}
+		if name != "playback" {
+			t.Errorf("event name = %q", name)
+		}
+
+		var event spotigo.PlaybackEvent
+		if err := json.Unmarshal([]byte(data), &event); err != nil {
+			t.Fatalf("invalid event data %q: %v", data, err)
+		}
+		if event.State == nil || !event.State.IsPlaying {
+			t.Errorf("event = %+v", event)
+		}
+	}
+}
@@ -0,0 +1,293 @@
+package unit
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sv4u/spotigo"
+	"github.com/sv4u/spotigo/tests"
+)
+
+// newPlayerTestClient creates a client pointed at the given test server
+func newPlayerTestClient(t *testing.T, server *httptest.Server) *spotigo.Client {
+	t.Helper()
+
+	auth := &tests.MockAuthManager{
+		Token: &spotigo.TokenInfo{
+			AccessToken: "test_token",
+			TokenType:   "Bearer",
+		},
+	}
+
+	client, err := spotigo.NewClient(auth)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	client.APIPrefix = server.URL + "/"
+	return client
+}
+
+// TestPlayTracks tests that PlayTracks converts IDs to URIs
+func TestPlayTracks(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/me/player/play" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("failed to decode request body: %v", err)
+		}
+
+		uris, ok := body["uris"].([]interface{})
+		if !ok || len(uris) != 2 {
+			t.Fatalf("expected 2 uris, got %v", body["uris"])
+		}
+		if uris[0] != "spotify:track:6b2oQwSGFkzsMtQruIWm2p" {
+			t.Errorf("expected track URI, got %v", uris[0])
+		}
+		if uris[1] != "spotify:episode:512ojhOuo1ktJprKbVcKyQ" {
+			t.Errorf("expected episode URI, got %v", uris[1])
+		}
+		if _, ok := body["context_uri"]; ok {
+			t.Error("expected no context_uri")
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+
+	err := client.PlayTracks(context.Background(),
+		"6b2oQwSGFkzsMtQruIWm2p",
+		"https://open.spotify.com/episode/512ojhOuo1ktJprKbVcKyQ",
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+// TestPlayTracksRequiresIDs tests that PlayTracks rejects an empty list
+func TestPlayTracksRequiresIDs(t *testing.T) {
+	client, err := spotigo.NewClient(&tests.MockAuthManager{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := client.PlayTracks(context.Background()); err == nil {
+		t.Error("expected error for empty track list")
+	}
+}
+
+// TestPlayContext tests that PlayContext sends context_uri and offset position
+func TestPlayContext(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("failed to decode request body: %v", err)
+		}
+
+		if body["context_uri"] != "spotify:album:04xe676vyiTeYNXw15o9jT" {
+			t.Errorf("unexpected context_uri: %v", body["context_uri"])
+		}
+		offset, ok := body["offset"].(map[string]interface{})
+		if !ok {
+			t.Fatal("expected offset object")
+		}
+		if offset["position"] != float64(2) {
+			t.Errorf("expected offset position 2, got %v", offset["position"])
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+
+	err := client.PlayContext(context.Background(), "https://open.spotify.com/album/04xe676vyiTeYNXw15o9jT", 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+// TestPlayContextValidation tests PlayContext input validation
+func TestPlayContextValidation(t *testing.T) {
+	client, err := spotigo.NewClient(&tests.MockAuthManager{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	ctx := context.Background()
+	if err := client.PlayContext(ctx, "", 0); err == nil {
+		t.Error("expected error for empty context URI")
+	}
+	if err := client.PlayContext(ctx, "spotify:track:6b2oQwSGFkzsMtQruIWm2p", 0); err == nil {
+		t.Error("expected error for track used as context")
+	}
+	if err := client.PlayContext(ctx, "spotify:album:04xe676vyiTeYNXw15o9jT", -1); err == nil {
+		t.Error("expected error for negative offset")
+	}
+	if err := client.PlayContext(ctx, "spotify:artist:3jOstUTkEu2JkjvRdBA5Gu", 3); err == nil {
+		t.Error("expected error for offset on artist context")
+	}
+}
+
+// TestPlayContextFromURI tests that PlayContextFromURI sends an offset URI
+func TestPlayContextFromURI(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("failed to decode request body: %v", err)
+		}
+
+		if body["context_uri"] != "spotify:playlist:2oCEWyyAPbZp9xhVSxZavx" {
+			t.Errorf("unexpected context_uri: %v", body["context_uri"])
+		}
+		offset, ok := body["offset"].(map[string]interface{})
+		if !ok {
+			t.Fatal("expected offset object")
+		}
+		if offset["uri"] != "spotify:track:6b2oQwSGFkzsMtQruIWm2p" {
+			t.Errorf("unexpected offset uri: %v", offset["uri"])
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+
+	err := client.PlayContextFromURI(context.Background(), "spotify:playlist:2oCEWyyAPbZp9xhVSxZavx", "6b2oQwSGFkzsMtQruIWm2p")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+// TestStartPlaybackMutuallyExclusiveFields tests validation of StartPlaybackOptions
+func TestStartPlaybackMutuallyExclusiveFields(t *testing.T) {
+	client, err := spotigo.NewClient(&tests.MockAuthManager{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	ctx := context.Background()
+	err = client.CurrentUserStartPlayback(ctx, &spotigo.StartPlaybackOptions{
+		ContextURI: "spotify:album:04xe676vyiTeYNXw15o9jT",
+		URIs:       []string{"spotify:track:6b2oQwSGFkzsMtQruIWm2p"},
+	})
+	if err == nil {
+		t.Error("expected error when both ContextURI and URIs are set")
+	}
+
+	err = client.CurrentUserStartPlayback(ctx, &spotigo.StartPlaybackOptions{
+		Offset: map[string]interface{}{"position": 1},
+	})
+	if err == nil {
+		t.Error("expected error when Offset is set without a context")
+	}
+}
+
+// queueServer serves a two-page playlist and an album and records queued URIs
+func queueServer(t *testing.T, queued *[]string) *httptest.Server {
+	t.Helper()
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/me/player/queue":
+			*queued = append(*queued, r.URL.Query().Get("uri"))
+			w.WriteHeader(http.StatusNoContent)
+		case "/playlists/37i9dQZF1DXcBWIGoYBM5M/tracks":
+			if r.URL.Query().Get("offset") == "" {
+				json.NewEncoder(w).Encode(map[string]interface{}{
+					"items": []interface{}{
+						map[string]interface{}{"track": map[string]string{"uri": "spotify:track:p1"}},
+						map[string]interface{}{"is_local": true, "track": map[string]string{"uri": "spotify:local:x"}},
+						map[string]interface{}{"track": nil},
+					},
+					"next": "http://" + r.Host + "/playlists/37i9dQZF1DXcBWIGoYBM5M/tracks?offset=3",
+				})
+				return
+			}
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"items": []interface{}{
+					map[string]interface{}{"track": map[string]string{"uri": "spotify:episode:e1"}},
+					map[string]interface{}{"track": map[string]string{"uri": "spotify:track:p2"}},
+				},
+			})
+		case "/albums/04xe676vyiTeYNXw15o9jT/tracks":
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"items": []interface{}{
+					map[string]string{"uri": "spotify:track:a1"},
+					map[string]string{"uri": "spotify:track:a2"},
+					map[string]string{"uri": "spotify:track:a3"},
+				},
+			})
+		default:
+			t.Errorf("unexpected request: %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func TestCurrentUserAddContextToQueue(t *testing.T) {
+	var queued []string
+	server := queueServer(t, &queued)
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+	ctx := context.Background()
+
+	n, err := client.CurrentUserAddContextToQueue(ctx, "spotify:playlist:37i9dQZF1DXcBWIGoYBM5M", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []string{"spotify:track:p1", "spotify:episode:e1", "spotify:track:p2"}
+	if n != 3 || len(queued) != 3 || queued[0] != want[0] || queued[1] != want[1] || queued[2] != want[2] {
+		t.Errorf("queued %d: %v, want %v", n, queued, want)
+	}
+
+	queued = nil
+	n, err = client.CurrentUserAddContextToQueue(ctx, "https://open.spotify.com/album/04xe676vyiTeYNXw15o9jT",
+		&spotigo.AddContextToQueueOptions{Limit: 2})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if n != 2 || len(queued) != 2 || queued[0] != "spotify:track:a1" || queued[1] != "spotify:track:a2" {
+		t.Errorf("queued %d: %v", n, queued)
+	}
+
+	queued = nil
+	n, err = client.CurrentUserAddContextToQueue(ctx, "spotify:album:04xe676vyiTeYNXw15o9jT",
+		&spotigo.AddContextToQueueOptions{Shuffle: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	seen := map[string]bool{}
+	for _, uri := range queued {
+		seen[uri] = true
+	}
+	if n != 3 || len(seen) != 3 {
+		t.Errorf("shuffled queue = %v", queued)
+	}
+}
+
+func TestCurrentUserAddContextToQueueValidation(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+
+	if _, err := client.CurrentUserAddContextToQueue(context.Background(), "spotify:artist:0TnOYISbd1XYRBk9myaseg", nil); err == nil {
+		t.Error("expected error for artist context")
+	}
+	if _, err := client.CurrentUserAddContextToQueue(context.Background(), "spotify:album:04xe676vyiTeYNXw15o9jT",
+		&spotigo.AddContextToQueueOptions{Limit: -1}); err == nil {
+		t.Error("expected error for negative limit")
+	}
+}
@@ -0,0 +1,57 @@
+package unit
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sv4u/spotigo/tests"
+)
+
+func TestPlaylistIfChanged(t *testing.T) {
+	snapshot := "s1"
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fields := r.URL.Query().Get("fields")
+		requests = append(requests, fields)
+		if fields == "snapshot_id" {
+			tests.WriteJSONResponse(w, http.StatusOK, map[string]interface{}{"snapshot_id": snapshot})
+			return
+		}
+		tests.WriteJSONResponse(w, http.StatusOK, map[string]interface{}{"id": "37i9dQZF1DXcBWIGoYBM5M", "name": "Hits", "snapshot_id": snapshot})
+	}))
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+	ctx := context.Background()
+
+	// Unchanged: only the probe is sent
+	playlist, changed, err := client.PlaylistIfChanged(ctx, "37i9dQZF1DXcBWIGoYBM5M", "s1")
+	if err != nil || changed || playlist != nil {
+		t.Fatalf("expected (nil, false, nil), got (%v, %v, %v)", playlist, changed, err)
+	}
+	if len(requests) != 1 {
+		t.Errorf("expected only the probe, got %q", requests)
+	}
+
+	// Changed: the probe is followed by a full read
+	snapshot = "s2"
+	requests = nil
+	playlist, changed, err = client.PlaylistIfChanged(ctx, "37i9dQZF1DXcBWIGoYBM5M", "s1")
+	if err != nil || !changed || playlist == nil || playlist.Name != "Hits" || playlist.SnapshotID != "s2" {
+		t.Fatalf("expected the changed playlist, got (%+v, %v, %v)", playlist, changed, err)
+	}
+	if len(requests) != 2 || requests[1] != "" {
+		t.Errorf("expected a probe and a full read, got %q", requests)
+	}
+
+	// No known snapshot: read straight away
+	requests = nil
+	if _, changed, err := client.PlaylistIfChanged(ctx, "37i9dQZF1DXcBWIGoYBM5M", ""); err != nil || !changed {
+		t.Fatalf("expected a full read, got (%v, %v)", changed, err)
+	}
+	if len(requests) != 1 || requests[0] != "" {
+		t.Errorf("expected a single full read, got %q", requests)
+	}
+}
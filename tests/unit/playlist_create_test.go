@@ -0,0 +1,180 @@
+package unit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"image/png"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/sv4u/spotigo"
+	"github.com/sv4u/spotigo/tests"
+)
+
+// createPlaylistServer serves playlist creation for user alice, failing item
+// adds after failAfter batches (0 = never), and records the requests made
+func createPlaylistServer(t *testing.T, failAfter int, requests *[]string) *httptest.Server {
+	t.Helper()
+
+	adds := 0
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*requests = append(*requests, r.Method+" "+r.URL.Path)
+		switch {
+		case r.URL.Path == "/me":
+			tests.WriteJSONResponse(w, http.StatusOK, map[string]interface{}{"id": "alice"})
+		case r.Method == http.MethodPost && r.URL.Path == "/users/alice/playlists":
+			var body map[string]interface{}
+			json.NewDecoder(r.Body).Decode(&body)
+			if body["name"] != "Road Trip" || body["description"] != "Songs for the drive" || len(body) != 2 {
+				t.Errorf("unexpected create body %v", body)
+			}
+			tests.WriteJSONResponse(w, http.StatusCreated, map[string]interface{}{"id": "2oCEWyyAPbZp9xhVSxZavx", "name": "Road Trip", "snapshot_id": "s0"})
+		case r.Method == http.MethodPost && r.URL.Path == "/playlists/2oCEWyyAPbZp9xhVSxZavx/tracks":
+			adds++
+			if failAfter > 0 && adds > failAfter {
+				tests.WriteJSONResponse(w, http.StatusInternalServerError, tests.CreateErrorResponse(500, "Server error", ""))
+				return
+			}
+			var body spotigo.PlaylistAddItemsRequest
+			json.NewDecoder(r.Body).Decode(&body)
+			if len(body.URIs) > 100 {
+				t.Errorf("expected batches of at most 100, got %d", len(body.URIs))
+			}
+			tests.WriteJSONResponse(w, http.StatusCreated, map[string]interface{}{"snapshot_id": "s" + strings.Repeat("1", adds)})
+		case r.Method == http.MethodPut && r.URL.Path == "/playlists/2oCEWyyAPbZp9xhVSxZavx/images":
+			io.Copy(io.Discard, r.Body)
+			w.WriteHeader(http.StatusAccepted)
+		case r.Method == http.MethodDelete && r.URL.Path == "/playlists/2oCEWyyAPbZp9xhVSxZavx/followers":
+			w.WriteHeader(http.StatusOK)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+}
+
+func roadTripOptions(t *testing.T, tracks int) *spotigo.CreatePlaylistOptions {
+	t.Helper()
+
+	var cover bytes.Buffer
+	if err := png.Encode(&cover, noiseImage(32, 32)); err != nil {
+		t.Fatal(err)
+	}
+	opts := &spotigo.CreatePlaylistOptions{Name: "Road Trip", Description: "Songs for the <b>drive</b>\n", CoverImage: cover.Bytes()}
+	for i := 0; i < tracks; i++ {
+		opts.InitialTracks = append(opts.InitialTracks, "spotify:track:"+base62ID("t", i))
+	}
+	return opts
+}
+
+func TestCreatePlaylist(t *testing.T) {
+	var requests []string
+	server := createPlaylistServer(t, 0, &requests)
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+
+	playlist, err := client.CreatePlaylist(context.Background(), roadTripOptions(t, 250))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if playlist.ID != "2oCEWyyAPbZp9xhVSxZavx" || playlist.SnapshotID != "s111" {
+		t.Errorf("expected the snapshot of the last add, got %+v", playlist)
+	}
+
+	want := []string{
+		"GET /me",
+		"POST /users/alice/playlists",
+		"POST /playlists/2oCEWyyAPbZp9xhVSxZavx/tracks",
+		"POST /playlists/2oCEWyyAPbZp9xhVSxZavx/tracks",
+		"POST /playlists/2oCEWyyAPbZp9xhVSxZavx/tracks",
+		"PUT /playlists/2oCEWyyAPbZp9xhVSxZavx/images",
+	}
+	if strings.Join(requests, "\n") != strings.Join(want, "\n") {
+		t.Errorf("unexpected requests:\n%s", strings.Join(requests, "\n"))
+	}
+}
+
+func TestCreatePlaylistPartialFailure(t *testing.T) {
+	var requests []string
+	server := createPlaylistServer(t, 1, &requests)
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+	client.RetryConfig.MaxRetries = 0
+
+	// Without rollback, the half-filled playlist is returned with the error
+	playlist, err := client.CreatePlaylist(context.Background(), roadTripOptions(t, 150))
+	if err == nil || playlist == nil || playlist.ID != "2oCEWyyAPbZp9xhVSxZavx" {
+		t.Fatalf("expected the playlist and an error, got %v, %v", playlist, err)
+	}
+	var spotifyErr *spotigo.SpotifyError
+	if !strings.Contains(err.Error(), "adding items 100-149") {
+		t.Errorf("expected the failing batch in the error, got %v", err)
+	}
+	for _, request := range requests {
+		if strings.HasPrefix(request, "DELETE") {
+			t.Error("expected no rollback")
+		}
+	}
+
+	// With rollback, the playlist is unfollowed
+	requests = nil
+	opts := roadTripOptions(t, 150)
+	opts.RollbackOnFailure = true
+	playlist, err = client.CreatePlaylist(context.Background(), opts)
+	if playlist != nil || !errors.As(err, &spotifyErr) {
+		t.Fatalf("expected only the add error after rolling back, got %v, %v", playlist, err)
+	}
+	if last := requests[len(requests)-1]; last != "DELETE /playlists/2oCEWyyAPbZp9xhVSxZavx/followers" {
+		t.Errorf("expected the playlist to be unfollowed, last request was %s", last)
+	}
+}
+
+func TestDeleteOwnPlaylist(t *testing.T) {
+	var deleted []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/me":
+			tests.WriteJSONResponse(w, http.StatusOK, map[string]interface{}{"id": "alice"})
+		case r.Method == http.MethodGet && strings.HasPrefix(r.URL.Path, "/playlists/"):
+			if fields := r.URL.Query().Get("fields"); fields != "id,owner(id)" {
+				t.Errorf("expected only the owner to be fetched, got fields %q", fields)
+			}
+			owner := "alice"
+			if r.URL.Path == "/playlists/37i9dQZF1DXcBWIGoYBM5M" {
+				owner = "spotify"
+			}
+			tests.WriteJSONResponse(w, http.StatusOK, map[string]interface{}{"id": strings.TrimPrefix(r.URL.Path, "/playlists/"), "owner": map[string]interface{}{"id": owner}})
+		case r.Method == http.MethodDelete:
+			deleted = append(deleted, r.URL.Path)
+			w.WriteHeader(http.StatusOK)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+
+	if err := client.DeleteOwnPlaylist(context.Background(), "spotify:playlist:2oCEWyyAPbZp9xhVSxZavx"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	err := client.DeleteOwnPlaylist(context.Background(), "https://open.spotify.com/playlist/37i9dQZF1DXcBWIGoYBM5M")
+	if !errors.Is(err, spotigo.ErrNotPlaylistOwner) {
+		t.Fatalf("expected ErrNotPlaylistOwner, got %v", err)
+	}
+	var notOwner *spotigo.NotPlaylistOwnerError
+	if !errors.As(err, &notOwner) || notOwner.OwnerID != "spotify" || notOwner.UserID != "alice" {
+		t.Errorf("unexpected error details: %+v", notOwner)
+	}
+
+	if len(deleted) != 1 || deleted[0] != "/playlists/2oCEWyyAPbZp9xhVSxZavx/followers" {
+		t.Errorf("expected only the owned playlist to be unfollowed, got %v", deleted)
+	}
+}
@@ -0,0 +1,64 @@
+package unit
+
+import (
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/sv4u/spotigo"
+)
+
+func TestRenderPlaylistDescription(t *testing.T) {
+	vars := spotigo.DescriptionVars{
+		Date:       time.Date(2024, 3, 9, 12, 0, 0, 0, time.UTC),
+		TrackCount: 42,
+		Generator:  "weekly-mix",
+		Extra:      map[string]string{"mood": "chill"},
+	}
+
+	desc, err := spotigo.RenderPlaylistDescription("{track_count} {mood} tracks, updated {date} by {generator}", vars)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := "42 chill tracks, updated 2024-03-09 by weekly-mix"
+	if desc != expected {
+		t.Errorf("expected %q, got %q", expected, desc)
+	}
+}
+
+func TestRenderPlaylistDescriptionUnknownPlaceholder(t *testing.T) {
+	_, err := spotigo.RenderPlaylistDescription("made by {author}", spotigo.DescriptionVars{})
+	if err == nil {
+		t.Fatal("expected error for unknown placeholder")
+	}
+	if !strings.Contains(err.Error(), "{author}") {
+		t.Errorf("expected error to name placeholder, got %q", err.Error())
+	}
+}
+
+func TestSanitizePlaylistDescription(t *testing.T) {
+	desc := spotigo.SanitizePlaylistDescription("line one\nline <b>two</b>")
+	if desc != "line one line two" {
+		t.Errorf("unexpected sanitized description: %q", desc)
+	}
+	if desc := spotigo.SanitizePlaylistDescription(`<a href="https://example.com">link</a> 1 < 2 > 0`); desc != "link 1  2  0" {
+		t.Errorf("unexpected sanitized description: %q", desc)
+	}
+
+	long := strings.Repeat("é", spotigo.MaxPlaylistDescriptionLength+50)
+	truncated := spotigo.SanitizePlaylistDescription(long)
+	if n := utf8.RuneCountInString(truncated); n != spotigo.MaxPlaylistDescriptionLength {
+		t.Errorf("expected %d characters, got %d", spotigo.MaxPlaylistDescriptionLength, n)
+	}
+	if !strings.HasSuffix(truncated, "…") {
+		t.Errorf("expected ellipsis suffix, got %q", truncated[len(truncated)-8:])
+	}
+}
+
+func TestUnescapePlaylistDescription(t *testing.T) {
+	if got := spotigo.UnescapePlaylistDescription("Rock &amp; Roll &#x27;70s"); got != "Rock & Roll '70s" {
+		t.Errorf("unexpected unescaped description: %q", got)
+	}
+}
@@ -0,0 +1,160 @@
+package unit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/sv4u/spotigo"
+)
+
+// idempotentPlaylistServer serves a playlist whose add endpoint fails with
+// failStatus for the first failures requests, appending the items anyway
+// when landOnFailure is set
+type idempotentPlaylistServer struct {
+	mu            sync.Mutex
+	items         []string
+	posts         int
+	failures      int
+	failStatus    int
+	landOnFailure bool
+}
+
+func (s *idempotentPlaylistServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	snapshot := fmt.Sprintf("snap%d", len(s.items))
+	w.Header().Set("Content-Type", "application/json")
+	switch {
+	case r.Method == http.MethodPost && r.URL.Path == "/playlists/3cEYpjA9oz9GiPac4AsH4n/tracks":
+		s.posts++
+		var body struct {
+			URIs []string `json:"uris"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		if s.posts <= s.failures {
+			if s.landOnFailure {
+				s.items = append(s.items, body.URIs...)
+			}
+			w.WriteHeader(s.failStatus)
+			json.NewEncoder(w).Encode(map[string]interface{}{"error": map[string]interface{}{"status": s.failStatus, "message": "upstream"}})
+			return
+		}
+		s.items = append(s.items, body.URIs...)
+		json.NewEncoder(w).Encode(map[string]string{"snapshot_id": fmt.Sprintf("snap%d", len(s.items))})
+	case r.URL.Path == "/playlists/3cEYpjA9oz9GiPac4AsH4n/tracks":
+		var offset, limit int
+		fmt.Sscanf(r.URL.Query().Get("offset"), "%d", &offset)
+		fmt.Sscanf(r.URL.Query().Get("limit"), "%d", &limit)
+		items := []map[string]interface{}{}
+		for i := offset; i < len(s.items) && i < offset+limit; i++ {
+			items = append(items, map[string]interface{}{"track": map[string]string{"uri": s.items[i]}})
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"items": items, "total": len(s.items)})
+	case r.URL.Path == "/playlists/3cEYpjA9oz9GiPac4AsH4n":
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"snapshot_id": snapshot,
+			"tracks":      map[string]int{"total": len(s.items)},
+		})
+	default:
+		http.Error(w, fmt.Sprintf("unexpected request %s %s", r.Method, r.URL.Path), http.StatusNotFound)
+	}
+}
+
+func TestPlaylistAddItemsIdempotent(t *testing.T) {
+	const playlistID = "3cEYpjA9oz9GiPac4AsH4n"
+	uris := []string{"spotify:track:" + base62ID("t", 1), "spotify:episode:" + base62ID("e", 2)}
+
+	tests := []struct {
+		name          string
+		failures      int
+		landOnFailure bool
+		wantPosts     int
+	}{
+		{name: "succeeds first time", wantPosts: 1},
+		{name: "landed despite 502", failures: 1, landOnFailure: true, wantPosts: 1},
+		{name: "retried after 502 that did not land", failures: 1, wantPosts: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			playlist := &idempotentPlaylistServer{
+				items:         []string{"spotify:track:" + base62ID("x", 0)},
+				failures:      tt.failures,
+				failStatus:    http.StatusBadGateway,
+				landOnFailure: tt.landOnFailure,
+			}
+			server := httptest.NewServer(playlist)
+			defer server.Close()
+
+			client := newPlayerTestClient(t, server)
+			client.RetryConfig.BackoffFactor = 0
+
+			snapshot, err := client.PlaylistAddItemsIdempotent(context.Background(), playlistID, uris,
+				&spotigo.IdempotentAddOptions{ExpectedSnapshotID: "snap1"})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if snapshot.SnapshotID != "snap3" {
+				t.Errorf("expected snapshot snap3, got %q", snapshot.SnapshotID)
+			}
+			if playlist.posts != tt.wantPosts {
+				t.Errorf("expected %d add requests, got %d", tt.wantPosts, playlist.posts)
+			}
+			if len(playlist.items) != 3 {
+				t.Errorf("expected items to be added once, playlist has %v", playlist.items)
+			}
+		})
+	}
+}
+
+func TestPlaylistAddItemsIdempotentSnapshotMismatch(t *testing.T) {
+	playlist := &idempotentPlaylistServer{items: []string{"spotify:track:" + base62ID("x", 0)}}
+	server := httptest.NewServer(playlist)
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+	_, err := client.PlaylistAddItemsIdempotent(context.Background(), "3cEYpjA9oz9GiPac4AsH4n",
+		[]string{base62ID("t", 1)}, &spotigo.IdempotentAddOptions{ExpectedSnapshotID: "snap0"})
+
+	var mismatch *spotigo.SnapshotMismatchError
+	if !errors.As(err, &mismatch) || !errors.Is(err, spotigo.ErrSnapshotMismatch) {
+		t.Fatalf("expected SnapshotMismatchError, got %v", err)
+	}
+	if mismatch.Expected != "snap0" || mismatch.Actual != "snap1" {
+		t.Errorf("unexpected mismatch: %+v", mismatch)
+	}
+	if playlist.posts != 0 {
+		t.Errorf("expected no add request, got %d", playlist.posts)
+	}
+}
+
+func TestPlaylistAddItemsIdempotentConcurrentChange(t *testing.T) {
+	playlist := &idempotentPlaylistServer{failures: 1, failStatus: http.StatusServiceUnavailable}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Someone else adds an item while the add request fails
+		if r.Method == http.MethodPost {
+			playlist.mu.Lock()
+			playlist.items = append(playlist.items, "spotify:track:"+base62ID("o", 9))
+			playlist.mu.Unlock()
+		}
+		playlist.ServeHTTP(w, r)
+	}))
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+	_, err := client.PlaylistAddItemsIdempotent(context.Background(), "3cEYpjA9oz9GiPac4AsH4n",
+		[]string{base62ID("t", 1), base62ID("t", 2)}, nil)
+	if err == nil {
+		t.Fatal("expected an error when the outcome cannot be determined")
+	}
+	if playlist.posts != 1 {
+		t.Errorf("expected no blind retry, got %d add requests", playlist.posts)
+	}
+}
@@ -0,0 +1,89 @@
+package unit
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/sv4u/spotigo"
+)
+
+// decodedPlaylistItems returns playlist items as PlaylistTracks decodes them
+func decodedPlaylistItems(t *testing.T) []spotigo.PlaylistTrack {
+	t.Helper()
+	var page spotigo.Paging[spotigo.PlaylistTrack]
+	err := json.Unmarshal([]byte(`{"items": [
+		{"track": {"id": "6LgJvl0Xdtc73RJ1mmpotq", "uri": "spotify:track:6LgJvl0Xdtc73RJ1mmpotq", "type": "track", "name": "Song"}},
+		{"track": {"id": "512ojhOuo1ktJprKbVcKyQ", "uri": "spotify:episode:512ojhOuo1ktJprKbVcKyQ", "type": "episode", "name": "Episode"}},
+		{"is_local": true, "track": {"uri": "spotify:local:Artist:Album:Demo:180", "name": "Demo", "is_local": true}},
+		{"track": null}
+	]}`), &page)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return page.Items
+}
+
+// TestPlaylistItemKinds tests classifying decoded playlist items
+func TestPlaylistItemKinds(t *testing.T) {
+	items := decodedPlaylistItems(t)
+	want := []spotigo.PlaylistItemKind{
+		spotigo.PlaylistItemTrack,
+		spotigo.PlaylistItemEpisode,
+		spotigo.PlaylistItemLocal,
+		spotigo.PlaylistItemUnavailable,
+	}
+	for i, item := range items {
+		if got := item.Kind(); got != want[i] {
+			t.Errorf("item %d: kind = %s, want %s", i, got, want[i])
+		}
+	}
+
+	if got := (spotigo.PlaylistItemTrack | spotigo.PlaylistItemLocal).String(); got != "track|local" {
+		t.Errorf("unexpected kind set name: %s", got)
+	}
+}
+
+// TestFilterPlaylistItems tests filtering items by kind
+func TestFilterPlaylistItems(t *testing.T) {
+	items := decodedPlaylistItems(t)
+
+	tracks := spotigo.FilterPlaylistItems(items, spotigo.PlaylistItemTrack)
+	if len(tracks) != 1 {
+		t.Fatalf("expected 1 track, got %d", len(tracks))
+	}
+	track, ok := tracks[0].AsTrack()
+	if !ok || track.ID != "6LgJvl0Xdtc73RJ1mmpotq" || track.IsLocal {
+		t.Errorf("unexpected track: %+v", track)
+	}
+
+	playable := spotigo.FilterPlaylistItems(items, spotigo.PlaylistItemTrack|spotigo.PlaylistItemEpisode)
+	if len(playable) != 2 {
+		t.Errorf("expected 2 playable items, got %d", len(playable))
+	}
+	if len(items) != 4 {
+		t.Error("expected the input to be unchanged")
+	}
+
+	local := spotigo.FilterPlaylistItems(items, spotigo.PlaylistItemLocal)
+	if track, ok := local[0].AsTrack(); !ok || !track.IsLocal || track.Name != "Demo" {
+		t.Errorf("expected a local track, got %+v", track)
+	}
+	if _, ok := items[1].AsTrack(); ok {
+		t.Error("expected an episode not to decode as a track")
+	}
+	if episode, ok := items[1].AsEpisode(); !ok || episode.Name != "Episode" {
+		t.Errorf("unexpected episode: %+v", episode)
+	}
+	if _, ok := items[3].AsTrack(); ok {
+		t.Error("expected an unavailable item not to decode")
+	}
+
+	typed := []spotigo.PlaylistItem{
+		{Track: &spotigo.Track{URI: "spotify:track:6LgJvl0Xdtc73RJ1mmpotq"}},
+		{Episode: &spotigo.Episode{}},
+		{IsLocal: true, Track: &spotigo.Track{IsLocal: true}},
+	}
+	if got := spotigo.FilterPlaylistItems(typed, spotigo.PlaylistItemEpisode); len(got) != 1 || got[0].Episode == nil {
+		t.Errorf("unexpected filtered items: %+v", got)
+	}
+}
@@ -0,0 +1,96 @@
+package unit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sv4u/spotigo"
+	"github.com/sv4u/spotigo/tests"
+)
+
+func TestPlaylistMoveTranslatesToReorder(t *testing.T) {
+	var body map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/playlists/"+specPlaylistID+"/tracks" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		body = nil
+		json.NewDecoder(r.Body).Decode(&body)
+		tests.WriteJSONResponse(w, http.StatusOK, map[string]interface{}{"snapshot_id": "next"})
+	}))
+	defer server.Close()
+	client := newPlayerTestClient(t, server)
+	ctx := context.Background()
+
+	cases := []struct {
+		name                      string
+		start, length, to         int
+		insertBefore, rangeLength float64
+	}{
+		{"item down", 0, 1, 2, 3, 0},
+		{"item up", 3, 1, 0, 0, 0},
+		{"range down", 0, 2, 2, 4, 2},
+		{"range up", 5, 3, 1, 1, 3},
+	}
+	for _, tc := range cases {
+		var (
+			snapshot *spotigo.PlaylistSnapshotID
+			err      error
+		)
+		if tc.length == 1 {
+			snapshot, err = client.PlaylistMoveItem(ctx, specPlaylistID, tc.start, tc.to, "prev")
+		} else {
+			snapshot, err = client.PlaylistMoveRange(ctx, specPlaylistID, tc.start, tc.length, tc.to)
+		}
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", tc.name, err)
+		}
+		if snapshot.SnapshotID != "next" {
+			t.Errorf("%s: expected the new snapshot, got %q", tc.name, snapshot.SnapshotID)
+		}
+		if body["range_start"] != float64(tc.start) || body["insert_before"] != tc.insertBefore {
+			t.Errorf("%s: unexpected body %v", tc.name, body)
+		}
+		if length, _ := body["range_length"].(float64); length != tc.rangeLength {
+			t.Errorf("%s: expected range_length %v, got %v", tc.name, tc.rangeLength, body["range_length"])
+		}
+		if tc.length == 1 && body["snapshot_id"] != "prev" {
+			t.Errorf("%s: expected snapshot_id to be passed, got %v", tc.name, body["snapshot_id"])
+		}
+	}
+}
+
+func TestPlaylistMoveToSamePosition(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Query().Get("fields") != "snapshot_id" {
+			t.Errorf("expected only a snapshot probe, got %s %s", r.Method, r.URL)
+		}
+		tests.WriteJSONResponse(w, http.StatusOK, map[string]interface{}{"snapshot_id": "current"})
+	}))
+	defer server.Close()
+	client := newPlayerTestClient(t, server)
+
+	snapshot, err := client.PlaylistMoveRange(context.Background(), specPlaylistID, 4, 2, 4)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if snapshot.SnapshotID != "current" {
+		t.Errorf("expected the current snapshot, got %q", snapshot.SnapshotID)
+	}
+}
+
+func TestPlaylistMoveValidation(t *testing.T) {
+	client, _ := spotigo.NewClient(nil)
+	_, err := client.PlaylistMoveRange(context.Background(), specPlaylistID, -1, 0, 2)
+	var optErr *spotigo.InvalidOptionError
+	if !errors.As(err, &optErr) || !errors.Is(err, spotigo.ErrValidation) {
+		t.Fatalf("expected an InvalidOptionError, got %v", err)
+	}
+	if got := err.Error(); got != "start must be non-negative, got -1\nlength must be at least 1, got 0" {
+		t.Errorf("expected both problems reported, got %q", got)
+	}
+}
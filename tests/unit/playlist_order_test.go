@@ -0,0 +1,221 @@
+package unit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/sv4u/spotigo"
+)
+
+// orderTestItem is a playlist item served by playlistOrderServer
+type orderTestItem struct {
+	Name        string
+	Artist      string
+	DurationMs  int
+	Popularity  int
+	ReleaseDate string
+	AddedAt     string
+	Removed     bool // Served with a null track
+}
+
+// playlistOrderServer simulates a playlist that supports paging and reorders
+type playlistOrderServer struct {
+	t        *testing.T
+	mu       sync.Mutex
+	items    []orderTestItem
+	snapshot int
+	reorders int
+}
+
+func (s *playlistOrderServer) names() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	names := make([]string, len(s.items))
+	for i, item := range s.items {
+		names[i] = item.Name
+	}
+	return names
+}
+
+func (s *playlistOrderServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	w.Header().Set("Content-Type", "application/json")
+
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == "/playlists/p1":
+		fmt.Fprintf(w, `{"id": "p1", "snapshot_id": "snap-%d"}`, s.snapshot)
+
+	case r.Method == http.MethodGet && r.URL.Path == "/playlists/p1/tracks":
+		// Serve two items per page to exercise paging
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		end := min(offset+2, len(s.items))
+		page := map[string]interface{}{"items": []interface{}{}}
+		items := []interface{}{}
+		for _, item := range s.items[offset:end] {
+			entry := map[string]interface{}{"added_at": item.AddedAt, "track": nil}
+			if !item.Removed {
+				entry["track"] = map[string]interface{}{
+					"name":        item.Name,
+					"duration_ms": item.DurationMs,
+					"popularity":  item.Popularity,
+					"artists":     []interface{}{map[string]string{"name": item.Artist}},
+					"album":       map[string]string{"release_date": item.ReleaseDate},
+				}
+			}
+			items = append(items, entry)
+		}
+		page["items"] = items
+		if end < len(s.items) {
+			next := fmt.Sprintf("http://%s/playlists/p1/tracks?offset=%d&limit=2", r.Host, end)
+			page["next"] = next
+		}
+		json.NewEncoder(w).Encode(page)
+
+	case r.Method == http.MethodPut && r.URL.Path == "/playlists/p1/tracks":
+		var body struct {
+			RangeStart   int     `json:"range_start"`
+			InsertBefore int     `json:"insert_before"`
+			RangeLength  *int    `json:"range_length"`
+			SnapshotID   *string `json:"snapshot_id"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			s.t.Errorf("invalid reorder body: %v", err)
+		}
+		if body.SnapshotID == nil || *body.SnapshotID != fmt.Sprintf("snap-%d", s.snapshot) {
+			s.t.Errorf("reorder with stale snapshot %v, current snap-%d", body.SnapshotID, s.snapshot)
+		}
+		length := 1
+		if body.RangeLength != nil {
+			length = *body.RangeLength
+		}
+		run := slices.Clone(s.items[body.RangeStart : body.RangeStart+length])
+		insertAt := body.InsertBefore
+		s.items = slices.Delete(s.items, body.RangeStart, body.RangeStart+length)
+		if insertAt > body.RangeStart {
+			insertAt -= length
+		}
+		s.items = slices.Insert(s.items, insertAt, run...)
+		s.snapshot++
+		s.reorders++
+		fmt.Fprintf(w, `{"snapshot_id": "snap-%d"}`, s.snapshot)
+
+	default:
+		s.t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func newPlaylistOrderTest(t *testing.T, items []orderTestItem) (*playlistOrderServer, *spotigo.Client) {
+	t.Helper()
+	state := &playlistOrderServer{t: t, items: items}
+	server := httptest.NewServer(state)
+	t.Cleanup(server.Close)
+	return state, newPlayerTestClient(t, server)
+}
+
+func TestPlaylistSortBy(t *testing.T) {
+	items := []orderTestItem{
+		{Name: "delta", Artist: "B", DurationMs: 200, Popularity: 10, ReleaseDate: "2001", AddedAt: "2024-01-04T00:00:00Z"},
+		{Name: "alpha", Artist: "C", DurationMs: 400, Popularity: 90, ReleaseDate: "1999-05-01", AddedAt: "2024-01-02T00:00:00Z"},
+		{Name: "gone", Removed: true, AddedAt: "2024-01-01T00:00:00Z"},
+		{Name: "Charlie", Artist: "A", DurationMs: 100, Popularity: 50, ReleaseDate: "2010-03", AddedAt: "2024-01-03T00:00:00Z"},
+		{Name: "bravo", Artist: "B", DurationMs: 300, Popularity: 50, ReleaseDate: "1980", AddedAt: "2024-01-05T00:00:00Z"},
+	}
+
+	tests := []struct {
+		field      spotigo.PlaylistSortField
+		descending bool
+		want       []string
+	}{
+		{spotigo.SortByName, false, []string{"alpha", "bravo", "Charlie", "delta", "gone"}},
+		{spotigo.SortByArtist, false, []string{"Charlie", "bravo", "delta", "alpha", "gone"}},
+		{spotigo.SortByDuration, false, []string{"Charlie", "delta", "bravo", "alpha", "gone"}},
+		{spotigo.SortByPopularity, true, []string{"alpha", "Charlie", "bravo", "delta", "gone"}},
+		{spotigo.SortByReleaseDate, false, []string{"bravo", "alpha", "delta", "Charlie", "gone"}},
+		{spotigo.SortByAddedAt, false, []string{"alpha", "Charlie", "delta", "bravo", "gone"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.field), func(t *testing.T) {
+			state, client := newPlaylistOrderTest(t, slices.Clone(items))
+
+			snapshot, err := client.PlaylistSortBy(context.Background(), "p1", tt.field,
+				&spotigo.PlaylistSortOptions{Descending: tt.descending})
+			if err != nil {
+				t.Fatalf("PlaylistSortBy failed: %v", err)
+			}
+			if got := state.names(); !slices.Equal(got, tt.want) {
+				t.Errorf("order = %v, want %v", got, tt.want)
+			}
+			if want := fmt.Sprintf("snap-%d", state.snapshot); snapshot.SnapshotID != want {
+				t.Errorf("snapshot = %q, want %q", snapshot.SnapshotID, want)
+			}
+		})
+	}
+}
+
+func TestPlaylistSortByBatchesRuns(t *testing.T) {
+	// Moving a sorted run of three items to the front takes one request
+	items := []orderTestItem{{Name: "d"}, {Name: "e"}, {Name: "a"}, {Name: "b"}, {Name: "c"}}
+	state, client := newPlaylistOrderTest(t, items)
+
+	if _, err := client.PlaylistSortBy(context.Background(), "p1", spotigo.SortByName, nil); err != nil {
+		t.Fatalf("PlaylistSortBy failed: %v", err)
+	}
+	if got := state.names(); !slices.Equal(got, []string{"a", "b", "c", "d", "e"}) {
+		t.Errorf("order = %v", got)
+	}
+	if state.reorders != 1 {
+		t.Errorf("reorder requests = %d, want 1", state.reorders)
+	}
+
+	// Sorting again changes nothing
+	state.reorders = 0
+	snapshot, err := client.PlaylistSortBy(context.Background(), "p1", spotigo.SortByName, nil)
+	if err != nil {
+		t.Fatalf("PlaylistSortBy failed: %v", err)
+	}
+	if state.reorders != 0 {
+		t.Errorf("reorder requests = %d for sorted playlist, want 0", state.reorders)
+	}
+	if snapshot.SnapshotID != "snap-1" {
+		t.Errorf("snapshot = %q, want unchanged snap-1", snapshot.SnapshotID)
+	}
+}
+
+func TestPlaylistSortByInvalidField(t *testing.T) {
+	_, client := newPlaylistOrderTest(t, nil)
+	_, err := client.PlaylistSortBy(context.Background(), "p1", "tempo", nil)
+	if err == nil || !strings.Contains(err.Error(), "tempo") {
+		t.Errorf("expected unsupported field error, got %v", err)
+	}
+}
+
+func TestPlaylistShuffle(t *testing.T) {
+	var items []orderTestItem
+	for i := 0; i < 20; i++ {
+		items = append(items, orderTestItem{Name: fmt.Sprintf("t%02d", i)})
+	}
+	state, client := newPlaylistOrderTest(t, items)
+
+	if _, err := client.PlaylistShuffle(context.Background(), "p1"); err != nil {
+		t.Fatalf("PlaylistShuffle failed: %v", err)
+	}
+
+	got := state.names()
+	sorted := slices.Sorted(slices.Values(got))
+	for i, name := range sorted {
+		if want := fmt.Sprintf("t%02d", i); name != want {
+			t.Fatalf("shuffle lost or duplicated items: %v", got)
+		}
+	}
+}
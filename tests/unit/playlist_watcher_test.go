@@ -0,0 +1,157 @@
+package unit
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/sv4u/spotigo"
+	"github.com/sv4u/spotigo/tests"
+)
+
+// watcherItems builds playlist items from single-letter track names
+func watcherItems(names string) []spotigo.PlaylistTrack {
+	items := make([]spotigo.PlaylistTrack, 0, len(names))
+	for _, name := range names {
+		items = append(items, spotigo.PlaylistTrack{Track: map[string]interface{}{"type": "track", "uri": "spotify:track:" + string(name)}})
+	}
+	return items
+}
+
+func changeURIs(changes []spotigo.PlaylistItemChange) string {
+	var names []string
+	for _, change := range changes {
+		names = append(names, strings.TrimPrefix(change.URI, "spotify:track:"))
+	}
+	return strings.Join(names, "")
+}
+
+func TestDiffPlaylistItems(t *testing.T) {
+	cases := []struct {
+		previous, current     string
+		added, removed, moved string
+	}{
+		{"abc", "abc", "", "", ""},
+		{"abc", "abcd", "d", "", ""},
+		{"abcd", "acd", "", "b", ""},
+		{"abcd", "dabc", "", "", "d"},
+		{"abcde", "aecdb", "", "", "eb"},
+		{"abca", "bcaa", "", "", "a"},
+		{"aab", "ab", "", "a", ""},
+		{"abc", "xcba", "x", "", "ba"},
+	}
+	for _, tc := range cases {
+		diff := spotigo.DiffPlaylistItems(watcherItems(tc.previous), watcherItems(tc.current))
+		if got := changeURIs(diff.Added); got != tc.added {
+			t.Errorf("%s -> %s: added %q, want %q", tc.previous, tc.current, got, tc.added)
+		}
+		if got := changeURIs(diff.Removed); got != tc.removed {
+			t.Errorf("%s -> %s: removed %q, want %q", tc.previous, tc.current, got, tc.removed)
+		}
+		if got := changeURIs(diff.Moved); got != tc.moved {
+			t.Errorf("%s -> %s: moved %q, want %q", tc.previous, tc.current, got, tc.moved)
+		}
+		if diff.Empty() != (tc.added+tc.removed+tc.moved == "") {
+			t.Errorf("%s -> %s: unexpected Empty() %v", tc.previous, tc.current, diff.Empty())
+		}
+	}
+
+	diff := spotigo.DiffPlaylistItems(watcherItems("abcd"), watcherItems("dabc"))
+	if move := diff.Moved[0]; move.PreviousPosition != 3 || move.Position != 0 {
+		t.Errorf("unexpected move %+v", move)
+	}
+	diff = spotigo.DiffPlaylistItems(watcherItems("ab"), watcherItems("b"))
+	if removed := diff.Removed[0]; removed.PreviousPosition != 0 || removed.Position != -1 {
+		t.Errorf("unexpected removal %+v", removed)
+	}
+}
+
+func TestPlaylistWatcher(t *testing.T) {
+	playlistID := "37i9dQZF1DXcBWIGoYBM5M"
+	snapshot, names := "s1", "abc"
+	itemReads := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/playlists/" + playlistID:
+			tests.WriteJSONResponse(w, http.StatusOK, map[string]interface{}{"snapshot_id": snapshot})
+		case "/playlists/" + playlistID + "/tracks":
+			itemReads++
+			var items []map[string]interface{}
+			for _, name := range names {
+				items = append(items, map[string]interface{}{"track": map[string]interface{}{"type": "track", "uri": "spotify:track:" + string(name)}})
+			}
+			tests.WriteJSONResponse(w, http.StatusOK, map[string]interface{}{"items": items, "total": len(items)})
+		default:
+			tests.WriteJSONResponse(w, http.StatusNotFound, tests.CreateErrorResponse(404, "Not found", ""))
+		}
+	}))
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+	var reported []spotigo.PlaylistDiff
+	watcher := client.NewPlaylistWatcher([]string{"spotify:playlist:" + playlistID}, func(diff spotigo.PlaylistDiff) {
+		reported = append(reported, diff)
+	})
+	ctx := context.Background()
+
+	// Baseline
+	if diffs, err := watcher.Poll(ctx); err != nil || len(diffs) != 0 {
+		t.Fatalf("expected a silent baseline, got %v, %v", diffs, err)
+	}
+
+	// Unchanged snapshot: items are not fetched again
+	if diffs, err := watcher.Poll(ctx); err != nil || len(diffs) != 0 || itemReads != 1 {
+		t.Fatalf("expected no change and no item read, got %v, %v, %d reads", diffs, err, itemReads)
+	}
+
+	snapshot, names = "s2", "cad"
+	diffs, err := watcher.Poll(ctx)
+	if err != nil || len(diffs) != 1 || len(reported) != 1 {
+		t.Fatalf("expected one diff, got %v, %v", diffs, err)
+	}
+	diff := diffs[0]
+	if diff.PlaylistID != playlistID || diff.PreviousSnapshotID != "s1" || diff.SnapshotID != "s2" {
+		t.Errorf("unexpected diff header %+v", diff)
+	}
+	if changeURIs(diff.Added) != "d" || changeURIs(diff.Removed) != "b" || changeURIs(diff.Moved) != "a" {
+		t.Errorf("unexpected diff +%s -%s ~%s", changeURIs(diff.Added), changeURIs(diff.Removed), changeURIs(diff.Moved))
+	}
+	if items, ok := watcher.Items(playlistID); !ok || len(items) != 3 {
+		t.Errorf("expected the latest items, got %d", len(items))
+	}
+}
+
+func TestPlaylistWatcherRunDefaultInterval(t *testing.T) {
+	playlistID := "37i9dQZF1DXcBWIGoYBM5M"
+	var polls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/playlists/" + playlistID:
+			polls.Add(1)
+			tests.WriteJSONResponse(w, http.StatusOK, map[string]interface{}{"snapshot_id": "s1"})
+		default:
+			tests.WriteJSONResponse(w, http.StatusOK, map[string]interface{}{"items": []interface{}{}, "total": 0})
+		}
+	}))
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+	watcher := client.NewPlaylistWatcher([]string{playlistID}, nil)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+
+	// A zero interval falls back to the default instead of panicking, so
+	// only the immediate poll happens before the context expires
+	if err := watcher.Run(ctx, 0); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected context.DeadlineExceeded, got %v", err)
+	}
+	if got := polls.Load(); got != 1 {
+		t.Errorf("expected 1 snapshot check before the default interval, got %d", got)
+	}
+}
@@ -0,0 +1,212 @@
+package unit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/sv4u/spotigo"
+	"github.com/sv4u/spotigo/playlistspec"
+	"github.com/sv4u/spotigo/tests"
+)
+
+// specServer fakes the endpoints playlistspec uses for user alice, keeping
+// playlists in memory and recording write requests
+type specServer struct {
+	t           *testing.T
+	playlists   map[string]*specPlaylist
+	writes      []string
+	searchItems []string
+}
+
+type specPlaylist struct {
+	name, description string
+	owner             string
+	items             []string
+}
+
+const specPlaylistID = "2oCEWyyAPbZp9xhVSxZavx"
+
+func trackURIs(items ...string) []map[string]interface{} {
+	tracks := make([]map[string]interface{}, 0, len(items))
+	for _, uri := range items {
+		tracks = append(tracks, map[string]interface{}{"type": "track", "uri": uri})
+	}
+	return tracks
+}
+
+func (s *specServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		s.writes = append(s.writes, r.Method+" "+r.URL.Path)
+	}
+	path := strings.TrimPrefix(r.URL.Path, "/playlists/")
+	id, rest, _ := strings.Cut(path, "/")
+
+	switch {
+	case r.URL.Path == "/me":
+		tests.WriteJSONResponse(w, http.StatusOK, map[string]interface{}{"id": "alice"})
+	case r.URL.Path == "/me/playlists":
+		var items []map[string]interface{}
+		for id, p := range s.playlists {
+			items = append(items, map[string]interface{}{"id": id, "name": p.name, "description": p.description, "owner": map[string]interface{}{"id": p.owner}})
+		}
+		tests.WriteJSONResponse(w, http.StatusOK, map[string]interface{}{"items": items, "total": len(items)})
+	case r.URL.Path == "/search":
+		tests.WriteJSONResponse(w, http.StatusOK, map[string]interface{}{"tracks": map[string]interface{}{"items": trackURIs(s.searchItems...)}})
+	case strings.HasPrefix(r.URL.Path, "/artists/"):
+		artist := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/artists/"), "/top-tracks")
+		tests.WriteJSONResponse(w, http.StatusOK, map[string]interface{}{"tracks": trackURIs("spotify:track:"+artist[:20]+"t1", "spotify:track:"+artist[:20]+"t2")})
+	case r.Method == http.MethodPost && r.URL.Path == "/users/alice/playlists":
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		name, _ := body["name"].(string)
+		description, _ := body["description"].(string)
+		s.playlists[specPlaylistID] = &specPlaylist{name: name, description: description, owner: "alice"}
+		tests.WriteJSONResponse(w, http.StatusCreated, map[string]interface{}{"id": specPlaylistID, "name": name})
+	case s.playlists[id] != nil && rest == "tracks":
+		p := s.playlists[id]
+		switch r.Method {
+		case http.MethodGet:
+			items := make([]map[string]interface{}, 0, len(p.items))
+			for _, track := range trackURIs(p.items...) {
+				items = append(items, map[string]interface{}{"track": track})
+			}
+			tests.WriteJSONResponse(w, http.StatusOK, map[string]interface{}{"items": items, "total": len(items)})
+			return
+		case http.MethodPut:
+			p.items = nil
+		}
+		var body spotigo.PlaylistAddItemsRequest
+		json.NewDecoder(r.Body).Decode(&body)
+		p.items = append(p.items, body.URIs...)
+		tests.WriteJSONResponse(w, http.StatusOK, map[string]interface{}{"snapshot_id": "s"})
+	case s.playlists[id] != nil && rest == "" && r.Method == http.MethodPut:
+		var body spotigo.ChangePlaylistDetailsOptions
+		json.NewDecoder(r.Body).Decode(&body)
+		if body.Name != nil {
+			s.playlists[id].name = *body.Name
+		}
+		if body.Description != nil {
+			s.playlists[id].description = *body.Description
+		}
+		w.WriteHeader(http.StatusOK)
+	default:
+		s.t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func TestPlaylistSpecApplyIsIdempotent(t *testing.T) {
+	fake := &specServer{
+		t:           t,
+		playlists:   map[string]*specPlaylist{},
+		searchItems: []string{"spotify:track:" + base62ID("s", 1), "spotify:track:" + base62ID("s", 2)},
+	}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+	client := newPlayerTestClient(t, server)
+
+	spec, err := playlistspec.Parse([]byte(`{
+		"name": "Focus",
+		"description": "Quiet\nmusic",
+		"sources": [
+			{"search": "genre:ambient year:2020"},
+			{"artist_top_tracks": ["` + base62ID("a", 1) + `"], "limit": 1},
+			{"tracks": ["spotify:track:` + base62ID("s", 1) + `", "https://open.spotify.com/episode/512ojhOuo1ktJprKbVcKyQ"]}
+		]
+	}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	plan, err := playlistspec.Apply(context.Background(), client, spec)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []string{
+		"spotify:track:" + base62ID("s", 1),
+		"spotify:track:" + base62ID("s", 2),
+		"spotify:track:" + base62ID("a", 1)[:20] + "t1",
+		"spotify:episode:512ojhOuo1ktJprKbVcKyQ",
+	}
+	if !plan.Create || plan.PlaylistID != specPlaylistID || !slices.Equal(plan.Items, want) {
+		t.Fatalf("unexpected plan %+v", plan)
+	}
+	if got := fake.playlists[specPlaylistID]; got.description != "Quiet music" || !slices.Equal(got.items, want) {
+		t.Errorf("unexpected playlist %+v", got)
+	}
+
+	fake.writes = nil
+	plan, err = playlistspec.Apply(context.Background(), client, spec)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if plan.Changed() || len(fake.writes) != 0 {
+		t.Errorf("expected no changes on the second run, got plan %+v and writes %v", plan, fake.writes)
+	}
+}
+
+func TestPlaylistSpecApplyUpdatesExisting(t *testing.T) {
+	first, second := "spotify:track:"+base62ID("t", 1), "spotify:track:"+base62ID("t", 2)
+	stale := "spotify:track:" + base62ID("t", 3)
+	fake := &specServer{
+		t: t,
+		playlists: map[string]*specPlaylist{
+			base62ID("other", 1): {name: "Focus", owner: "bob"},
+			specPlaylistID:       {name: "Focus", description: "Old", owner: "alice", items: []string{second, stale, first}},
+		},
+	}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+	client := newPlayerTestClient(t, server)
+
+	spec := &playlistspec.Spec{
+		Name:        "Focus",
+		Description: "New",
+		Sources:     []playlistspec.Source{{Tracks: []string{first, second}}},
+	}
+	plan, err := playlistspec.NewPlan(context.Background(), client, spec)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if plan.Create || plan.PlaylistID != specPlaylistID {
+		t.Fatalf("expected alice's playlist to be found, got %+v", plan)
+	}
+	if plan.Details == nil || plan.Details.Name != nil || *plan.Details.Description != "New" {
+		t.Errorf("unexpected detail changes %+v", plan.Details)
+	}
+	if len(plan.Added) != 0 || !slices.Equal(plan.Removed, []string{stale}) {
+		t.Errorf("unexpected diff +%v -%v", plan.Added, plan.Removed)
+	}
+	if len(fake.writes) != 0 {
+		t.Errorf("NewPlan should not write, got %v", fake.writes)
+	}
+
+	if err := playlistspec.Execute(context.Background(), client, spec, plan); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	got := fake.playlists[specPlaylistID]
+	if got.description != "New" || !slices.Equal(got.items, []string{first, second}) {
+		t.Errorf("unexpected playlist %+v", got)
+	}
+}
+
+func TestPlaylistSpecValidate(t *testing.T) {
+	if _, err := playlistspec.Parse([]byte(`{"sources": []}`)); !errors.Is(err, spotigo.ErrValidation) {
+		t.Errorf("expected a validation error for a missing name, got %v", err)
+	}
+
+	_, err := playlistspec.Parse([]byte(`{"name": "Mix", "sources": [{"tracks": ["x"]}, {"search": "a", "playlist": "b"}]}`))
+	var sourceErr *playlistspec.SourceError
+	if !errors.As(err, &sourceErr) || sourceErr.Index != 1 || sourceErr.Set != 2 {
+		t.Errorf("expected a SourceError for sources[1], got %v", err)
+	}
+	if !errors.Is(err, spotigo.ErrValidation) {
+		t.Errorf("expected SourceError to match ErrValidation")
+	}
+}
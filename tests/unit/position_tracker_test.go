@@ -0,0 +1,69 @@
+package unit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/sv4u/spotigo"
+)
+
+func TestPositionTracker(t *testing.T) {
+	var tracker spotigo.PositionTracker
+	fetched := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	if got := tracker.PositionAt(fetched); got != 0 {
+		t.Errorf("expected zero position before any update, got %v", got)
+	}
+
+	tracker.Update(&spotigo.PlaybackState{
+		ProgressMs: 60000,
+		IsPlaying:  true,
+		Item:       map[string]interface{}{"id": "4iV5W9uYEdYUVa79Axb7Rh", "duration_ms": 200000},
+	}, fetched)
+
+	if got := tracker.Duration(); got != 200*time.Second {
+		t.Errorf("expected the item's duration, got %v", got)
+	}
+	if got := tracker.PositionAt(fetched.Add(2500 * time.Millisecond)); got != 62500*time.Millisecond {
+		t.Errorf("expected the position to advance while playing, got %v", got)
+	}
+	if got := tracker.PositionAt(fetched.Add(10 * time.Minute)); got != 200*time.Second {
+		t.Errorf("expected the position to stop at the end of the item, got %v", got)
+	}
+	if got := tracker.PositionAt(fetched.Add(-time.Second)); got != 60*time.Second {
+		t.Errorf("expected no extrapolation before the fetch, got %v", got)
+	}
+
+	// Paused playback stays put
+	tracker.Update(&spotigo.PlaybackState{
+		ProgressMs: 90000,
+		Item:       map[string]interface{}{"id": "4iV5W9uYEdYUVa79Axb7Rh", "duration_ms": 200000},
+	}, fetched)
+	if got := tracker.PositionAt(fetched.Add(time.Minute)); got != 90*time.Second {
+		t.Errorf("expected a paused position to stay put, got %v", got)
+	}
+
+	// Nothing playing resets the tracker
+	tracker.Update(nil, fetched)
+	if got := tracker.PositionAt(fetched.Add(time.Minute)); got != 0 || tracker.Duration() != 0 {
+		t.Errorf("expected a reset tracker, got %v of %v", got, tracker.Duration())
+	}
+}
+
+func TestPositionTrackerFraction(t *testing.T) {
+	var tracker spotigo.PositionTracker
+	tracker.Update(&spotigo.PlaybackState{
+		ProgressMs: 50000,
+		Item:       map[string]interface{}{"duration_ms": 200000},
+	}, time.Time{})
+
+	if got := tracker.Fraction(); got != 0.25 {
+		t.Errorf("expected 0.25, got %v", got)
+	}
+
+	// Unknown durations are not clamped and report no fraction
+	tracker.Update(&spotigo.PlaybackState{ProgressMs: 50000}, time.Time{})
+	if tracker.Fraction() != 0 || tracker.Position() != 50*time.Second {
+		t.Errorf("expected an unclamped position without a fraction, got %v", tracker.Position())
+	}
+}
@@ -0,0 +1,140 @@
+package unit
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/sv4u/spotigo"
+	"github.com/sv4u/spotigo/tests"
+)
+
+// queueItem builds a queued track as the API returns it
+func queueItem(id string, durationMs int) map[string]interface{} {
+	return map[string]interface{}{
+		"id":          id,
+		"uri":         "spotify:track:" + id,
+		"type":        "track",
+		"duration_ms": durationMs,
+	}
+}
+
+// TestQueuePositionAndTimeUntil tests positions and wait estimates from a queue
+func TestQueuePositionAndTimeUntil(t *testing.T) {
+	first := base62ID("first", 22)
+	second := base62ID("second", 22)
+	third := base62ID("third", 22)
+	relinked := queueItem(base62ID("relinked", 22), 60000)
+	relinked["linked_from"] = map[string]interface{}{"uri": "spotify:track:" + third}
+
+	queue := &spotigo.QueueResponse{
+		Queue: []spotigo.QueueItem{queueItem(first, 180000), queueItem(second, 200000), relinked},
+	}
+	state := &spotigo.PlaybackState{
+		ProgressMs: 30000,
+		Item:       queueItem(base62ID("playing", 22), 90000),
+	}
+
+	if got := queue.Position("spotify:track:" + second); got != 1 {
+		t.Errorf("expected position 1, got %d", got)
+	}
+	if got := queue.Position(third); got != 2 {
+		t.Errorf("expected relinked track at position 2, got %d", got)
+	}
+	if got := queue.Position(base62ID("missing", 22)); got != -1 {
+		t.Errorf("expected -1 for unqueued track, got %d", got)
+	}
+
+	wait, ok := queue.TimeUntil(second, state)
+	if !ok || wait != 240*time.Second {
+		t.Errorf("expected 60s remaining + 180s queued, got %s (ok=%v)", wait, ok)
+	}
+	if wait, ok := queue.TimeUntil(base62ID("playing", 22), state); !ok || wait != 0 {
+		t.Errorf("expected 0 for the playing track, got %s (ok=%v)", wait, ok)
+	}
+	if _, ok := queue.TimeUntil(base62ID("missing", 22), state); ok {
+		t.Error("expected unqueued track to report false")
+	}
+}
+
+// TestTimeUntilPlayingNotQueued tests that unqueued items return ErrNotInQueue
+func TestTimeUntilPlayingNotQueued(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/me/player":
+			tests.WriteJSONResponse(w, http.StatusOK, map[string]interface{}{
+				"is_playing": true, "progress_ms": 0, "item": queueItem(base62ID("playing", 22), 90000),
+			})
+		case "/me/player/queue":
+			tests.WriteJSONResponse(w, http.StatusOK, map[string]interface{}{
+				"queue": []interface{}{queueItem(base62ID("first", 22), 180000)},
+			})
+		default:
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+	_, err := client.TimeUntilPlaying(context.Background(), base62ID("missing", 22))
+	if !errors.Is(err, spotigo.ErrNotInQueue) {
+		t.Fatalf("expected ErrNotInQueue, got %v", err)
+	}
+
+	wait, err := client.TimeUntilPlaying(context.Background(), base62ID("first", 22))
+	if err != nil || wait != 90*time.Second {
+		t.Errorf("expected 90s, got %s (err=%v)", wait, err)
+	}
+}
+
+// TestWaitUntilPlaying tests that WaitUntilPlaying returns once the item plays
+func TestWaitUntilPlaying(t *testing.T) {
+	target := base62ID("target", 22)
+	var polls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		item := queueItem(base62ID("playing", 22), 90000)
+		if polls.Add(1) >= 2 {
+			item = queueItem(target, 120000)
+		}
+		// Near the end of the playing item, so polls are spaced at the minimum
+		tests.WriteJSONResponse(w, http.StatusOK, map[string]interface{}{
+			"is_playing": true, "progress_ms": 89990, "item": item,
+		})
+	}))
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	state, err := client.WaitUntilPlaying(ctx, "spotify:track:"+target)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if state == nil || polls.Load() != 2 {
+		t.Errorf("expected to return on the second poll, got %d polls", polls.Load())
+	}
+}
+
+// TestWaitUntilPlayingContextDone tests that WaitUntilPlaying stops with ctx
+func TestWaitUntilPlayingContextDone(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tests.WriteJSONResponse(w, http.StatusOK, map[string]interface{}{
+			"is_playing": false, "progress_ms": 0, "item": queueItem(base62ID("playing", 22), 90000),
+		})
+	}))
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	_, err := client.WaitUntilPlaying(ctx, base62ID("target", 22))
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded, got %v", err)
+	}
+}
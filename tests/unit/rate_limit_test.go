@@ -0,0 +1,96 @@
+package unit
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRateLimitState(t *testing.T) {
+	reset := time.Now().Add(time.Minute).Unix()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Limit", "100")
+		w.Header().Set("X-RateLimit-Remaining", "42")
+		w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(reset, 10))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id": "4iV5W9uYEdYUVa79Axb7Rh", "name": "Test Track"}`))
+	}))
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+	if state := client.RateLimitState(); state.Known {
+		t.Error("expected unknown state before any request")
+	}
+
+	if _, err := client.Track(context.Background(), "4iV5W9uYEdYUVa79Axb7Rh"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	state := client.RateLimitState()
+	if !state.Known || state.Limit != 100 || state.Remaining != 42 {
+		t.Errorf("unexpected state: %+v", state)
+	}
+	if state.Reset.Unix() != reset {
+		t.Errorf("expected reset %d, got %d", reset, state.Reset.Unix())
+	}
+}
+
+func TestRateLimitStateRetryAfter(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id": "4iV5W9uYEdYUVa79Axb7Rh", "name": "Test Track"}`))
+	}))
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+	if _, err := client.Track(context.Background(), "4iV5W9uYEdYUVa79Axb7Rh"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	state := client.RateLimitState()
+	if state.LimitedAt.IsZero() {
+		t.Error("expected 429 response to be recorded")
+	}
+	if state.RetryAfter != 0 {
+		t.Errorf("expected Retry-After 0, got %v", state.RetryAfter)
+	}
+}
+
+func TestRateLimitReserveDelaysRequests(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.Header().Set("X-RateLimit-Remaining", "0")
+		w.Header().Set("X-RateLimit-Reset", "1")
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id": "4iV5W9uYEdYUVa79Axb7Rh", "name": "Test Track"}`))
+	}))
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+	client.RateLimitReserve = 1
+
+	if _, err := client.Track(context.Background(), "4iV5W9uYEdYUVa79Axb7Rh"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Budget is exhausted until the window resets in 1s
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	if _, err := client.Track(ctx, "4iV5W9uYEdYUVa79Axb7Rh"); err == nil {
+		t.Fatal("expected request to wait for rate limit reset and time out")
+	}
+	if got := atomic.LoadInt32(&calls); got != 1 {
+		t.Errorf("expected delayed request not to reach the server, got %d calls", got)
+	}
+}
@@ -0,0 +1,56 @@
+package unit
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/sv4u/spotigo"
+)
+
+func TestContextWithRawResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Test", "yes")
+		if strings.HasSuffix(r.URL.Path, "missing") {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error": {"status": 404, "message": "not found"}}`))
+			return
+		}
+		w.Write([]byte(`{"id": "4iV5W9uYEdYUVa79Axb7Rh", "name": "Song", "brand_new": 1}`))
+	}))
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+
+	var raw spotigo.RawResponse
+	track, err := client.Track(spotigo.ContextWithRawResponse(context.Background(), &raw), "4iV5W9uYEdYUVa79Axb7Rh")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if track.Name != "Song" {
+		t.Errorf("expected typed result alongside the raw response, got %+v", track)
+	}
+	if raw.StatusCode != http.StatusOK || raw.Method != http.MethodGet || raw.Header.Get("X-Test") != "yes" {
+		t.Errorf("unexpected raw response: %+v", raw)
+	}
+	if !strings.Contains(string(raw.Body), `"brand_new": 1`) {
+		t.Errorf("expected raw body, got %s", raw.Body)
+	}
+	if !strings.HasSuffix(raw.URL, "/tracks/4iV5W9uYEdYUVa79Axb7Rh") {
+		t.Errorf("unexpected URL %q", raw.URL)
+	}
+
+	var errRaw spotigo.RawResponse
+	err = client.Do(spotigo.ContextWithRawResponse(context.Background(), &errRaw), http.MethodGet, "missing", nil, nil, nil)
+	var spotifyErr *spotigo.SpotifyError
+	if !errors.As(err, &spotifyErr) {
+		t.Fatalf("expected SpotifyError, got %v", err)
+	}
+	if errRaw.StatusCode != http.StatusNotFound || !strings.Contains(string(errRaw.Body), "not found") {
+		t.Errorf("expected the error response, got %+v", errRaw)
+	}
+}
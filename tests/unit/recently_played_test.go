@@ -0,0 +1,100 @@
+package unit
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/sv4u/spotigo/tests"
+)
+
+// recentlyPlayedServer serves plays of t0..t9 one hour apart, newest first,
+// three per page by before cursor. Each page repeats the previous page's
+// last play, as Spotify sometimes does.
+func recentlyPlayedServer(t *testing.T, base time.Time, requests *int) *httptest.Server {
+	t.Helper()
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*requests++
+		before := base.Add(10 * time.Hour)
+		if cursor := r.URL.Query().Get("before"); cursor != "" {
+			ms, err := strconv.ParseInt(cursor, 10, 64)
+			if err != nil {
+				t.Fatalf("invalid before cursor %q", cursor)
+			}
+			before = time.UnixMilli(ms).Add(time.Millisecond) // Repeat the boundary play
+		}
+
+		var items []map[string]interface{}
+		var oldest time.Time
+		for n := 9; n >= 0 && len(items) < 3; n-- {
+			playedAt := base.Add(time.Duration(n) * time.Hour)
+			if !playedAt.Before(before) {
+				continue
+			}
+			items = append(items, map[string]interface{}{
+				"track":     map[string]interface{}{"id": fmt.Sprintf("t%d", n)},
+				"played_at": playedAt.Format(time.RFC3339),
+			})
+			oldest = playedAt
+		}
+
+		var next interface{}
+		if len(items) == 3 && oldest.After(base) {
+			next = fmt.Sprintf("http://%s/me/player/recently-played?before=%d&limit=50", r.Host, oldest.UnixMilli())
+		}
+		tests.WriteJSONResponse(w, http.StatusOK, map[string]interface{}{"items": items, "next": next})
+	}))
+}
+
+func TestRecentlyPlayedBetween(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	var requests int
+	server := recentlyPlayedServer(t, base, &requests)
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+
+	plays, err := client.RecentlyPlayedBetween(context.Background(), base.Add(2*time.Hour), base.Add(8*time.Hour))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var ids []string
+	for _, play := range plays {
+		ids = append(ids, play.Track.ID)
+	}
+	if fmt.Sprint(ids) != "[t2 t3 t4 t5 t6 t7]" {
+		t.Errorf("expected t2..t7 oldest first without duplicates, got %v", ids)
+	}
+
+	// Walking stops once plays are older than from
+	if requests > 4 {
+		t.Errorf("expected at most 4 pages to be fetched, got %d", requests)
+	}
+}
+
+func TestRecentlyPlayedBetweenOpenWindow(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	var requests int
+	server := recentlyPlayedServer(t, base, &requests)
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+
+	plays, err := client.RecentlyPlayedBetween(context.Background(), time.Time{}, time.Time{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(plays) != 10 || plays[0].Track.ID != "t0" || plays[9].Track.ID != "t9" {
+		t.Errorf("expected all 10 plays oldest first, got %d", len(plays))
+	}
+
+	if _, err := client.RecentlyPlayedBetween(context.Background(), base.Add(time.Hour), base); err == nil {
+		t.Error("expected error for an empty window")
+	}
+}
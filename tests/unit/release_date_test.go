@@ -0,0 +1,97 @@
+package unit
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/sv4u/spotigo"
+)
+
+func TestParseReleaseDate(t *testing.T) {
+	tests := []struct {
+		input     string
+		precision spotigo.DatePrecision
+		start     time.Time
+		end       time.Time
+	}{
+		{"1981", spotigo.PrecisionYear, time.Date(1981, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(1982, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"1981-12", spotigo.PrecisionMonth, time.Date(1981, 12, 1, 0, 0, 0, 0, time.UTC), time.Date(1982, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"1981-12-05", spotigo.PrecisionDay, time.Date(1981, 12, 5, 0, 0, 0, 0, time.UTC), time.Date(1981, 12, 6, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		date, err := spotigo.ParseReleaseDate(tt.input)
+		if err != nil {
+			t.Fatalf("ParseReleaseDate(%q): %v", tt.input, err)
+		}
+		if date.Precision != tt.precision || !date.Time().Equal(tt.start) || !date.End().Equal(tt.end) {
+			t.Errorf("ParseReleaseDate(%q) = %+v, %v to %v", tt.input, date, date.Time(), date.End())
+		}
+		if date.String() != tt.input {
+			t.Errorf("String() = %q, want %q", date.String(), tt.input)
+		}
+	}
+
+	for _, invalid := range []string{"", "81", "1981-13", "1981/12/05", "December 1981"} {
+		if _, err := spotigo.ParseReleaseDate(invalid); err == nil {
+			t.Errorf("expected an error for %q", invalid)
+		}
+	}
+}
+
+func TestReleaseDateCompare(t *testing.T) {
+	var dates []spotigo.ReleaseDate
+	for _, s := range []string{"2001-01-01", "1999-05", "2001", "1980", "2001-01"} {
+		date, err := spotigo.ParseReleaseDate(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		dates = append(dates, date)
+	}
+
+	slices.SortFunc(dates, spotigo.ReleaseDate.Compare)
+	var got []string
+	for _, date := range dates {
+		got = append(got, date.String())
+	}
+	if want := []string{"1980", "1999-05", "2001", "2001-01", "2001-01-01"}; !slices.Equal(got, want) {
+		t.Errorf("sorted = %v, want %v", got, want)
+	}
+	if !dates[0].Before(dates[1]) || !dates[4].After(dates[3]) || dates[2].After(dates[2]) {
+		t.Error("unexpected Before/After results")
+	}
+}
+
+func TestReleaseDateJSON(t *testing.T) {
+	var album spotigo.SimplifiedAlbum
+	if err := json.Unmarshal([]byte(`{"id": "a1", "release_date": "1997-05", "release_date_precision": "month"}`), &album); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if album.ReleaseDate.Year != 1997 || album.ReleaseDate.Month != time.May || album.ReleaseDate.Precision != spotigo.PrecisionMonth {
+		t.Errorf("unexpected release date: %+v", album.ReleaseDate)
+	}
+
+	data, err := json.Marshal(album)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var fields map[string]interface{}
+	json.Unmarshal(data, &fields)
+	if fields["release_date"] != "1997-05" {
+		t.Errorf("expected the date to encode as a string, got %v", fields["release_date"])
+	}
+
+	// Unexpected formats do not fail the response and are kept as received
+	var episode spotigo.SimplifiedEpisode
+	if err := json.Unmarshal([]byte(`{"id": "e1", "release_date": "sometime"}`), &episode); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if episode.ReleaseDate.String() != "sometime" || episode.ReleaseDate.Precision != "" || episode.ReleaseDate.IsZero() {
+		t.Errorf("unexpected release date: %+v", episode.ReleaseDate)
+	}
+	if !new(spotigo.ReleaseDate).IsZero() {
+		t.Error("expected the zero value to be zero")
+	}
+}
@@ -0,0 +1,91 @@
+package unit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/sv4u/spotigo"
+)
+
+func TestResolveCanonicalTrackIDs(t *testing.T) {
+	var batches []int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/tracks" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		if market := r.URL.Query().Get("market"); market != "DE" {
+			t.Errorf("expected market DE, got %q", market)
+		}
+		ids := strings.Split(r.URL.Query().Get("ids"), ",")
+		batches = append(batches, len(ids))
+
+		// Every fifth track is relinked; track 7 is unknown
+		tracks := make([]interface{}, len(ids))
+		for i, id := range ids {
+			var n int
+			fmt.Sscanf(strings.TrimLeft(id, "t"), "%d", &n)
+			switch {
+			case n == 7:
+				tracks[i] = nil
+			case n%5 == 0:
+				tracks[i] = map[string]interface{}{
+					"id":          base62ID("r", n),
+					"linked_from": map[string]interface{}{"id": id},
+				}
+			default:
+				tracks[i] = map[string]interface{}{"id": id}
+			}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{"tracks": tracks})
+	}))
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+
+	var ids []string
+	for i := 0; i < 55; i++ {
+		ids = append(ids, base62ID("t", i))
+	}
+	ids = append(ids, "spotify:track:"+base62ID("t", 10), "not valid!")
+
+	canonical, err := client.ResolveCanonicalTrackIDs(context.Background(), ids, "DE")
+	var multiErr *spotigo.MultiError
+	if !errors.As(err, &multiErr) || multiErr.Len() != 1 || multiErr.Errors[0].Index != 56 {
+		t.Fatalf("expected a MultiError for the invalid ID, got %v", err)
+	}
+
+	if len(batches) != 2 || batches[0] != 50 || batches[1] != 5 {
+		t.Errorf("expected batches of 50 and 5, got %v", batches)
+	}
+
+	if got := canonical[base62ID("t", 3)]; got != base62ID("t", 3) {
+		t.Errorf("expected unrelinked track to map to itself, got %q", got)
+	}
+	if got := canonical[base62ID("r", 10)]; got != base62ID("t", 10) {
+		t.Errorf("expected substituted ID to map to the canonical ID, got %q", got)
+	}
+	if got := canonical["spotify:track:"+base62ID("t", 10)]; got != base62ID("t", 10) {
+		t.Errorf("expected URI input to map to the canonical ID, got %q", got)
+	}
+	if _, ok := canonical[base62ID("t", 7)]; ok {
+		t.Error("expected unknown track to be left out")
+	}
+}
+
+func TestTrackCanonicalID(t *testing.T) {
+	track := spotigo.Track{ID: "playable"}
+	if track.CanonicalID() != "playable" {
+		t.Errorf("expected ID without relinking, got %q", track.CanonicalID())
+	}
+	track.LinkedFrom = &spotigo.TrackLink{ID: "original"}
+	if track.CanonicalID() != "original" {
+		t.Errorf("expected LinkedFrom ID, got %q", track.CanonicalID())
+	}
+}
@@ -0,0 +1,186 @@
+package unit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sv4u/spotigo"
+)
+
+// remotePlayer simulates a player whose reported state can lag behind commands
+type remotePlayer struct {
+	mu       sync.Mutex
+	playing  bool
+	volume   int
+	progress int
+	frozen   bool // Keep reporting the initial state, as if it lagged
+	noDevice bool
+	commands []string
+}
+
+func (p *remotePlayer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	switch r.URL.Path {
+	case "/me/player":
+		if p.noDevice {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"device": {"id": "dev1", "name": "Kitchen", "is_active": true, "volume_percent": %d},
+			"is_playing": %t, "progress_ms": %d, "item": {"id": "t1", "duration_ms": 200000}}`,
+			p.volume, p.playing, p.progress)
+		return
+	case "/me/player/play":
+		p.commands = append(p.commands, "play")
+		if !p.frozen {
+			p.playing = true
+		}
+	case "/me/player/pause":
+		p.commands = append(p.commands, "pause")
+		if !p.frozen {
+			p.playing = false
+		}
+	case "/me/player/volume":
+		p.commands = append(p.commands, "volume="+r.URL.Query().Get("volume_percent"))
+		if !p.frozen {
+			fmt.Sscanf(r.URL.Query().Get("volume_percent"), "%d", &p.volume)
+		}
+	case "/me/player/seek":
+		p.commands = append(p.commands, "seek="+r.URL.Query().Get("position_ms"))
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	if r.URL.Query().Get("device_id") != "dev1" {
+		p.commands = append(p.commands, "missing device_id")
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func newRemoteTest(t *testing.T, player *remotePlayer) *spotigo.Client {
+	t.Helper()
+	server := httptest.NewServer(player)
+	t.Cleanup(server.Close)
+	return newPlayerTestClient(t, server)
+}
+
+func TestTogglePlayback(t *testing.T) {
+	player := &remotePlayer{playing: true}
+	client := newRemoteTest(t, player)
+	ctx := context.Background()
+
+	playing, err := client.TogglePlayback(ctx)
+	if err != nil || playing {
+		t.Fatalf("first toggle = %v, %v; want paused", playing, err)
+	}
+	playing, err = client.TogglePlayback(ctx)
+	if err != nil || !playing {
+		t.Fatalf("second toggle = %v, %v; want playing", playing, err)
+	}
+	if fmt.Sprint(player.commands) != "[pause play]" {
+		t.Errorf("commands = %v", player.commands)
+	}
+}
+
+func TestTogglePlaybackLaggingState(t *testing.T) {
+	// The player keeps reporting "playing"; rapid toggles must still alternate
+	player := &remotePlayer{playing: true, frozen: true}
+	client := newRemoteTest(t, player)
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		if _, err := client.TogglePlayback(ctx); err != nil {
+			t.Fatalf("toggle failed: %v", err)
+		}
+	}
+	if fmt.Sprint(player.commands) != "[pause play pause]" {
+		t.Errorf("commands = %v", player.commands)
+	}
+}
+
+func TestVolumeNudges(t *testing.T) {
+	player := &remotePlayer{volume: 50, frozen: true}
+	client := newRemoteTest(t, player)
+	ctx := context.Background()
+
+	// Concurrent nudges against a lagging state accumulate
+	var wg sync.WaitGroup
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := client.VolumeUp(ctx, 5); err != nil {
+				t.Errorf("VolumeUp failed: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	volume, err := client.VolumeUp(ctx, 0)
+	if err != nil || volume != 75 {
+		t.Errorf("VolumeUp(0) = %d, %v; want 75", volume, err)
+	}
+	volume, err = client.VolumeDown(ctx, 100)
+	if err != nil || volume != 0 {
+		t.Errorf("VolumeDown(100) = %d, %v; want 0", volume, err)
+	}
+	if fmt.Sprint(player.commands) != "[volume=55 volume=60 volume=65 volume=75 volume=0]" {
+		t.Errorf("commands = %v", player.commands)
+	}
+
+	if _, err := client.VolumeUp(ctx, -1); err == nil {
+		t.Error("expected error for negative step")
+	}
+}
+
+func TestVolumeAtLimit(t *testing.T) {
+	player := &remotePlayer{volume: 100}
+	client := newRemoteTest(t, player)
+
+	volume, err := client.VolumeUp(context.Background(), 10)
+	if err != nil || volume != 100 {
+		t.Errorf("VolumeUp = %d, %v; want 100", volume, err)
+	}
+	if len(player.commands) != 0 {
+		t.Errorf("no command expected at the limit, got %v", player.commands)
+	}
+}
+
+func TestSeekRelative(t *testing.T) {
+	player := &remotePlayer{progress: 10000}
+	client := newRemoteTest(t, player)
+	ctx := context.Background()
+
+	pos, err := client.SeekRelative(ctx, -15*time.Second)
+	if err != nil || pos != 0 {
+		t.Errorf("SeekRelative(-15s) = %v, %v; want 0", pos, err)
+	}
+	pos, err = client.SeekRelative(ctx, 30*time.Second)
+	if err != nil || pos != 40*time.Second {
+		t.Errorf("SeekRelative(30s) = %v, %v; want 40s", pos, err)
+	}
+	pos, err = client.SeekRelative(ctx, time.Hour)
+	if err != nil || pos != 200*time.Second {
+		t.Errorf("SeekRelative(1h) = %v, %v; want end of track", pos, err)
+	}
+	if fmt.Sprint(player.commands) != "[seek=0 seek=40000 seek=200000]" {
+		t.Errorf("commands = %v", player.commands)
+	}
+}
+
+func TestRemoteNoActiveDevice(t *testing.T) {
+	client := newRemoteTest(t, &remotePlayer{noDevice: true})
+
+	if _, err := client.TogglePlayback(context.Background()); !errors.Is(err, spotigo.ErrDeviceNotFound) {
+		t.Errorf("expected ErrDeviceNotFound, got %v", err)
+	}
+}
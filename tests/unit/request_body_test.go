@@ -0,0 +1,103 @@
+package unit
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/sv4u/spotigo"
+	"github.com/sv4u/spotigo/tests"
+)
+
+// TestPlaylistUploadCoverImageBody tests that cover images are sent base64
+// encoded without leaking the content type into the query string
+func TestPlaylistUploadCoverImageBody(t *testing.T) {
+	imageData := []byte{0xFF, 0xD8, 0xFF, 0xE0, 0x00, 0x10}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.RawQuery != "" {
+			t.Errorf("expected no query parameters, got %q", r.URL.RawQuery)
+		}
+		if ct := r.Header.Get("Content-Type"); ct != "image/jpeg" {
+			t.Errorf("expected Content-Type 'image/jpeg', got %q", ct)
+		}
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != base64.StdEncoding.EncodeToString(imageData) {
+			t.Errorf("expected base64 encoded image body, got %q", body)
+		}
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+	if err := client.PlaylistUploadCoverImage(context.Background(), "2oCEWyyAPbZp9xhVSxZavx", imageData); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+// TestJSONBodyDefault tests that bodies without a registered encoder are sent as JSON
+func TestJSONBodyDefault(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if ct := r.Header.Get("Content-Type"); ct != "application/json" {
+			t.Errorf("expected Content-Type 'application/json', got %q", ct)
+		}
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("expected JSON body: %v", err)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+	err := client.CurrentUserStartPlayback(context.Background(), &spotigo.StartPlaybackOptions{
+		URIs: []string{"spotify:track:4iV5W9uYEdYUVa79Axb7Rh"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+// TestWithBodyEncoder tests that registered encoders override the default
+func TestWithBodyEncoder(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if ct := r.Header.Get("Content-Type"); ct != "text/plain" {
+			t.Errorf("expected Content-Type 'text/plain', got %q", ct)
+		}
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != "custom" {
+			t.Errorf("expected custom body, got %q", body)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	auth := &tests.MockAuthManager{
+		Token: &spotigo.TokenInfo{AccessToken: "test_token", TokenType: "Bearer"},
+	}
+	client, err := spotigo.NewClient(auth,
+		spotigo.WithAPIPrefix(server.URL+"/"),
+		spotigo.WithBodyEncoder(
+			reflect.TypeOf(map[string]interface{}{}),
+			func(body interface{}) (io.Reader, string, error) {
+				return bytes.NewReader([]byte("custom")), "text/plain", nil
+			},
+		),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	err = client.CurrentUserStartPlayback(context.Background(), &spotigo.StartPlaybackOptions{
+		URIs: []string{"spotify:track:4iV5W9uYEdYUVa79Axb7Rh"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,82 @@
+package unit
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/sv4u/spotigo"
+)
+
+func TestWithMaxResponseBytesEndlessBody(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.Header().Set("Content-Type", "application/json")
+		chunk := []byte(strings.Repeat(" ", 4096))
+		for r.Context().Err() == nil {
+			if _, err := w.Write(chunk); err != nil {
+				return
+			}
+		}
+	}))
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+	spotigo.WithMaxResponseBytes(64 << 10)(client)
+
+	_, err := client.Track(context.Background(), "4iV5W9uYEdYUVa79Axb7Rh")
+	var tooLarge *spotigo.ResponseTooLargeError
+	if !errors.As(err, &tooLarge) || !errors.Is(err, spotigo.ErrResponseTooLarge) {
+		t.Fatalf("expected a ResponseTooLargeError, got %v", err)
+	}
+	if tooLarge.Limit != 64<<10 || tooLarge.Read <= tooLarge.Limit {
+		t.Errorf("unexpected limit %d and read %d", tooLarge.Limit, tooLarge.Read)
+	}
+	if n := atomic.LoadInt32(&requests); n != 1 {
+		t.Errorf("expected no retries, got %d requests", n)
+	}
+}
+
+func TestWithMaxResponseBytes(t *testing.T) {
+	body := `{"id": "4iV5W9uYEdYUVa79Axb7Rh", "name": "Test Track"}`
+	var compressed bytes.Buffer
+	gz := gzip.NewWriter(&compressed)
+	gz.Write([]byte(`{"id": "4iV5W9uYEdYUVa79Axb7Rh", "name": "` + strings.Repeat("x", 1<<20) + `"}`))
+	gz.Close()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if strings.HasPrefix(r.URL.Path, "/tracks/") {
+			w.Write([]byte(body))
+			return
+		}
+		// A small download that decompresses to 1 MB
+		w.Header().Set("Content-Encoding", "gzip")
+		w.Write(compressed.Bytes())
+	}))
+	defer server.Close()
+
+	for _, streaming := range []bool{false, true} {
+		client := newPlayerTestClient(t, server)
+		spotigo.WithMaxResponseBytes(int64(len(body)))(client)
+		spotigo.WithStreamingDecode(streaming)(client)
+
+		// A body exactly at the limit is accepted
+		if track, err := client.Track(context.Background(), "4iV5W9uYEdYUVa79Axb7Rh"); err != nil || track.Name != "Test Track" {
+			t.Fatalf("streaming=%v: expected the track, got %v", streaming, err)
+		}
+
+		// The limit also applies after decompression
+		_, err := client.Album(context.Background(), "4aawyAB9vmqN3uQ7FjRGTy")
+		if !errors.Is(err, spotigo.ErrResponseTooLarge) {
+			t.Errorf("streaming=%v: expected ErrResponseTooLarge for a decompression bomb, got %v", streaming, err)
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package unit
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/sv4u/spotigo"
+	"github.com/sv4u/spotigo/tests"
+)
+
+// statusSequenceServer answers with each status in turn, then 200
+func statusSequenceServer(statuses ...int) (*httptest.Server, *int) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests <= len(statuses) {
+			status := statuses[requests-1]
+			tests.WriteJSONResponse(w, status, tests.CreateErrorResponse(status, "failed", ""))
+			return
+		}
+		tests.WriteJSONResponse(w, http.StatusOK, map[string]interface{}{"id": "user"})
+	}))
+	return server, &requests
+}
+
+func TestStatusRetryPolicies(t *testing.T) {
+	noDelay := spotigo.BackoffFunc(func(int) time.Duration { return 0 })
+	policies := []spotigo.ClientOption{
+		spotigo.WithBackoffStrategy(noDelay),
+		spotigo.WithStatusRetryPolicy(500, spotigo.StatusRetryPolicy{}),
+		spotigo.WithStatusRetryPolicy(503, spotigo.StatusRetryPolicy{MaxRetries: 5, Backoff: noDelay}),
+		spotigo.WithStatusRetryPolicy(spotigo.StatusFamily5xx, spotigo.StatusRetryPolicy{MaxRetries: 1}),
+	}
+
+	cases := []struct {
+		name     string
+		statuses []int
+		wantOK   bool
+		requests int
+	}{
+		{"500 is never retried", []int{500}, false, 1},
+		{"503 retries past MaxRetries", []int{503, 503, 503, 503, 503}, true, 6},
+		{"503 stops at its limit", []int{503, 503, 503, 503, 503, 503}, false, 6},
+		{"family policy covers 502", []int{502, 502}, false, 2},
+		{"statuses are counted separately", []int{502, 503, 503}, true, 4},
+	}
+	for _, tc := range cases {
+		server, requests := statusSequenceServer(tc.statuses...)
+		client := newPlayerTestClient(t, server)
+		for _, opt := range policies {
+			opt(client)
+		}
+
+		_, err := client.CurrentUser(context.Background())
+		server.Close()
+		if (err == nil) != tc.wantOK {
+			t.Errorf("%s: unexpected error %v", tc.name, err)
+		}
+		if *requests != tc.requests {
+			t.Errorf("%s: expected %d requests, got %d", tc.name, tc.requests, *requests)
+		}
+	}
+}
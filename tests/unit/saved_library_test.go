@@ -0,0 +1,82 @@
+package unit
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sv4u/spotigo"
+	"github.com/sv4u/spotigo/tests"
+)
+
+// savedLibraryServer serves three saved items, each wrapped under key, two per page
+func savedLibraryServer(t *testing.T, path, key string, requests *int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != path {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		*requests++
+
+		var next interface{}
+		items := []map[string]interface{}{{key: map[string]interface{}{"id": "i3"}}}
+		if r.URL.Query().Get("offset") == "" {
+			if r.URL.Query().Get("limit") != "2" {
+				t.Errorf("expected limit=2, got %s", r.URL.RawQuery)
+			}
+			items = []map[string]interface{}{{key: map[string]interface{}{"id": "i1"}}, {key: map[string]interface{}{"id": "i2"}}}
+			next = fmt.Sprintf("http://%s%s?offset=2&limit=2", r.Host, path)
+		}
+		tests.WriteJSONResponse(w, http.StatusOK, map[string]interface{}{"items": items, "next": next, "limit": 2, "total": 3})
+	}))
+}
+
+func TestCurrentUserSavedAll(t *testing.T) {
+	ctx := context.Background()
+	var requests int
+
+	server := savedLibraryServer(t, "/me/shows", "show", &requests)
+	shows, err := newPlayerTestClient(t, server).CurrentUserSavedShowsAll(ctx, &spotigo.SavedShowsOptions{Limit: 2})
+	server.Close()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(shows) != 3 || shows[0].Show.ID != "i1" || shows[2].Show.ID != "i3" {
+		t.Errorf("expected shows i1..i3, got %+v", shows)
+	}
+
+	server = savedLibraryServer(t, "/me/albums", "album", &requests)
+	albums, err := newPlayerTestClient(t, server).CurrentUserSavedAlbumsAll(ctx, &spotigo.SavedAlbumsOptions{Limit: 2})
+	server.Close()
+	if err != nil || len(albums) != 3 || albums[1].Album.ID != "i2" {
+		t.Errorf("expected albums i1..i3, got %+v (%v)", albums, err)
+	}
+
+	server = savedLibraryServer(t, "/me/episodes", "episode", &requests)
+	episodes, err := newPlayerTestClient(t, server).CurrentUserSavedEpisodesAll(ctx, &spotigo.SavedEpisodesOptions{Limit: 2})
+	server.Close()
+	if err != nil || len(episodes) != 3 || episodes[2].Episode.ID != "i3" {
+		t.Errorf("expected episodes i1..i3, got %+v (%v)", episodes, err)
+	}
+}
+
+func TestLibraryIteratorStopsEarly(t *testing.T) {
+	var requests int
+	server := savedLibraryServer(t, "/me/tracks", "track", &requests)
+	defer server.Close()
+	client := newPlayerTestClient(t, server)
+
+	for saved, err := range client.CurrentUserSavedTracksIter(context.Background(), &spotigo.SavedTracksOptions{Limit: 2}) {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if saved.Track.ID != "i1" {
+			t.Errorf("expected i1 first, got %s", saved.Track.ID)
+		}
+		break
+	}
+	if requests != 1 {
+		t.Errorf("expected only the first page to be fetched, got %d requests", requests)
+	}
+}
@@ -0,0 +1,127 @@
+package unit
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync/atomic"
+	"testing"
+
+	"github.com/sv4u/spotigo"
+	"github.com/sv4u/spotigo/tests"
+)
+
+// TestSeedSetNormalizes tests that seeds are normalized and deduplicated
+func TestSeedSetNormalizes(t *testing.T) {
+	artist := base62ID("artist", 22)
+	track := base62ID("track", 22)
+
+	var seeds spotigo.SeedSet
+	if err := seeds.AddArtists("spotify:artist:"+artist, artist); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := seeds.AddTracks("https://open.spotify.com/track/" + track + "?si=abc"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := seeds.AddGenres("  Hip Hop ", "hip-hop", "JAZZ"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got := seeds.Artists(); !slices.Equal(got, []string{artist}) {
+		t.Errorf("unexpected artists: %v", got)
+	}
+	if got := seeds.Tracks(); !slices.Equal(got, []string{track}) {
+		t.Errorf("unexpected tracks: %v", got)
+	}
+	if got := seeds.Genres(); !slices.Equal(got, []string{"hip-hop", "jazz"}) {
+		t.Errorf("unexpected genres: %v", got)
+	}
+	if seeds.Len() != 4 {
+		t.Errorf("expected 4 seeds, got %d", seeds.Len())
+	}
+
+	opts := seeds.Options()
+	if len(opts.SeedArtists) != 1 || len(opts.SeedTracks) != 1 || len(opts.SeedGenres) != 2 {
+		t.Errorf("unexpected options: %+v", opts)
+	}
+}
+
+// TestSeedSetCombinedLimit tests that additions past 5 seeds are rejected whole
+func TestSeedSetCombinedLimit(t *testing.T) {
+	var seeds spotigo.SeedSet
+	if err := seeds.AddGenres("rock", "pop", "jazz"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	err := seeds.AddTracks(base62ID("a", 22), base62ID("b", 22), base62ID("c", 22))
+	var tooMany *spotigo.TooManyIDsError
+	if !errors.As(err, &tooMany) || tooMany.Got != 6 {
+		t.Fatalf("expected TooManyIDsError for 6 seeds, got %v", err)
+	}
+	if !errors.Is(err, spotigo.ErrValidation) {
+		t.Error("expected ErrValidation")
+	}
+	if seeds.Len() != 3 {
+		t.Errorf("expected the rejected tracks not to be added, got %d seeds", seeds.Len())
+	}
+
+	if err := seeds.AddArtists("not a valid id!"); err == nil {
+		t.Error("expected error for invalid artist ID")
+	}
+	if seeds.Len() != 3 {
+		t.Errorf("expected the invalid artist not to be added, got %d seeds", seeds.Len())
+	}
+}
+
+// TestValidateSeeds tests genre validation against the cached genre seeds
+func TestValidateSeeds(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if r.URL.Path != "/recommendations/available-genre-seeds" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		tests.WriteJSONResponse(w, http.StatusOK, map[string]interface{}{
+			"genres": []string{"rock", "hip-hop", "acoustic"},
+		})
+	}))
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+	ctx := context.Background()
+
+	var valid spotigo.SeedSet
+	if err := valid.AddGenres("Hip Hop", "rock"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := client.ValidateSeeds(ctx, &valid); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var invalid spotigo.SeedSet
+	if err := invalid.AddGenres("rock", "vaporwave"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	err := client.ValidateSeeds(ctx, &invalid)
+	var genreErr *spotigo.InvalidSeedGenreError
+	if !errors.As(err, &genreErr) || genreErr.Genre != "vaporwave" {
+		t.Fatalf("expected InvalidSeedGenreError for vaporwave, got %v", err)
+	}
+
+	if requests.Load() != 1 {
+		t.Errorf("expected genre seeds to be fetched once, got %d requests", requests.Load())
+	}
+
+	var tracksOnly spotigo.SeedSet
+	if err := tracksOnly.AddTracks(base62ID("track", 22)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := client.ValidateSeeds(ctx, &tracksOnly); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := client.ValidateSeeds(ctx, &spotigo.SeedSet{}); !errors.Is(err, spotigo.ErrValidation) {
+		t.Errorf("expected ErrValidation for an empty set, got %v", err)
+	}
+}
@@ -0,0 +1,128 @@
+package unit
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/sv4u/spotigo"
+	"github.com/sv4u/spotigo/tests"
+)
+
+// newShareLinkClient returns a client whose HTTP requests are answered by
+// respond, recording the requested URLs
+func newShareLinkClient(t *testing.T, requested *[]string, respond func(*http.Request) *http.Response) *spotigo.Client {
+	t.Helper()
+
+	client, err := spotigo.NewClient(&tests.MockAuthManager{Token: &spotigo.TokenInfo{AccessToken: "test_token"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	client.HTTPClient = &http.Client{Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+		*requested = append(*requested, r.URL.String())
+		return respond(r), nil
+	})}
+	return client
+}
+
+// redirectTo returns a redirect response to location
+func redirectTo(location string) *http.Response {
+	return &http.Response{
+		StatusCode: http.StatusTemporaryRedirect,
+		Header:     http.Header{"Location": {location}},
+		Body:       io.NopCloser(strings.NewReader("")),
+	}
+}
+
+func TestResolveShareLink(t *testing.T) {
+	var requested []string
+	client := newShareLinkClient(t, &requested, func(r *http.Request) *http.Response {
+		switch r.URL.Host {
+		case "spotify.link":
+			return redirectTo("https://spotify.app.link/abc?_p=xyz")
+		default:
+			return redirectTo("https://open.spotify.com/intl-de/track/4iV5W9uYEdYUVa79Axb7Rh?si=123&utm_source=copy-link")
+		}
+	})
+
+	link, err := client.ResolveShareLink(context.Background(), "https://spotify.link/abc")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if link != "https://open.spotify.com/track/4iV5W9uYEdYUVa79Axb7Rh" {
+		t.Errorf("unexpected canonical URL %q", link)
+	}
+	if len(requested) != 2 {
+		t.Errorf("expected 2 requests, got %v", requested)
+	}
+
+	id, err := spotigo.GetID(link, "track")
+	if err != nil || id != "4iV5W9uYEdYUVa79Axb7Rh" {
+		t.Errorf("GetID(%q) = %q, %v", link, id, err)
+	}
+}
+
+func TestResolveShareLinkInterstitialPage(t *testing.T) {
+	var requested []string
+	client := newShareLinkClient(t, &requested, func(r *http.Request) *http.Response {
+		page := `<html><script>window.location = "https://open.spotify.com/playlist/37i9dQZF1DXcBWIGoYBM5M?si=1";</script></html>`
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(page))}
+	})
+
+	link, err := client.ResolveShareLink(context.Background(), "https://spotify.link/xyz")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if link != "https://open.spotify.com/playlist/37i9dQZF1DXcBWIGoYBM5M" {
+		t.Errorf("unexpected canonical URL %q", link)
+	}
+}
+
+func TestResolveShareLinkRefusesOtherHosts(t *testing.T) {
+	for _, location := range []string{
+		"http://169.254.169.254/latest/meta-data/",
+		"https://localhost/track",
+		"http://spotify.app.link/abc",
+		"https://spotify.app.link:8443/abc",
+	} {
+		var requested []string
+		client := newShareLinkClient(t, &requested, func(r *http.Request) *http.Response {
+			return redirectTo(location)
+		})
+
+		if _, err := client.ResolveShareLink(context.Background(), "https://spotify.link/abc"); err == nil {
+			t.Errorf("expected redirect to %s to be refused", location)
+		}
+		if len(requested) != 1 {
+			t.Errorf("expected only the share link to be requested, got %v", requested)
+		}
+	}
+}
+
+func TestResolveShareLinkPassesThroughSpotifyURLs(t *testing.T) {
+	var requested []string
+	client := newShareLinkClient(t, &requested, func(r *http.Request) *http.Response {
+		t.Errorf("unexpected request to %s", r.URL)
+		return nil
+	})
+
+	uri := "spotify:album:4aawyAB9vmqN3uQ7FjRGTy"
+	if link, err := client.ResolveShareLink(context.Background(), uri); err != nil || link != uri {
+		t.Errorf("ResolveShareLink(%q) = %q, %v", uri, link, err)
+	}
+	if _, err := client.ResolveShareLink(context.Background(), "https://example.com/track/1"); err == nil {
+		t.Error("expected error for a non-Spotify URL")
+	}
+}
+
+func TestGetIDShareLink(t *testing.T) {
+	if !spotigo.IsShareLink("https://spotify.link/abc") || spotigo.IsShareLink("https://open.spotify.com/track/4iV5W9uYEdYUVa79Axb7Rh") {
+		t.Error("IsShareLink misclassified a link")
+	}
+	_, err := spotigo.GetID("https://spotify.link/abc", "track")
+	if err == nil || !strings.Contains(err.Error(), "ResolveShareLink") {
+		t.Errorf("expected an error pointing to ResolveShareLink, got %v", err)
+	}
+}
@@ -0,0 +1,105 @@
+package unit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/sv4u/spotigo"
+	"github.com/sv4u/spotigo/tests"
+)
+
+// showEpisodesServer serves 120 daily episodes of a show, newest first, the
+// newest released on 2024-05-31, and counts the pages requested
+func showEpisodesServer(t *testing.T, pages *int) *httptest.Server {
+	t.Helper()
+
+	newest := time.Date(2024, 5, 31, 0, 0, 0, 0, time.UTC)
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/shows/5CfCWKI5pZ28U0uOzXkDHe/episodes" {
+			tests.WriteJSONResponse(w, http.StatusNotFound, tests.CreateErrorResponse(404, "Not Found", ""))
+			return
+		}
+		if market := r.URL.Query().Get("market"); market != "US" {
+			t.Errorf("expected market US, got %q", market)
+		}
+		*pages++
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		var items []interface{}
+		for i := offset; i < min(offset+limit, 120); i++ {
+			if i == 1 {
+				items = append(items, nil) // Unavailable in the market
+				continue
+			}
+			items = append(items, map[string]interface{}{
+				"id":                     fmt.Sprintf("episode%d", i),
+				"release_date":           newest.AddDate(0, 0, -i).Format("2006-01-02"),
+				"release_date_precision": "day",
+			})
+		}
+		page := map[string]interface{}{"items": items, "limit": limit, "offset": offset, "total": 120, "next": nil}
+		if offset+limit < 120 {
+			page["next"] = fmt.Sprintf("%s%s?limit=%d&offset=%d&market=US", server.URL, r.URL.Path, limit, offset+limit)
+		}
+		tests.WriteJSONResponse(w, http.StatusOK, page)
+	}))
+	return server
+}
+
+func TestShowNewEpisodesSince(t *testing.T) {
+	pages := 0
+	server := showEpisodesServer(t, &pages)
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+
+	// Midday on 2024-05-29: the episode of that day still counts as new
+	since := time.Date(2024, 5, 29, 12, 0, 0, 0, time.UTC)
+	episodes, err := client.ShowNewEpisodesSince(context.Background(), "spotify:show:5CfCWKI5pZ28U0uOzXkDHe", since, "US")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(episodes) != 2 || episodes[0].ID != "episode0" || episodes[1].ID != "episode2" {
+		t.Errorf("expected episodes 0 and 2, got %+v", episodes)
+	}
+	if pages != 1 {
+		t.Errorf("expected to stop after the first page, fetched %d", pages)
+	}
+
+	// Episodes across pages
+	pages = 0
+	episodes, err = client.ShowNewEpisodesSince(context.Background(), "5CfCWKI5pZ28U0uOzXkDHe", since.AddDate(0, 0, -60), "US")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(episodes) != 62 || pages != 2 {
+		t.Errorf("expected 62 episodes from 2 pages, got %d from %d", len(episodes), pages)
+	}
+}
+
+func TestShowsNewEpisodesSince(t *testing.T) {
+	pages := 0
+	server := showEpisodesServer(t, &pages)
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+
+	since := time.Date(2024, 5, 30, 0, 0, 0, 0, time.UTC)
+	shows := []string{"5CfCWKI5pZ28U0uOzXkDHe", "4rOoJ6Egrf8K2IrywzwOMk"}
+	byShow, err := client.ShowsNewEpisodesSince(context.Background(), shows, since, "US")
+
+	var failed *spotigo.MultiError
+	if !errors.As(err, &failed) || len(failed.Errors) != 1 || failed.Errors[0].Index != 1 {
+		t.Fatalf("expected the second show to fail, got %v", err)
+	}
+	if len(byShow) != 1 || len(byShow["5CfCWKI5pZ28U0uOzXkDHe"]) != 1 {
+		t.Errorf("unexpected episodes: %+v", byShow)
+	}
+}
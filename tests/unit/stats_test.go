@@ -0,0 +1,84 @@
+package unit
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/sv4u/spotigo"
+)
+
+func TestClientStats(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/me/player/devices" {
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"devices": []}`))
+			return
+		}
+		// The first request is rate limited, the retry succeeds
+		if calls.Add(1) == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id": "0TnOYISbd1XYRBk9myaseg", "name": "Pitbull"}`))
+	}))
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+	ctx := context.Background()
+
+	if _, err := client.Artist(ctx, "0TnOYISbd1XYRBk9myaseg"); err != nil {
+		t.Fatalf("Artist failed: %v", err)
+	}
+	for i := 0; i < 3; i++ {
+		if _, err := client.CurrentUserDevices(ctx); err != nil {
+			t.Fatalf("CurrentUserDevices failed: %v", err)
+		}
+	}
+
+	stats := client.Stats()
+	if stats.Requests != 3 || stats.Retries != 1 || stats.RateLimited != 1 {
+		t.Errorf("requests=%d retries=%d rate_limited=%d, want 3, 1, 1", stats.Requests, stats.Retries, stats.RateLimited)
+	}
+	if stats.StatusCodes[200] != 2 || stats.StatusCodes[429] != 1 {
+		t.Errorf("status codes = %v", stats.StatusCodes)
+	}
+	if stats.BytesReceived == 0 || stats.AverageLatency <= 0 {
+		t.Errorf("bytes=%d latency=%s", stats.BytesReceived, stats.AverageLatency)
+	}
+	if stats.CacheHits != 2 || stats.CacheMisses != 1 {
+		t.Errorf("cache hits=%d misses=%d", stats.CacheHits, stats.CacheMisses)
+	}
+	if rate := stats.CacheHitRate(); rate < 0.66 || rate > 0.67 {
+		t.Errorf("CacheHitRate = %v", rate)
+	}
+
+	if latency := stats.Latency; len(latency.Bounds) == 0 || len(latency.Counts) != len(latency.Bounds) ||
+		latency.Count != 3 || latency.Counts[len(latency.Counts)-1] > latency.Count {
+		t.Errorf("latency histogram = %+v", latency)
+	}
+
+	// Snapshots are independent of later requests
+	stats.StatusCodes[200] = 100
+	stats.Latency.Bounds[0] = 0
+	if client.Stats().StatusCodes[200] != 2 {
+		t.Error("snapshot shares state with the client")
+	}
+	if client.Stats().Latency.Bounds[0] == 0 {
+		t.Error("snapshot shares latency bounds with the client")
+	}
+
+	client.ResetStats()
+	if reset := client.Stats(); reset.Requests != 0 || len(reset.StatusCodes) != 0 || reset.Since.IsZero() {
+		t.Errorf("stats after reset = %+v", reset)
+	}
+
+	if (spotigo.ClientStats{}).CacheHitRate() != 0 {
+		t.Error("CacheHitRate of empty stats should be 0")
+	}
+}
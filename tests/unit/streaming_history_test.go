@@ -0,0 +1,106 @@
+package unit
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/sv4u/spotigo"
+)
+
+const officialHistoryJSON = `[
+  {
+    "ts": "2024-01-01T09:00:00Z",
+    "platform": "android",
+    "ms_played": 210000,
+    "conn_country": "US",
+    "ip_addr_decrypted": null,
+    "user_agent_decrypted": null,
+    "master_metadata_track_name": "Creep",
+    "master_metadata_album_artist_name": "Radiohead",
+    "master_metadata_album_album_name": "Pablo Honey",
+    "spotify_track_uri": "spotify:track:6b2oQwSGFkzsMtQruIWm2p",
+    "episode_name": null,
+    "episode_show_name": null,
+    "spotify_episode_uri": null,
+    "reason_start": "clickrow",
+    "reason_end": "trackdone",
+    "shuffle": false,
+    "skipped": null,
+    "offline": false,
+    "offline_timestamp": 0,
+    "incognito_mode": false
+  }
+]`
+
+func TestReadWriteStreamingHistory(t *testing.T) {
+	entries, err := spotigo.ReadStreamingHistory(strings.NewReader(officialHistoryJSON))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(entries) != 1 {
+		t.Fatalf("expected 1 entry, got %d", len(entries))
+	}
+	if entries[0].URI() != "spotify:track:6b2oQwSGFkzsMtQruIWm2p" {
+		t.Errorf("unexpected URI: %q", entries[0].URI())
+	}
+	if entries[0].MsPlayed != 210000 || entries[0].ReasonStart != "clickrow" {
+		t.Errorf("unexpected entry: %+v", entries[0])
+	}
+
+	var buf bytes.Buffer
+	if err := spotigo.WriteStreamingHistory(&buf, entries); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	roundTrip, err := spotigo.ReadStreamingHistory(&buf)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(roundTrip) != 1 || roundTrip[0].Timestamp != "2024-01-01T09:00:00Z" {
+		t.Errorf("round trip mismatch: %+v", roundTrip)
+	}
+}
+
+func TestPlayHistoryToStreamingHistoryAndMerge(t *testing.T) {
+	official, err := spotigo.ReadStreamingHistory(strings.NewReader(officialHistoryJSON))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	collected := spotigo.PlayHistoryToStreamingHistory([]spotigo.PlayHistoryItem{
+		{
+			PlayedAt: "2024-01-01T10:00:00.512Z",
+			Track: spotigo.Track{
+				ID:         "0Svkvt5I79wficMFgaqEQJ",
+				Name:       "El Scorcho",
+				DurationMs: 243000,
+				Artists:    []spotigo.Artist{{Name: "Weezer"}},
+				Album:      &spotigo.SimplifiedAlbum{Name: "Pinkerton"},
+			},
+		},
+		{
+			// Same play as the official export
+			PlayedAt: "2024-01-01T09:00:00.120Z",
+			Track:    spotigo.Track{URI: "spotify:track:6b2oQwSGFkzsMtQruIWm2p", Name: "Creep"},
+		},
+	})
+
+	if collected[0].Timestamp != "2024-01-01T10:00:00Z" {
+		t.Errorf("expected normalized timestamp, got %q", collected[0].Timestamp)
+	}
+	if collected[0].URI() != "spotify:track:0Svkvt5I79wficMFgaqEQJ" {
+		t.Errorf("expected URI built from ID, got %q", collected[0].URI())
+	}
+
+	merged := spotigo.MergeStreamingHistory(official, collected)
+	if len(merged) != 2 {
+		t.Fatalf("expected 2 merged entries, got %d", len(merged))
+	}
+	if merged[0].Platform != "android" {
+		t.Error("expected official entry to win for duplicate play")
+	}
+	if merged[1].URI() != "spotify:track:0Svkvt5I79wficMFgaqEQJ" {
+		t.Errorf("expected entries sorted by timestamp, got %q last", merged[1].URI())
+	}
+}
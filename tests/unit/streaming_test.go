@@ -0,0 +1,153 @@
+package unit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/sv4u/spotigo"
+	"github.com/sv4u/spotigo/tests"
+)
+
+// playlistTracksServer serves total playlist items in pages of the requested
+// limit
+func playlistTracksServer(t *testing.T, total int) *httptest.Server {
+	t.Helper()
+
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		items := []map[string]interface{}{}
+		for i := offset; i < min(offset+limit, total); i++ {
+			items = append(items, map[string]interface{}{"added_at": fmt.Sprintf("item-%d", i), "is_local": false})
+		}
+		page := map[string]interface{}{"href": r.URL.String(), "items": items, "limit": limit, "offset": offset, "total": total, "next": nil}
+		if offset+limit < total {
+			page["next"] = fmt.Sprintf("%s%s?limit=%d&offset=%d", server.URL, r.URL.Path, limit, offset+limit)
+		}
+		tests.WriteJSONResponse(w, http.StatusOK, page)
+	}))
+	return server
+}
+
+func TestItemStream(t *testing.T) {
+	server := playlistTracksServer(t, 250)
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+
+	var streamed []string
+	ctx := spotigo.ContextWithItemStream(context.Background(), func(item spotigo.PlaylistTrack) error {
+		streamed = append(streamed, item.AddedAt)
+		return nil
+	})
+
+	page, err := client.PlaylistTracks(ctx, "37i9dQZF1DXcBWIGoYBM5M", &spotigo.PlaylistTracksOptions{Limit: 100})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(page.Items) != 0 {
+		t.Errorf("expected streamed items not to be collected, got %d", len(page.Items))
+	}
+	if page.Total != 250 || page.Limit != 100 || page.Next == nil {
+		t.Errorf("expected the other page fields to be decoded, got %+v", page)
+	}
+	for err == nil && page != nil {
+		page, err = spotigo.NextGeneric[spotigo.PlaylistTrack](client, ctx, page)
+	}
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(streamed) != 250 || streamed[0] != "item-0" || streamed[249] != "item-249" {
+		t.Errorf("expected 250 items in order, got %d", len(streamed))
+	}
+}
+
+func TestItemStreamCallbackError(t *testing.T) {
+	server := playlistTracksServer(t, 50)
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+
+	stop := errors.New("disk full")
+	calls := 0
+	ctx := spotigo.ContextWithItemStream(context.Background(), func(item spotigo.PlaylistTrack) error {
+		calls++
+		if calls == 3 {
+			return stop
+		}
+		return nil
+	})
+
+	_, err := client.PlaylistTracks(ctx, "37i9dQZF1DXcBWIGoYBM5M", nil)
+	if err != stop {
+		t.Errorf("expected the callback's error, got %v", err)
+	}
+	if calls != 3 {
+		t.Errorf("expected decoding to stop after the failing item, got %d calls", calls)
+	}
+}
+
+func TestItemStreamOtherType(t *testing.T) {
+	server := playlistTracksServer(t, 10)
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+
+	// A callback for another item type leaves the page as usual
+	ctx := spotigo.ContextWithItemStream(context.Background(), func(item spotigo.SavedTrack) error {
+		t.Error("unexpected callback")
+		return nil
+	})
+	page, err := client.PlaylistTracks(ctx, "37i9dQZF1DXcBWIGoYBM5M", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(page.Items) != 10 {
+		t.Errorf("expected 10 items, got %d", len(page.Items))
+	}
+}
+
+func TestStreamingDecode(t *testing.T) {
+	body := audioAnalysisJSON(500)
+	server := compressingServer(t, body, nil)
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+	spotigo.WithStreamingDecode(true)(client)
+
+	analysis, err := client.AudioAnalysis(context.Background(), "6b2oQwSGFkzsMtQruIWm2p")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(analysis.Segments) != 500 || len(analysis.Segments[499].Pitches) != 12 {
+		t.Errorf("unexpected analysis: %d segments", len(analysis.Segments))
+	}
+	if received := client.Stats().BytesReceived; received == 0 || received >= int64(len(body)) {
+		t.Errorf("expected the compressed transfer size to be counted, got %d of %d", received, len(body))
+	}
+
+}
+
+func TestStreamingDecodeStrict(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tests.WriteJSONResponse(w, http.StatusOK, map[string]interface{}{"id": "4iV5W9uYEdYUVa79Axb7Rh", "name": "Test Track", "mood": "calm"})
+	}))
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+	spotigo.WithStreamingDecode(true)(client)
+	spotigo.WithStrictDecoding(true)(client)
+
+	_, err := client.Track(context.Background(), "4iV5W9uYEdYUVa79Axb7Rh")
+	var unknown *spotigo.UnknownFieldError
+	if !errors.As(err, &unknown) || len(unknown.Fields) != 1 || unknown.Fields[0] != "mood" {
+		t.Errorf("expected an UnknownFieldError for mood, got %v", err)
+	}
+}
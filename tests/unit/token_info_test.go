@@ -0,0 +1,80 @@
+package unit
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sv4u/spotigo"
+	"github.com/sv4u/spotigo/tests"
+)
+
+func TestClientTokenInfo(t *testing.T) {
+	expiresAt := time.Now().Add(5 * time.Minute).Unix()
+	auth := &tests.MockAuthManager{Token: &spotigo.TokenInfo{
+		AccessToken: "token",
+		TokenType:   "Bearer",
+		Scope:       "user-read-private playlist-modify-public",
+		ExpiresAt:   int(expiresAt),
+	}}
+	client, _ := spotigo.NewClient(auth)
+
+	info, err := client.TokenInfo(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if info.TokenType != "Bearer" || !slices.Equal(info.Scopes(), []string{"user-read-private", "playlist-modify-public"}) {
+		t.Errorf("unexpected token info %+v", info)
+	}
+	if info.Expiry().Unix() != expiresAt || !info.ExpiresWithin(10*time.Minute) || info.ExpiresWithin(time.Minute) {
+		t.Errorf("unexpected expiry %v", info.Expiry())
+	}
+
+	info.Scope = ""
+	if auth.Token.Scope == "" {
+		t.Error("expected TokenInfo to return a copy")
+	}
+
+	client, _ = spotigo.NewClient(&tests.MockAuthManager{})
+	if _, err := client.TokenInfo(context.Background()); err == nil {
+		t.Error("expected an error without a token")
+	}
+}
+
+func TestClientForceRefreshToken(t *testing.T) {
+	auth, err := spotigo.NewClientCredentials("client_id", "secret")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	requests := 0
+	auth.HTTPClient = &http.Client{Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+		requests++
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+			Body:       io.NopCloser(strings.NewReader(fmt.Sprintf(`{"access_token": "access_%d", "token_type": "Bearer", "expires_in": 3600}`, requests))),
+			Request:    r,
+		}, nil
+	})}
+	client, _ := spotigo.NewClient(auth)
+	ctx := context.Background()
+
+	if _, err := auth.GetAccessToken(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := client.ForceRefreshToken(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	info, err := client.TokenInfo(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if requests != 2 || info.AccessToken != "access_2" {
+		t.Errorf("expected a second token request despite a valid token, got %d requests and %q", requests, info.AccessToken)
+	}
+}
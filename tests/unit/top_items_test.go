@@ -0,0 +1,99 @@
+package unit
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/sv4u/spotigo"
+	"github.com/sv4u/spotigo/tests"
+)
+
+// topItemsServer serves total top tracks, returning nothing past offset 49
+// like Spotify, and records the requested offsets and limits
+func topItemsServer(t *testing.T, total int, requests *[]string) *httptest.Server {
+	t.Helper()
+	var mu sync.Mutex
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		mu.Lock()
+		*requests = append(*requests, fmt.Sprintf("%d+%d", offset, limit))
+		mu.Unlock()
+
+		if r.URL.Query().Get("time_range") != "long_term" {
+			t.Errorf("expected time_range=long_term, got %q", r.URL.Query().Get("time_range"))
+		}
+
+		items := []interface{}{}
+		for i := offset; i < min(offset+limit, total) && offset <= 49; i++ {
+			items = append(items, map[string]interface{}{"id": fmt.Sprintf("item%d", i), "name": fmt.Sprintf("Item %d", i)})
+		}
+		tests.WriteJSONResponse(w, http.StatusOK, map[string]interface{}{
+			"items": items, "total": total, "limit": limit, "offset": offset,
+		})
+	}))
+}
+
+// TestCurrentUserTopTracksAll tests that paging reaches the 99 item cap
+func TestCurrentUserTopTracksAll(t *testing.T) {
+	var requests []string
+	server := topItemsServer(t, 150, &requests)
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+	tracks, err := client.CurrentUserTopTracksAll(context.Background(), &spotigo.TopItemsOptions{Range: spotigo.TimeRangeLong})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(tracks) != 99 {
+		t.Fatalf("expected 99 tracks, got %d", len(tracks))
+	}
+	for i, track := range tracks {
+		if track.ID != fmt.Sprintf("item%d", i) {
+			t.Fatalf("expected item%d at %d, got %s", i, i, track.ID)
+		}
+	}
+	if fmt.Sprint(requests) != "[0+49 49+50]" {
+		t.Errorf("unexpected requests: %v", requests)
+	}
+}
+
+// TestCurrentUserTopArtistsAllShort tests that paging stops at the total
+func TestCurrentUserTopArtistsAllShort(t *testing.T) {
+	var requests []string
+	server := topItemsServer(t, 30, &requests)
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+	artists, err := client.CurrentUserTopArtistsAll(context.Background(), &spotigo.TopItemsOptions{Range: spotigo.TimeRangeLong})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(artists) != 30 || len(requests) != 1 {
+		t.Errorf("expected 30 artists in 1 request, got %d in %v", len(artists), requests)
+	}
+}
+
+// TestTopItemsOffsetLimit tests that offsets past the cap are rejected
+func TestTopItemsOffsetLimit(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request: %s", r.URL)
+	}))
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+	_, err := client.CurrentUserTopTracks(context.Background(), &spotigo.TopItemsOptions{Offset: 50})
+	if err == nil {
+		t.Fatal("expected error for offset 50")
+	}
+	_, err = client.CurrentUserTopArtists(context.Background(), &spotigo.TopItemsOptions{Range: "weekly"})
+	if err == nil {
+		t.Fatal("expected error for an invalid time range")
+	}
+}
@@ -0,0 +1,75 @@
+package unit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sv4u/spotigo"
+	"github.com/sv4u/spotigo/tests"
+)
+
+func TestUsersBatch(t *testing.T) {
+	var mu sync.Mutex
+	inFlight, peak := 0, 0
+	requests := map[string]int{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := strings.TrimPrefix(r.URL.Path, "/users/")
+		mu.Lock()
+		requests[id]++
+		inFlight++
+		peak = max(peak, inFlight)
+		mu.Unlock()
+
+		time.Sleep(20 * time.Millisecond)
+
+		mu.Lock()
+		inFlight--
+		mu.Unlock()
+
+		if id == "ghost" {
+			tests.WriteJSONResponse(w, http.StatusNotFound, tests.CreateErrorResponse(404, "No such user", ""))
+			return
+		}
+		tests.WriteJSONResponse(w, http.StatusOK, map[string]interface{}{
+			"id": id, "followers": map[string]interface{}{"total": len(id)},
+		})
+	}))
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+
+	var ids []string
+	for i := 0; i < 10; i++ {
+		ids = append(ids, fmt.Sprintf("user%d", i))
+	}
+	ids = append(ids, "ghost", "user3")
+
+	users, err := client.UsersBatch(context.Background(), ids, &spotigo.UsersBatchOptions{Concurrency: 3})
+
+	var failed *spotigo.MultiError
+	if !errors.As(err, &failed) || failed.Len() != 1 || failed.Errors[0].ID != "ghost" || failed.Errors[0].Index != 10 {
+		t.Fatalf("expected one failure for ghost, got %v", err)
+	}
+	if len(users) != 10 {
+		t.Fatalf("expected 10 users, got %d", len(users))
+	}
+	if users["user7"] == nil || users["user7"].ID != "user7" || users["user7"].Followers.Total != 5 {
+		t.Errorf("unexpected profile: %+v", users["user7"])
+	}
+	if requests["user3"] != 1 {
+		t.Errorf("expected a duplicate ID to be fetched once, got %d requests", requests["user3"])
+	}
+	if peak > 3 {
+		t.Errorf("expected at most 3 requests at once, got %d", peak)
+	}
+	if peak < 2 {
+		t.Errorf("expected requests to run concurrently, peak was %d", peak)
+	}
+}
@@ -1,6 +1,7 @@
 package unit
 
 import (
+	"errors"
 	"strings"
 	"testing"
 
@@ -300,3 +301,71 @@ func TestGenerateRandomState(t *testing.T) {
 		states[state] = true
 	}
 }
+
+func TestParseAny(t *testing.T) {
+	testCases := []struct {
+		name         string
+		input        string
+		expectedKind string
+		expectedID   string
+		expectError  bool
+	}{
+		{"URI episode", "spotify:episode:512ojhOuo1ktJprKbVcKyQ", "episode", "512ojhOuo1ktJprKbVcKyQ", false},
+		{"URL track", "https://open.spotify.com/intl-de/track/6b2oQwSGFkzsMtQruIWm2p?si=x", "track", "6b2oQwSGFkzsMtQruIWm2p", false},
+		{"User playlist URI", "spotify:user:spotify:playlist:37i9dQZF1DXcBWIGoYBM5M", "playlist", "37i9dQZF1DXcBWIGoYBM5M", false},
+		{"Raw ID", "6b2oQwSGFkzsMtQruIWm2p", "", "", true},
+		{"Invalid URI", "spotify:invalid:123", "", "", true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			kind, id, err := spotigo.ParseAny(tc.input)
+			if tc.expectError {
+				if err == nil {
+					t.Error("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if kind != tc.expectedKind || id != tc.expectedID {
+				t.Errorf("expected (%q, %q), got (%q, %q)", tc.expectedKind, tc.expectedID, kind, id)
+			}
+		})
+	}
+}
+
+func TestItemURI(t *testing.T) {
+	testCases := []struct {
+		input    string
+		expected string
+	}{
+		{"6b2oQwSGFkzsMtQruIWm2p", "spotify:track:6b2oQwSGFkzsMtQruIWm2p"},
+		{"spotify:episode:512ojhOuo1ktJprKbVcKyQ", "spotify:episode:512ojhOuo1ktJprKbVcKyQ"},
+		{"https://open.spotify.com/episode/512ojhOuo1ktJprKbVcKyQ?si=abc", "spotify:episode:512ojhOuo1ktJprKbVcKyQ"},
+		{"https://open.spotify.com/intl-de/track/6b2oQwSGFkzsMtQruIWm2p", "spotify:track:6b2oQwSGFkzsMtQruIWm2p"},
+	}
+	for _, tc := range testCases {
+		if uri, err := spotigo.ItemURI(tc.input); err != nil || uri != tc.expected {
+			t.Errorf("ItemURI(%q) = %q, %v, want %q", tc.input, uri, err, tc.expected)
+		}
+	}
+
+	if _, err := spotigo.ItemURI("spotify:album:4aawyAB9vmqN3uQ7FjRGTy"); !errors.Is(err, spotigo.ErrValidation) {
+		t.Errorf("expected ErrValidation for an album, got %v", err)
+	}
+	if _, err := spotigo.ItemURI("not an id"); err == nil {
+		t.Error("expected error for an invalid ID")
+	}
+}
+
+func TestTypedItemURI(t *testing.T) {
+	uri, err := spotigo.TypedItem{Kind: "episode", ID: "512ojhOuo1ktJprKbVcKyQ"}.URI()
+	if err != nil || uri != "spotify:episode:512ojhOuo1ktJprKbVcKyQ" {
+		t.Errorf("URI() = %q, %v", uri, err)
+	}
+	if _, err := (spotigo.TypedItem{ID: "512ojhOuo1ktJprKbVcKyQ"}).URI(); err == nil {
+		t.Error("expected error for missing kind")
+	}
+}
@@ -0,0 +1,202 @@
+package unit
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/sv4u/spotigo"
+	"github.com/sv4u/spotigo/tests"
+)
+
+func TestFileQueueStoreSurvivesRestart(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "queue", "ops.jsonl")
+	store, err := spotigo.NewFileQueueStore(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, id := range []string{"a", "b", "c"} {
+		if err := store.Put(spotigo.QueuedOperation{ID: id, Method: http.MethodPost, Path: "me/tracks"}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if err := store.Delete("b"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := store.Put(spotigo.QueuedOperation{ID: "a", Method: http.MethodPost, Path: "me/tracks", Attempts: 2}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	store.Close()
+
+	// Simulate a crash in the middle of writing a record
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	file.WriteString(`{"put": {"id": "d", "meth`)
+	file.Close()
+
+	store, err = spotigo.NewFileQueueStore(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer store.Close()
+
+	ops, err := store.Load()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(ops) != 2 || ops[0].ID != "a" || ops[1].ID != "c" {
+		t.Fatalf("expected operations a and c in order, got %+v", ops)
+	}
+	if ops[0].Attempts != 2 {
+		t.Errorf("expected the latest version of a, got %+v", ops[0])
+	}
+}
+
+func TestWriteQueueFlush(t *testing.T) {
+	var mu sync.Mutex
+	var received []string
+	failNext := 1
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if r.URL.Path == "/playlists/3cEYpjA9oz9GiPac4AsH4n/tracks" && failNext > 0 {
+			failNext--
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		if r.URL.Path == "/me/tracks" && r.URL.Query().Get("ids") == "bad" {
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(map[string]interface{}{"error": map[string]interface{}{"status": 400, "message": "invalid id"}})
+			return
+		}
+		var body struct {
+			URIs []string `json:"uris"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		received = append(received, r.Method+" "+r.URL.Path+" "+r.URL.Query().Get("ids")+" "+body.URIs[0])
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"snapshot_id": "snap"}`))
+	}))
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+	client.RetryConfig.MaxRetries = 0
+	client.RetryConfig.StatusRetries = 0
+
+	path := filepath.Join(t.TempDir(), "ops.jsonl")
+	store, err := spotigo.NewFileQueueStore(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer store.Close()
+
+	queue := client.NewWriteQueue(store)
+	var dropped []spotigo.QueuedOperation
+	queue.OnDrop = func(op spotigo.QueuedOperation, err error) {
+		dropped = append(dropped, op)
+	}
+
+	if _, err := queue.EnqueuePlaylistAddItems("3cEYpjA9oz9GiPac4AsH4n", []string{base62ID("t", 1)}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := queue.Enqueue(http.MethodPut, "me/tracks", map[string][]string{"ids": {"bad"}}, map[string][]string{"uris": {"x"}}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := queue.Enqueue(http.MethodPut, "me/tracks", map[string][]string{"ids": {"good"}}, map[string][]string{"uris": {"y"}}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := queue.Enqueue(http.MethodGet, "me", nil, nil); err == nil {
+		t.Error("expected GET to be rejected")
+	}
+
+	// The first operation fails transiently and blocks the rest
+	if err := queue.Flush(context.Background()); err == nil {
+		t.Fatal("expected a transient error")
+	}
+	pending, _ := queue.Pending()
+	if len(pending) != 3 || pending[0].Attempts != 1 || pending[0].LastError == "" {
+		t.Fatalf("expected all operations to remain with the failure recorded, got %+v", pending)
+	}
+	if len(received) != 0 {
+		t.Fatalf("expected no operation to succeed, got %v", received)
+	}
+
+	if err := queue.Flush(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []string{
+		"POST /playlists/3cEYpjA9oz9GiPac4AsH4n/tracks  spotify:track:" + base62ID("t", 1),
+		"PUT /me/tracks good y",
+	}
+	if len(received) != len(want) || received[0] != want[0] || received[1] != want[1] {
+		t.Errorf("expected %v, got %v", want, received)
+	}
+	if len(dropped) != 1 || dropped[0].Params.Get("ids") != "bad" {
+		t.Errorf("expected the rejected operation to be dropped, got %+v", dropped)
+	}
+	if pending, _ := queue.Pending(); len(pending) != 0 {
+		t.Errorf("expected an empty queue, got %+v", pending)
+	}
+	if info, err := os.Stat(path); err != nil || info.Size() != 0 {
+		t.Errorf("expected the queue file to be compacted, got %v, %v", info, err)
+	}
+}
+
+func TestWriteQueueRunDefaultInterval(t *testing.T) {
+	// The write keeps failing, so the queue waits the retry interval
+	var attempts atomic.Int32
+	sent := make(chan string, 1)
+	server := httptest.NewServer(countRequests(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case sent <- r.URL.Path:
+		default:
+		}
+		tests.WriteJSONResponse(w, http.StatusServiceUnavailable, tests.CreateErrorResponse(503, "Service unavailable", ""))
+	}), &attempts))
+	defer server.Close()
+
+	client := newPlayerTestClient(t, server)
+	client.RetryConfig.StatusRetries = 0
+	store, err := spotigo.NewFileQueueStore(filepath.Join(t.TempDir(), "ops.jsonl"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer store.Close()
+
+	queue := client.NewWriteQueue(store)
+	if _, err := queue.EnqueuePlaylistAddItems("3cEYpjA9oz9GiPac4AsH4n", []string{base62ID("t", 1)}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		queue.Run(ctx, 0) // A zero interval falls back to the default instead of panicking
+	}()
+
+	select {
+	case path := <-sent:
+		if path != "/playlists/3cEYpjA9oz9GiPac4AsH4n/tracks" {
+			t.Errorf("unexpected path %s", path)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected the queued operation to be sent")
+	}
+	// The enqueue wake-up may trigger one more flush right away; after
+	// that, the queue waits the default retry interval
+	time.Sleep(50 * time.Millisecond)
+	expectNoMorePolls(t, &attempts, attempts.Load())
+	cancel()
+	<-done
+}
@@ -0,0 +1,72 @@
+package spotigo
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ============================================================================
+// Token Introspection
+// ============================================================================
+
+// Scopes returns the granted scopes, or nil if none were reported
+func (t *TokenInfo) Scopes() []string {
+	if t == nil {
+		return nil
+	}
+	return strings.Fields(t.Scope)
+}
+
+// ExpiresWithin reports whether the access token expires within d. A token
+// with an unknown expiry is assumed not to.
+//
+// Example:
+//
+//	if info.ExpiresWithin(10 * time.Minute) {
+//		err = client.ForceRefreshToken(ctx)
+//	}
+func (t *TokenInfo) ExpiresWithin(d time.Duration) bool {
+	expiry := t.Expiry()
+	return !expiry.IsZero() && time.Until(expiry) < d
+}
+
+// TokenInfo returns a copy of the auth manager's current token (type,
+// scopes, and expiry) without refreshing it, so applications can show
+// session status. The token may already be expired.
+//
+// Example:
+//
+//	info, err := client.TokenInfo(ctx)
+//	if err != nil {
+//		return err // Not authorized yet
+//	}
+//	fmt.Printf("%s token, scopes %v, expires %s\n", info.TokenType, info.Scopes(), info.Expiry())
+func (c *Client) TokenInfo(ctx context.Context) (*TokenInfo, error) {
+	token, err := c.AuthManager.GetCachedToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if token == nil {
+		return nil, fmt.Errorf("no token cached")
+	}
+	info := *token
+	return &info, nil
+}
+
+// tokenForceRefresher is implemented by auth managers whose RefreshToken
+// keeps a token that is still valid
+type tokenForceRefresher interface {
+	ForceRefreshToken(ctx context.Context) error
+}
+
+// ForceRefreshToken replaces the current access token with a new one even
+// if it is still valid, e.g. before a long operation that should not
+// refresh midway. Implicit Grant tokens cannot be refreshed.
+func (c *Client) ForceRefreshToken(ctx context.Context) error {
+	if refresher, ok := c.AuthManager.(tokenForceRefresher); ok {
+		return refresher.ForceRefreshToken(ctx)
+	}
+	return c.AuthManager.RefreshToken(ctx)
+}
@@ -1,5 +1,10 @@
 package spotigo
 
+import (
+	"encoding/json"
+	"net/http"
+)
+
 // Type definitions for Spotify Web API responses
 // All types match the Spotify API JSON structure exactly
 
@@ -105,10 +110,13 @@ type Artist struct {
 	Popularity   int           `json:"popularity"`
 	Type         string        `json:"type"`
 	URI          string        `json:"uri"`
+
+	Extras map[string]json.RawMessage `json:"-"` // Response fields not in the model yet (see WithStrictDecoding)
 }
 
 // SimplifiedAlbum represents a simplified album object
 type SimplifiedAlbum struct {
+	AlbumGroup           string        `json:"album_group,omitempty"` // Set by ArtistAlbums: the artist's relationship to the album
 	AlbumType            string        `json:"album_type"`
 	Artists              []Artist      `json:"artists"`
 	AvailableMarkets     []string      `json:"available_markets"`
@@ -117,7 +125,7 @@ type SimplifiedAlbum struct {
 	ID                   string        `json:"id"`
 	Images               []Image       `json:"images"`
 	Name                 string        `json:"name"`
-	ReleaseDate          string        `json:"release_date"`
+	ReleaseDate          ReleaseDate   `json:"release_date"`
 	ReleaseDatePrecision string        `json:"release_date_precision"`
 	Restrictions         *Restrictions `json:"restrictions,omitempty"`
 	TotalTracks          int           `json:"total_tracks"`
@@ -156,6 +164,8 @@ type Track struct {
 	Type             string           `json:"type"`
 	URI              string           `json:"uri"`
 	IsLocal          bool             `json:"is_local"`
+
+	Extras map[string]json.RawMessage `json:"-"` // Response fields not in the model yet (see WithStrictDecoding)
 }
 
 // SimplifiedTrack represents a simplified track object
@@ -206,13 +216,15 @@ type Album struct {
 	Label                string                   `json:"label"`
 	Name                 string                   `json:"name"`
 	Popularity           int                      `json:"popularity"`
-	ReleaseDate          string                   `json:"release_date"`
+	ReleaseDate          ReleaseDate              `json:"release_date"`
 	ReleaseDatePrecision string                   `json:"release_date_precision"`
 	Restrictions         *Restrictions            `json:"restrictions,omitempty"`
 	Tracks               *Paging[SimplifiedTrack] `json:"tracks"`
 	TotalTracks          int                      `json:"total_tracks"`
 	Type                 string                   `json:"type"`
 	URI                  string                   `json:"uri"`
+
+	Extras map[string]json.RawMessage `json:"-"` // Response fields not in the model yet (see WithStrictDecoding)
 }
 
 // Copyright represents copyright information
@@ -259,6 +271,8 @@ type Playlist struct {
 	SimplifiedPlaylist
 	Followers   *Followers `json:"followers"`
 	Description *string    `json:"description"`
+
+	Extras map[string]json.RawMessage `json:"-"` // Response fields not in the model yet (see WithStrictDecoding)
 }
 
 // PlaylistTracksRef represents a reference to playlist tracks
@@ -275,13 +289,15 @@ type PlaylistTrack struct {
 	Track   interface{} `json:"track"` // Can be Track or Episode
 }
 
-// PlaylistItem represents an item in a playlist (track or episode)
+// PlaylistItem represents an item in a playlist (track, episode, or local
+// file). At most one of Track, Episode, and Local is set.
 type PlaylistItem struct {
 	AddedAt string      `json:"added_at"`
 	AddedBy *PublicUser `json:"added_by"`
 	IsLocal bool        `json:"is_local"`
 	Track   *Track      `json:"track,omitempty"`
 	Episode *Episode    `json:"episode,omitempty"`
+	Local   *LocalTrack `json:"local,omitempty"`
 }
 
 // PublicUser represents a public user profile
@@ -346,8 +362,9 @@ type SimplifiedEpisode struct {
 	Language             *string       `json:"language"`
 	Languages            []string      `json:"languages"`
 	Name                 string        `json:"name"`
-	ReleaseDate          string        `json:"release_date"`
+	ReleaseDate          ReleaseDate   `json:"release_date"`
 	ReleaseDatePrecision string        `json:"release_date_precision"`
+	ResumePoint          *ResumePoint  `json:"resume_point,omitempty"` // Requires the user-read-playback-position scope
 	Restrictions         *Restrictions `json:"restrictions,omitempty"`
 	Type                 string        `json:"type"`
 	URI                  string        `json:"uri"`
@@ -357,6 +374,8 @@ type SimplifiedEpisode struct {
 type Episode struct {
 	SimplifiedEpisode
 	Show *SimplifiedShow `json:"show"`
+
+	Extras map[string]json.RawMessage `json:"-"` // Response fields not in the model yet (see WithStrictDecoding)
 }
 
 // SimplifiedAudiobook represents a simplified audiobook object
@@ -405,6 +424,8 @@ type User struct {
 	Product         *string                  `json:"product"`
 	Type            string                   `json:"type"`
 	URI             string                   `json:"uri"`
+
+	Extras map[string]json.RawMessage `json:"-"` // Response fields not in the model yet (see WithStrictDecoding)
 }
 
 // ExplicitContentSettings represents explicit content settings
@@ -417,6 +438,8 @@ type ExplicitContentSettings struct {
 type Show struct {
 	SimplifiedShow
 	Episodes *Paging[SimplifiedEpisode] `json:"episodes"`
+
+	Extras map[string]json.RawMessage `json:"-"` // Response fields not in the model yet (see WithStrictDecoding)
 }
 
 // ShowsResponse represents a response with multiple shows
@@ -433,6 +456,8 @@ type EpisodesResponse struct {
 type Audiobook struct {
 	SimplifiedAudiobook
 	Chapters *Paging[Chapter] `json:"chapters"`
+
+	Extras map[string]json.RawMessage `json:"-"` // Response fields not in the model yet (see WithStrictDecoding)
 }
 
 // AudiobooksResponse represents a response with multiple audiobooks
@@ -440,6 +465,11 @@ type AudiobooksResponse struct {
 	Audiobooks []Audiobook `json:"audiobooks"`
 }
 
+// ChaptersResponse represents a response with multiple chapters
+type ChaptersResponse struct {
+	Chapters []Chapter `json:"chapters"`
+}
+
 // Chapter represents a chapter object
 type Chapter struct {
 	Audiobook            *SimplifiedAudiobook `json:"audiobook,omitempty"`
@@ -457,7 +487,7 @@ type Chapter struct {
 	IsPlayable           bool                 `json:"is_playable"`
 	Languages            []string             `json:"languages"`
 	Name                 string               `json:"name"`
-	ReleaseDate          string               `json:"release_date"`
+	ReleaseDate          ReleaseDate          `json:"release_date"`
 	ReleaseDatePrecision string               `json:"release_date_precision"`
 	ResumePoint          *ResumePoint         `json:"resume_point,omitempty"`
 	Type                 string               `json:"type"`
@@ -718,3 +748,23 @@ type PlayHistoryItem struct {
 	PlayedAt string   `json:"played_at"` // ISO 8601 timestamp
 	Context  *Context `json:"context,omitempty"`
 }
+
+// AcceptedResult reports the HTTP status of a request that returns no content.
+//
+// Spotify answers some writes with 202 Accepted, meaning the change was queued
+// and may not be visible yet, and others with 200 OK or 204 No Content,
+// meaning it was applied.
+type AcceptedResult struct {
+	StatusCode int         // HTTP status code of the response
+	Headers    http.Header // Response headers
+}
+
+// Accepted reports whether the request was queued for processing (202 Accepted)
+func (r *AcceptedResult) Accepted() bool {
+	return r != nil && r.StatusCode == http.StatusAccepted
+}
+
+// Applied reports whether the request was applied immediately (200 OK or 204 No Content)
+func (r *AcceptedResult) Applied() bool {
+	return r != nil && (r.StatusCode == http.StatusOK || r.StatusCode == http.StatusNoContent)
+}
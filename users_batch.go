@@ -0,0 +1,94 @@
+package spotigo
+
+import (
+	"context"
+	"sync"
+)
+
+// ============================================================================
+// Batch User Profiles
+// ============================================================================
+
+// DefaultUsersBatchConcurrency is how many profiles UsersBatch fetches at once
+// by default
+const DefaultUsersBatchConcurrency = 8
+
+// UsersBatchOptions holds options for UsersBatch
+type UsersBatchOptions struct {
+	Concurrency int // Profiles fetched at once (default: DefaultUsersBatchConcurrency)
+}
+
+// UsersBatch fetches the public profiles of several users concurrently. The
+// API has no bulk users endpoint, so each profile is a separate request; at
+// most Concurrency run at once, and rate limiting and retries apply to each
+// as usual. Duplicate IDs are fetched once.
+//
+// The result is keyed by the IDs as passed in. Users that could not be
+// fetched are left out of the map and reported in a *MultiError, returned
+// alongside the profiles that were fetched.
+//
+// Example:
+//
+//	users, err := client.UsersBatch(ctx, userIDs, nil)
+//	var failed *spotigo.MultiError
+//	if err != nil && !errors.As(err, &failed) {
+//		return err
+//	}
+//	for _, id := range userIDs {
+//		if user, ok := users[id]; ok {
+//			fmt.Println(id, user.Followers.Total)
+//		}
+//	}
+func (c *Client) UsersBatch(ctx context.Context, userIDs []string, opts *UsersBatchOptions) (map[string]*PublicUser, error) {
+	concurrency := DefaultUsersBatchConcurrency
+	if opts != nil && opts.Concurrency > 0 {
+		concurrency = opts.Concurrency
+	}
+
+	// Fetch each ID once, remembering where it first appeared
+	var unique []string
+	firstIndex := make(map[string]int)
+	for i, id := range userIDs {
+		if _, seen := firstIndex[id]; !seen {
+			firstIndex[id] = i
+			unique = append(unique, id)
+		}
+	}
+
+	users := make([]*PublicUser, len(unique))
+	errs := make([]error, len(unique))
+	slots := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i, id := range unique {
+		if id == "" {
+			errs[i] = &MissingOptionError{Field: "user ID"}
+			continue
+		}
+		select {
+		case slots <- struct{}{}:
+		case <-ctx.Done():
+			errs[i] = ctx.Err()
+			continue
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-slots }()
+			users[i], errs[i] = c.User(ctx, id)
+		}()
+	}
+	wg.Wait()
+
+	result := make(map[string]*PublicUser, len(unique))
+	failed := &MultiError{}
+	for i, id := range unique {
+		if errs[i] != nil {
+			failed.Add(firstIndex[id], id, errs[i])
+			continue
+		}
+		result[id] = users[i]
+	}
+
+	return result, failed.ErrorOrNil()
+}
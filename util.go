@@ -75,15 +75,16 @@ var validEntityTypes = map[string]bool{
 	"show":      true,
 	"episode":   true,
 	"audiobook": true,
+	"chapter":   true,
 	"user":      true,
 }
 
 // Spotify URI pattern: spotify:track:ID or spotify:user:username:playlist:ID
-var spotifyURIPattern = regexp.MustCompile(`^spotify:(?:(?P<type>track|artist|album|playlist|show|episode|audiobook):(?P<id>[0-9A-Za-z]+)|user:(?P<username>[0-9A-Za-z]+):playlist:(?P<playlistid>[0-9A-Za-z]+))$`)
+var spotifyURIPattern = regexp.MustCompile(`^spotify:(?:(?P<type>track|artist|album|playlist|show|episode|audiobook|chapter):(?P<id>[0-9A-Za-z]+)|user:(?P<username>[0-9A-Za-z]+):playlist:(?P<playlistid>[0-9A-Za-z]+))$`)
 
 // Spotify URL pattern: https://open.spotify.com/track/ID (with optional intl-XX/ or intl-XX-YY/)
 // Handles query parameters, fragments, and trailing slashes
-var spotifyURLPattern = regexp.MustCompile(`^https?://(?:(?:www|open)\.)?spotify\.com/(?:(?:intl-[a-z]{2}(?:-[A-Z]{2})?/)?)?(?P<type>track|artist|album|playlist|show|episode|audiobook|user)/(?P<id>[0-9A-Za-z]+)(?:[/?#].*)?$`)
+var spotifyURLPattern = regexp.MustCompile(`^https?://(?:(?:www|open)\.)?spotify\.com/(?:(?:intl-[a-z]{2}(?:-[A-Z]{2})?/)?)?(?P<type>track|artist|album|playlist|show|episode|audiobook|chapter|user)/(?P<id>[0-9A-Za-z]+)(?:[/?#].*)?$`)
 
 // Base62 pattern for raw IDs
 var base62Pattern = regexp.MustCompile(`^[0-9A-Za-z]+$`)
@@ -106,6 +107,11 @@ func GetID(uri string, entityType ...string) (string, error) {
 		return parseURL(uri, expectedType)
 	}
 
+	// Short links do not contain the ID
+	if IsShareLink(uri) {
+		return "", fmt.Errorf("share link %s must be resolved with ResolveShareLink", uri)
+	}
+
 	// Assume raw ID
 	if expectedType != "" {
 		if err := validateEntityType("", expectedType); err != nil {
@@ -139,6 +145,79 @@ func IsURI(uri string) bool {
 	return spotifyURIPattern.MatchString(uri)
 }
 
+// ParseAny extracts the entity type and ID from a Spotify URI or URL, for
+// inputs whose type is not known in advance. Raw IDs carry no type and are
+// rejected; use GetID with an expected type or a TypedItem for those.
+//
+// Example:
+//
+//	kind, id, err := spotigo.ParseAny("https://open.spotify.com/episode/512ojhOuo1ktJprKbVcKyQ")
+//	// kind == "episode", id == "512ojhOuo1ktJprKbVcKyQ"
+func ParseAny(input string) (kind, id string, err error) {
+	var pattern *regexp.Regexp
+	switch {
+	case strings.HasPrefix(input, "spotify:"):
+		pattern = spotifyURIPattern
+	case strings.Contains(input, "spotify.com"):
+		pattern = spotifyURLPattern
+	case IsShareLink(input):
+		return "", "", fmt.Errorf("share link %s must be resolved with ResolveShareLink", input)
+	default:
+		return "", "", fmt.Errorf("cannot infer the type of %q: not a Spotify URI or URL", input)
+	}
+
+	matches := pattern.FindStringSubmatch(input)
+	if matches == nil {
+		return "", "", fmt.Errorf("invalid Spotify URI or URL: %s", input)
+	}
+	if i := pattern.SubexpIndex("playlistid"); i >= 0 && matches[i] != "" {
+		// User playlist format: spotify:user:username:playlist:ID
+		return "playlist", matches[i], nil
+	}
+	return matches[pattern.SubexpIndex("type")], matches[pattern.SubexpIndex("id")], nil
+}
+
+// ItemURI converts a track or episode ID, URI, or URL to a Spotify URI, as
+// accepted by the playlist, queue, and playback endpoints. URIs and URLs
+// carry their type; raw IDs are taken to be tracks.
+//
+// Example:
+//
+//	uri, err := spotigo.ItemURI("https://open.spotify.com/episode/512ojhOuo1ktJprKbVcKyQ")
+//	// uri == "spotify:episode:512ojhOuo1ktJprKbVcKyQ"
+func ItemURI(item string) (string, error) {
+	if !strings.HasPrefix(item, "spotify:") && !strings.Contains(item, "spotify.com") {
+		return GetURI(item, "track")
+	}
+	kind, id, err := ParseAny(item)
+	if err != nil {
+		return "", err
+	}
+	if kind != "track" && kind != "episode" {
+		return "", &InvalidOptionError{Field: "item", Reason: fmt.Sprintf("must be a track or episode, got %s", item)}
+	}
+	return GetURI(id, kind)
+}
+
+// TypedItem is an ID with an explicit entity type, for mixed lists where a
+// raw ID alone does not say whether it is, say, a track or an episode
+type TypedItem struct {
+	Kind string // Entity type: "track", "episode", "album", ...
+	ID   string // Raw ID, URI, or URL; URIs and URLs must match Kind
+}
+
+// URI returns the item's Spotify URI
+func (t TypedItem) URI() (string, error) {
+	if t.Kind == "" {
+		return "", fmt.Errorf("item %q has no kind", t.ID)
+	}
+	id, err := GetID(t.ID, t.Kind)
+	if err != nil {
+		return "", err
+	}
+	return GetURI(id, t.Kind)
+}
+
 // parseURI parses a Spotify URI and extracts the ID
 func parseURI(uri string, expectedType string) (string, error) {
 	matches := spotifyURIPattern.FindStringSubmatch(uri)
@@ -0,0 +1,419 @@
+package spotigo
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// ============================================================================
+// Write-Behind Queue
+// ============================================================================
+
+// QueuedOperation is a write request waiting in a WriteQueue
+type QueuedOperation struct {
+	ID         string          `json:"id"`
+	Method     string          `json:"method"`               // HTTP method, e.g. "POST"
+	Path       string          `json:"path"`                 // Endpoint path relative to APIPrefix
+	Params     url.Values      `json:"params,omitempty"`     // Query parameters
+	Body       json.RawMessage `json:"body,omitempty"`       // JSON request body
+	EnqueuedAt time.Time       `json:"enqueued_at"`          // When the operation was enqueued
+	Attempts   int             `json:"attempts"`             // Failed attempts so far
+	LastError  string          `json:"last_error,omitempty"` // Error from the last failed attempt
+}
+
+// QueueStore persists queued operations. Implementations must be safe for
+// concurrent use.
+type QueueStore interface {
+	// Put adds an operation, or replaces the one with the same ID
+	Put(op QueuedOperation) error
+	// Delete removes an operation; deleting an unknown ID is not an error
+	Delete(id string) error
+	// Load returns the pending operations in the order they were first added
+	Load() ([]QueuedOperation, error)
+}
+
+// fileQueueRecord is one line of a FileQueueStore
+type fileQueueRecord struct {
+	Put    *QueuedOperation `json:"put,omitempty"`
+	Delete string           `json:"delete,omitempty"`
+}
+
+// FileQueueStore is a QueueStore backed by an append-only JSON Lines file.
+// Every change is synced to disk before Put or Delete returns, and the file
+// is compacted when it is opened and whenever the queue empties.
+type FileQueueStore struct {
+	path string
+
+	mu      sync.Mutex
+	file    *os.File
+	pending map[string]QueuedOperation
+	order   []string // IDs in the order they were first added
+}
+
+// NewFileQueueStore opens the queue file at path, creating it if needed, and
+// loads the operations left from earlier runs. A partially written final
+// line, left by a crash mid-write, is ignored.
+func NewFileQueueStore(path string) (*FileQueueStore, error) {
+	s := &FileQueueStore{path: path, pending: make(map[string]QueuedOperation)}
+
+	data, err := os.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("failed to read queue file: %w", err)
+	}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(nil, 16<<20)
+	for scanner.Scan() {
+		var record fileQueueRecord
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			continue
+		}
+		s.apply(record)
+	}
+
+	if dir := filepath.Dir(path); dir != "." && dir != "" {
+		if err := os.MkdirAll(dir, 0700); err != nil {
+			return nil, fmt.Errorf("failed to create queue directory: %w", err)
+		}
+	}
+	if err := s.compact(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// apply updates the in-memory state with a record
+func (s *FileQueueStore) apply(record fileQueueRecord) {
+	if record.Put != nil {
+		if _, ok := s.pending[record.Put.ID]; !ok {
+			s.order = append(s.order, record.Put.ID)
+		}
+		s.pending[record.Put.ID] = *record.Put
+	}
+	if record.Delete != "" {
+		if _, ok := s.pending[record.Delete]; ok {
+			delete(s.pending, record.Delete)
+			for i, id := range s.order {
+				if id == record.Delete {
+					s.order = append(s.order[:i], s.order[i+1:]...)
+					break
+				}
+			}
+		}
+	}
+}
+
+// compact rewrites the file with only the pending operations and reopens it
+// for appending
+func (s *FileQueueStore) compact() error {
+	var buf bytes.Buffer
+	for _, id := range s.order {
+		op := s.pending[id]
+		line, err := json.Marshal(fileQueueRecord{Put: &op})
+		if err != nil {
+			return fmt.Errorf("failed to encode queued operation: %w", err)
+		}
+		buf.Write(line)
+		buf.WriteByte('\n')
+	}
+
+	tmpPath := s.path + ".tmp"
+	if err := os.WriteFile(tmpPath, buf.Bytes(), 0600); err != nil {
+		os.Remove(tmpPath)
+		return fmt.Errorf("failed to write queue file: %w", err)
+	}
+	if err := os.Rename(tmpPath, s.path); err != nil {
+		os.Remove(tmpPath)
+		return fmt.Errorf("failed to replace queue file: %w", err)
+	}
+
+	if s.file != nil {
+		s.file.Close()
+	}
+	file, err := os.OpenFile(s.path, os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to open queue file: %w", err)
+	}
+	s.file = file
+	return nil
+}
+
+// write appends a record and syncs it to disk
+func (s *FileQueueStore) write(record fileQueueRecord) error {
+	line, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("failed to encode queued operation: %w", err)
+	}
+	if _, err := s.file.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("failed to write queue file: %w", err)
+	}
+	if err := s.file.Sync(); err != nil {
+		return fmt.Errorf("failed to sync queue file: %w", err)
+	}
+	s.apply(record)
+	return nil
+}
+
+// Put implements QueueStore
+func (s *FileQueueStore) Put(op QueuedOperation) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.write(fileQueueRecord{Put: &op})
+}
+
+// Delete implements QueueStore
+func (s *FileQueueStore) Delete(id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.pending[id]; !ok {
+		return nil
+	}
+	if err := s.write(fileQueueRecord{Delete: id}); err != nil {
+		return err
+	}
+	if len(s.pending) == 0 {
+		return s.compact()
+	}
+	return nil
+}
+
+// Load implements QueueStore
+func (s *FileQueueStore) Load() ([]QueuedOperation, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ops := make([]QueuedOperation, 0, len(s.order))
+	for _, id := range s.order {
+		ops = append(ops, s.pending[id])
+	}
+	return ops, nil
+}
+
+// Close closes the queue file
+func (s *FileQueueStore) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.file == nil {
+		return nil
+	}
+	err := s.file.Close()
+	s.file = nil
+	return err
+}
+
+// DefaultWriteQueueRetryInterval is how often WriteQueue.Run retries after
+// a failure when interval is not positive
+const DefaultWriteQueueRetryInterval = 30 * time.Second
+
+// WriteQueue defers write requests to a durable QueueStore and sends them in
+// order, retrying after failures, so daemons survive restarts and outages
+// without losing operations.
+//
+// Operations are sent one at a time in the order they were enqueued. An
+// operation that fails with a transient error (network errors, 5xx and 429
+// responses) stays at the head of the queue and blocks later ones until it
+// succeeds, preserving ordering. An operation that Spotify rejects (other
+// 4xx responses), or that has failed MaxAttempts times, is dropped and
+// reported to OnDrop.
+type WriteQueue struct {
+	client *Client
+	store  QueueStore
+
+	MaxAttempts int                                 // Drop operations after this many failed attempts (0 = retry forever)
+	OnDrop      func(op QueuedOperation, err error) // Called when an operation is dropped (optional)
+
+	flushMu sync.Mutex    // Serializes flushes
+	wake    chan struct{} // Signals Run that an operation was enqueued
+}
+
+// NewWriteQueue returns a queue that sends operations with c and persists
+// them in store. Operations left in store by an earlier run are sent by the
+// next Flush or Run.
+//
+// Example:
+//
+//	store, err := spotigo.NewFileQueueStore("/var/lib/playlist-sync/queue.jsonl")
+//	if err != nil {
+//		return err
+//	}
+//	queue := client.NewWriteQueue(store)
+//	go queue.Run(ctx, 30*time.Second)
+//
+//	_, err = queue.EnqueuePlaylistAddItems(playlistID, uris)
+func (c *Client) NewWriteQueue(store QueueStore) *WriteQueue {
+	return &WriteQueue{
+		client: c,
+		store:  store,
+		wake:   make(chan struct{}, 1),
+	}
+}
+
+// Enqueue persists a write request and returns its operation ID. body is
+// encoded as JSON. The request is sent by the next Flush, or promptly by a
+// running Run.
+func (q *WriteQueue) Enqueue(method, path string, params url.Values, body interface{}) (string, error) {
+	switch method {
+	case http.MethodPost, http.MethodPut, http.MethodDelete, http.MethodPatch:
+	default:
+		return "", fmt.Errorf("unsupported method for a write queue: %q", method)
+	}
+	if path == "" {
+		return "", &MissingOptionError{Field: "path"}
+	}
+
+	op := QueuedOperation{Method: method, Path: path, Params: params, EnqueuedAt: time.Now()}
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return "", fmt.Errorf("failed to encode request body: %w", err)
+		}
+		op.Body = data
+	}
+
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return "", fmt.Errorf("failed to generate operation ID: %w", err)
+	}
+	op.ID = hex.EncodeToString(id)
+
+	if err := q.store.Put(op); err != nil {
+		return "", err
+	}
+	select {
+	case q.wake <- struct{}{}:
+	default:
+	}
+	return op.ID, nil
+}
+
+// EnqueuePlaylistAddItems enqueues adding items (track or episode URIs,
+// URLs, or IDs, as in PlaylistAddItems) to the end of a playlist
+func (q *WriteQueue) EnqueuePlaylistAddItems(playlistID string, items []string) (string, error) {
+	id, err := GetID(playlistID, "playlist")
+	if err != nil {
+		return "", err
+	}
+	if len(items) > 100 {
+		return "", &TooManyIDsError{Kind: "items", Max: 100, Got: len(items)}
+	}
+
+	uris := make([]string, 0, len(items))
+	invalidItems := &MultiError{}
+	for i, item := range items {
+		uri, err := ItemURI(item)
+		if err != nil {
+			invalidItems.Add(i, item, err)
+			continue
+		}
+		uris = append(uris, uri)
+	}
+	if err := invalidItems.ErrorOrNil(); err != nil {
+		return "", err
+	}
+
+	return q.Enqueue(http.MethodPost, fmt.Sprintf("playlists/%s/tracks", id), nil, PlaylistAddItemsRequest{URIs: uris})
+}
+
+// Pending returns the operations waiting to be sent, oldest first
+func (q *WriteQueue) Pending() ([]QueuedOperation, error) {
+	return q.store.Load()
+}
+
+// Flush sends pending operations in order until the queue is empty or an
+// operation fails with a transient error, which is returned.
+func (q *WriteQueue) Flush(ctx context.Context) error {
+	q.flushMu.Lock()
+	defer q.flushMu.Unlock()
+
+	ops, err := q.store.Load()
+	if err != nil {
+		return err
+	}
+
+	for _, op := range ops {
+		var body interface{}
+		if len(op.Body) > 0 {
+			body = op.Body
+		}
+		err := q.client.Do(ctx, op.Method, op.Path, op.Params, body, nil)
+		if err == nil {
+			if err := q.store.Delete(op.ID); err != nil {
+				return err
+			}
+			continue
+		}
+		if ctx.Err() != nil {
+			return err
+		}
+
+		op.Attempts++
+		op.LastError = err.Error()
+		if permanentWriteError(err) || (q.MaxAttempts > 0 && op.Attempts >= q.MaxAttempts) {
+			if deleteErr := q.store.Delete(op.ID); deleteErr != nil {
+				return deleteErr
+			}
+			if q.OnDrop != nil {
+				q.OnDrop(op, err)
+			}
+			continue
+		}
+
+		if putErr := q.store.Put(op); putErr != nil {
+			return errors.Join(err, putErr)
+		}
+		return err
+	}
+	return nil
+}
+
+// permanentWriteError reports whether a failed write will fail again if
+// retried: validation errors and 4xx responses other than 429
+func permanentWriteError(err error) bool {
+	if errors.Is(err, ErrValidation) {
+		return true
+	}
+	var spotifyErr *SpotifyError
+	if errors.As(err, &spotifyErr) {
+		return spotifyErr.HTTPStatus >= 400 && spotifyErr.HTTPStatus < 500 &&
+			spotifyErr.HTTPStatus != http.StatusTooManyRequests
+	}
+	return false
+}
+
+// Run flushes the queue whenever an operation is enqueued, and every
+// interval (default: DefaultWriteQueueRetryInterval) while operations are
+// waiting after a failure, until ctx is cancelled. Flush errors are logged
+// with the client's Logger.
+func (q *WriteQueue) Run(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		interval = DefaultWriteQueueRetryInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := q.Flush(ctx); err != nil && ctx.Err() == nil && q.client.Logger != nil {
+			q.client.Logger.Warn("Write queue flush failed, retrying in %s: %v", interval, err)
+		}
+
+		select {
+		case <-q.wake:
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}